@@ -9,7 +9,47 @@ import (
 	h "maragu.dev/gomponents/html"
 )
 
+// AccordionIcon selects the indicator shown on accordion toggles.
+type AccordionIcon int
+
+const (
+	// AccordionIconArrow shows a chevron rotating as the item opens.
+	AccordionIconArrow AccordionIcon = iota
+	// AccordionIconPlus shows a plus that turns into a minus when open.
+	AccordionIconPlus
+	// AccordionIconNone shows no indicator.
+	AccordionIconNone
+	// AccordionIconCustom shows the icons set with WithCustomIcons.
+	AccordionIconCustom
+)
+
+// AccordionStyle represents the visual style variants for accordions.
+type AccordionStyle int
+
+const (
+	AccordionDefault AccordionStyle = iota
+	AccordionBordered
+	AccordionShadow
+	// AccordionJoined renders bordered items joined into a single block.
+	AccordionJoined
+)
+
+// String returns the CSS classes for the accordion style.
+func (as AccordionStyle) String() string {
+	switch as {
+	case AccordionBordered:
+		return "accordion-bordered"
+	case AccordionShadow:
+		return "accordion-shadow"
+	case AccordionJoined:
+		return "accordion-bordered join join-vertical w-full"
+	default:
+		return "divide-base-content/25 divide-y"
+	}
+}
+
 // AccordionItem represents a single accordion item with title and content.
+// Content may hold another AccordionComponent to nest accordions.
 type AccordionItem struct {
 	ID      string
 	Title   string
@@ -24,6 +64,10 @@ type AccordionComponent struct {
 	multiple bool // Allow multiple items to be open simultaneously
 	color    flyon.Color
 	classes  []string
+	icon     AccordionIcon
+	closed   string // Custom icon class of closed items
+	opened   string // Custom icon class of open items
+	style    AccordionStyle
 }
 
 // NewAccordion creates a new accordion component with the given items.
@@ -44,14 +88,46 @@ func (ac *AccordionComponent) WithID(id string) *AccordionComponent {
 	return newAC
 }
 
+// WithAlwaysOpen keeps items open when another item opens, instead of
+// closing them.
+func (ac *AccordionComponent) WithAlwaysOpen(alwaysOpen bool) *AccordionComponent {
+	newAC := ac.copy()
+	newAC.multiple = alwaysOpen
+	return newAC
+}
+
 // WithMultiple allows multiple accordion items to be open simultaneously.
+//
+// Deprecated: use WithAlwaysOpen.
 func (ac *AccordionComponent) WithMultiple(multiple bool) *AccordionComponent {
+	return ac.WithAlwaysOpen(multiple)
+}
+
+// WithIcon sets the indicator shown on the item toggles.
+func (ac *AccordionComponent) WithIcon(icon AccordionIcon) *AccordionComponent {
+	newAC := ac.copy()
+	newAC.icon = icon
+	return newAC
+}
+
+// WithCustomIcons shows the icon class closed on closed items and opened on
+// open items, e.g. "icon-[tabler--eye]". An empty opened keeps closed shown.
+func (ac *AccordionComponent) WithCustomIcons(closed, opened string) *AccordionComponent {
+	newAC := ac.copy()
+	newAC.icon = AccordionIconCustom
+	newAC.closed = closed
+	newAC.opened = opened
+	return newAC
+}
+
+// WithStyle sets the visual style of the accordion.
+func (ac *AccordionComponent) WithStyle(style AccordionStyle) *AccordionComponent {
 	newAC := ac.copy()
-	newAC.multiple = multiple
+	newAC.style = style
 	return newAC
 }
 
-// WithColor sets the color for the accordion component.
+// WithColor sets the color of the title of open items.
 func (ac *AccordionComponent) WithColor(color flyon.Color) *AccordionComponent {
 	newAC := ac.copy()
 	newAC.color = color
@@ -72,6 +148,12 @@ func (ac *AccordionComponent) With(modifiers ...any) flyon.Component {
 		switch m := modifier.(type) {
 		case flyon.Color:
 			newAC.color = m
+		case AccordionIcon:
+			newAC.icon = m
+		case AccordionStyle:
+			newAC.style = m
+		case string:
+			newAC.classes = append(newAC.classes, m)
 		}
 	}
 	return newAC
@@ -79,82 +161,95 @@ func (ac *AccordionComponent) With(modifiers ...any) flyon.Component {
 
 // copy creates a deep copy of the accordion component.
 func (ac *AccordionComponent) copy() *AccordionComponent {
-	newItems := make([]AccordionItem, len(ac.items))
-	copy(newItems, ac.items)
-	
-	newClasses := make([]string, len(ac.classes))
-	copy(newClasses, ac.classes)
-	
-	return &AccordionComponent{
-		id:       ac.id,
-		items:    newItems,
-		multiple: ac.multiple,
-		color:    ac.color,
-		classes:  newClasses,
+	newAC := *ac
+	newAC.items = append([]AccordionItem(nil), ac.items...)
+	newAC.classes = append([]string{}, ac.classes...)
+	return &newAC
+}
+
+// indicator returns the icons of an item toggle, placed before the title
+// for the arrow and after it otherwise
+func (ac *AccordionComponent) indicator() gomponents.Node {
+	switch ac.icon {
+	case AccordionIconPlus:
+		return gomponents.Group([]gomponents.Node{
+			h.Span(h.Class("icon-[tabler--plus] accordion-item-active:hidden block size-4.5 shrink-0"), h.Aria("hidden", "true")),
+			h.Span(h.Class("icon-[tabler--minus] accordion-item-active:block hidden size-4.5 shrink-0"), h.Aria("hidden", "true")),
+		})
+	case AccordionIconCustom:
+		if ac.opened == "" {
+			return h.Span(h.Class(ac.closed+" size-4.5 shrink-0"), h.Aria("hidden", "true"))
+		}
+		return gomponents.Group([]gomponents.Node{
+			h.Span(h.Class(ac.closed+" accordion-item-active:hidden block size-4.5 shrink-0"), h.Aria("hidden", "true")),
+			h.Span(h.Class(ac.opened+" accordion-item-active:block hidden size-4.5 shrink-0"), h.Aria("hidden", "true")),
+		})
+	case AccordionIconNone:
+		return nil
+	default:
+		return h.Span(h.Class("icon-[tabler--chevron-right] accordion-item-active:rotate-90 size-5 shrink-0 transition-transform duration-300 rtl:rotate-180"), h.Aria("hidden", "true"))
 	}
 }
 
 // Render renders the accordion component to HTML.
 func (ac *AccordionComponent) Render(w io.Writer) error {
 	// Build CSS classes
-	classes := []string{"collapse-group"}
+	classes := []string{"accordion", ac.style.String()}
 	classes = append(classes, ac.classes...)
-	
+
+	itemClasses := "accordion-item"
+	if ac.style == AccordionJoined {
+		itemClasses += " join-item"
+	}
+
+	toggleClasses := "accordion-toggle inline-flex items-center gap-x-4 text-start accordion-item-active:text-" + ac.color.String()
+	if ac.icon != AccordionIconArrow {
+		toggleClasses += " justify-between"
+	}
+
 	// Create accordion items
 	accordionItems := make([]gomponents.Node, 0, len(ac.items))
 	for _, item := range ac.items {
-		// Generate unique names for radio buttons if not multiple
-		name := ac.id + "-accordion"
-		if ac.multiple {
-			name = ac.id + "-accordion-" + item.ID
+		itemClass := itemClasses
+		contentClasses := "accordion-content w-full overflow-hidden transition-[height] duration-300"
+		expanded := "false"
+		if item.Open {
+			itemClass += " active"
+			expanded = "true"
+		} else {
+			contentClasses += " hidden"
 		}
-		
-		// Create input element (radio for single, checkbox for multiple)
-		var inputElement gomponents.Node
-		if ac.multiple {
-			inputElement = h.Input(
-				h.Type("checkbox"),
-				h.ID(item.ID),
-				h.Name(name),
-				h.Class("collapse-toggle"),
-				gomponents.If(item.Open, h.Checked()),
-			)
+
+		// The arrow leads the title, the other indicators trail it
+		title := []gomponents.Node{gomponents.Text(item.Title)}
+		if ac.icon == AccordionIconArrow {
+			title = append([]gomponents.Node{ac.indicator()}, title...)
 		} else {
-			inputElement = h.Input(
-				h.Type("radio"),
-				h.ID(item.ID),
-				h.Name(name),
-				h.Class("collapse-toggle"),
-				gomponents.If(item.Open, h.Checked()),
-			)
+			title = append([]gomponents.Node{h.Span(gomponents.Text(item.Title))}, ac.indicator())
 		}
-		
-		// Create the accordion item
-		accordionItem := h.Div(
-			h.Class("collapse collapse-arrow"),
-			gomponents.Attr("data-accordion-item", item.ID),
-			
-			// Hidden input for state management
-			inputElement,
-			
-			// Accordion header/title
-			h.Label(
-				h.For(item.ID),
-				h.Class("collapse-title text-xl font-medium cursor-pointer"),
-				gomponents.Text(item.Title),
+
+		accordionItems = append(accordionItems, h.Div(
+			h.ID(item.ID),
+			h.Class(itemClass),
+			h.Button(
+				h.Type("button"),
+				h.ID(item.ID+"-toggle"),
+				h.Class(toggleClasses),
+				h.Aria("controls", item.ID+"-collapse"),
+				h.Aria("expanded", expanded),
+				gomponents.Group(title),
 			),
-			
-			// Accordion content
 			h.Div(
-				h.Class("collapse-content"),
+				h.ID(item.ID+"-collapse"),
+				h.Class(contentClasses),
+				h.Aria("labelledby", item.ID+"-toggle"),
+				h.Role("region"),
 				h.Div(
-					h.Class("pb-2"),
+					h.Class("px-5 pb-4"),
 					item.Content,
 				),
 			),
-		)
-		
-		accordionItems = append(accordionItems, accordionItem)
+		))
 	}
 	
 	// Render the complete accordion component
@@ -162,8 +257,7 @@ func (ac *AccordionComponent) Render(w io.Writer) error {
 		h.ID(ac.id),
 		h.Class(strings.Join(classes, " ")),
 		gomponents.Attr("data-component", "accordion"),
-		gomponents.If(!ac.multiple, gomponents.Attr("data-single", "true")),
-		gomponents.If(ac.multiple, gomponents.Attr("data-multiple", "true")),
+		gomponents.If(ac.multiple, gomponents.Attr("data-accordion-always-open", "")),
 		gomponents.Group(accordionItems),
 	)
 	
@@ -188,4 +282,7 @@ func NewOpenAccordionItem(id, title string, content gomponents.Node) AccordionIt
 		Content: content,
 		Open:    true,
 	}
-}
\ No newline at end of file
+}
+
+// Ensure AccordionComponent implements flyon.Component
+var _ flyon.Component = (*AccordionComponent)(nil)
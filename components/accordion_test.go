@@ -130,108 +130,152 @@ func TestAccordionComponent_Render(t *testing.T) {
 	if !strings.Contains(html, `id="test-accordion"`) {
 		t.Error("Missing accordion ID")
 	}
-	if !strings.Contains(html, `class="collapse-group"`) {
-		t.Error("Missing collapse-group class")
+	if !strings.Contains(html, `class="accordion divide-base-content/25 divide-y"`) {
+		t.Error("Missing accordion class")
 	}
 	if !strings.Contains(html, `data-component="accordion"`) {
 		t.Error("Missing data-component attribute")
 	}
-	if !strings.Contains(html, `data-single="true"`) {
-		t.Error("Missing data-single attribute for single mode")
+	if strings.Contains(html, `data-accordion-always-open`) {
+		t.Error("Single accordion should not be always open")
 	}
 
-	// Check accordion items
-	if !strings.Contains(html, `class="collapse collapse-arrow"`) {
-		t.Error("Missing collapse class")
+	// Check items
+	if !strings.Contains(html, `id="item1" class="accordion-item active"`) {
+		t.Error("Missing open item")
 	}
-	if !strings.Contains(html, `data-accordion-item="item1"`) {
-		t.Error("Missing item1 data-accordion-item")
-	}
-	if !strings.Contains(html, `data-accordion-item="item2"`) {
-		t.Error("Missing item2 data-accordion-item")
+	if !strings.Contains(html, `id="item2" class="accordion-item"`) {
+		t.Error("Missing closed item")
 	}
 
-	// Check radio inputs for single mode
-	if !strings.Contains(html, `type="radio"`) {
-		t.Error("Missing radio input type")
-	}
-	if !strings.Contains(html, `name="test-accordion-accordion"`) {
-		t.Error("Missing radio input name")
-	}
-	if !strings.Contains(html, `class="collapse-toggle"`) {
-		t.Error("Missing collapse-toggle class")
-	}
-	if !strings.Contains(html, `checked`) {
-		t.Error("Missing checked attribute for open item")
-	}
-
-	// Check labels and content
-	if !strings.Contains(html, `class="collapse-title text-xl font-medium cursor-pointer"`) {
-		t.Error("Missing collapse-title class")
-	}
-	if !strings.Contains(html, `for="item1"`) {
-		t.Error("Missing for attribute for item1")
+	// Check toggles
+	if !strings.Contains(html, `id="item1-toggle" class="accordion-toggle inline-flex items-center gap-x-4 text-start accordion-item-active:text-primary" aria-controls="item1-collapse" aria-expanded="true"`) {
+		t.Error("Missing open item toggle")
 	}
-	if !strings.Contains(html, `for="item2"`) {
-		t.Error("Missing for attribute for item2")
+	if !strings.Contains(html, `aria-controls="item2-collapse" aria-expanded="false"`) {
+		t.Error("Missing closed item toggle")
 	}
-	if !strings.Contains(html, "Item 1") {
-		t.Error("Missing Item 1 title")
-	}
-	if !strings.Contains(html, "Item 2") {
-		t.Error("Missing Item 2 title")
+	if !strings.Contains(html, `icon-[tabler--chevron-right] accordion-item-active:rotate-90`) {
+		t.Error("Missing arrow indicator")
 	}
 
-	// Check content structure
-	if !strings.Contains(html, `class="collapse-content"`) {
-		t.Error("Missing collapse-content class")
-	}
-	if !strings.Contains(html, `class="pb-2"`) {
-		t.Error("Missing pb-2 class")
+	// Check content
+	if !strings.Contains(html, `id="item1-collapse" class="accordion-content w-full overflow-hidden transition-[height] duration-300" aria-labelledby="item1-toggle" role="region"`) {
+		t.Error("Missing open item content")
 	}
-	if !strings.Contains(html, "Content 1") {
-		t.Error("Missing Content 1 text")
+	if !strings.Contains(html, `id="item2-collapse" class="accordion-content w-full overflow-hidden transition-[height] duration-300 hidden"`) {
+		t.Error("Missing hidden content of closed item")
 	}
-	if !strings.Contains(html, "Content 2") {
-		t.Error("Missing Content 2 text")
+	for _, text := range []string{"Item 1", "Item 2", "Content 1", "Content 2"} {
+		if !strings.Contains(html, text) {
+			t.Errorf("Missing %q", text)
+		}
 	}
 }
 
-func TestAccordionComponent_RenderMultiple(t *testing.T) {
+func TestAccordionComponent_RenderAlwaysOpen(t *testing.T) {
 	item1 := NewOpenAccordionItem("item1", "Item 1", gomponents.Text("Content 1"))
-	item2 := NewAccordionItem("item2", "Item 2", gomponents.Text("Content 2"))
-	accordion := NewAccordion(item1, item2).WithID("test-accordion").WithMultiple(true)
+	item2 := NewOpenAccordionItem("item2", "Item 2", gomponents.Text("Content 2"))
+	accordion := NewAccordion(item1, item2).WithAlwaysOpen(true)
 
 	var buf strings.Builder
-	err := accordion.Render(&buf)
-	if err != nil {
+	if err := accordion.Render(&buf); err != nil {
 		t.Fatalf("Render failed: %v", err)
 	}
 
 	html := buf.String()
-
-	// Check multiple mode attributes
-	if !strings.Contains(html, `data-multiple="true"`) {
-		t.Error("Missing data-multiple attribute for multiple mode")
+	if !strings.Contains(html, `data-accordion-always-open=""`) {
+		t.Error("Missing always-open attribute")
 	}
-	if strings.Contains(html, `data-single="true"`) {
-		t.Error("Should not have data-single attribute in multiple mode")
+	if strings.Count(html, `class="accordion-item active"`) != 2 {
+		t.Error("Expected both items to be open")
 	}
+}
 
-	// Check checkbox inputs for multiple mode
-	if !strings.Contains(html, `type="checkbox"`) {
-		t.Error("Missing checkbox input type")
+func TestAccordionComponent_RenderIcons(t *testing.T) {
+	item := NewAccordionItem("item1", "Item 1", gomponents.Text("Content 1"))
+
+	tests := []struct {
+		name      string
+		accordion *AccordionComponent
+		want      []string
+		notWant   string
+	}{
+		{
+			name:      "plus",
+			accordion: NewAccordion(item).WithIcon(AccordionIconPlus),
+			want:      []string{"icon-[tabler--plus] accordion-item-active:hidden", "icon-[tabler--minus] accordion-item-active:block", "justify-between"},
+			notWant:   "chevron",
+		},
+		{
+			name:      "custom",
+			accordion: NewAccordion(item).WithCustomIcons("icon-[tabler--eye-off]", "icon-[tabler--eye]"),
+			want:      []string{"icon-[tabler--eye-off] accordion-item-active:hidden", "icon-[tabler--eye] accordion-item-active:block"},
+			notWant:   "chevron",
+		},
+		{
+			name:      "none",
+			accordion: NewAccordion(item).WithIcon(AccordionIconNone),
+			want:      []string{"<span>Item 1</span>"},
+			notWant:   "icon-[",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			if err := tt.accordion.Render(&buf); err != nil {
+				t.Fatalf("Render failed: %v", err)
+			}
+			html := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(html, want) {
+					t.Errorf("Missing %q in %s", want, html)
+				}
+			}
+			if strings.Contains(html, tt.notWant) {
+				t.Errorf("Unexpected %q in %s", tt.notWant, html)
+			}
+		})
 	}
-	if strings.Contains(html, `type="radio"`) {
-		t.Error("Should not have radio input type in multiple mode")
+}
+
+func TestAccordionComponent_RenderStyles(t *testing.T) {
+	item := NewAccordionItem("item1", "Item 1", gomponents.Text("Content 1"))
+
+	tests := []struct {
+		style AccordionStyle
+		want  string
+	}{
+		{AccordionBordered, `class="accordion accordion-bordered"`},
+		{AccordionShadow, `class="accordion accordion-shadow"`},
+		{AccordionJoined, `class="accordion-item join-item"`},
+	}
+	for _, tt := range tests {
+		var buf strings.Builder
+		if err := NewAccordion(item).WithStyle(tt.style).Render(&buf); err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("Missing %q for style %d", tt.want, tt.style)
+		}
 	}
+}
 
-	// Check unique names for each checkbox
-	if !strings.Contains(html, `name="test-accordion-accordion-item1"`) {
-		t.Error("Missing unique name for item1 checkbox")
+func TestAccordionComponent_RenderColorAndNested(t *testing.T) {
+	nested := NewAccordion(NewAccordionItem("inner", "Inner", gomponents.Text("Inner content"))).WithID("nested")
+	accordion := NewAccordion(NewOpenAccordionItem("outer", "Outer", nested)).WithColor(flyon.Secondary)
+
+	var buf strings.Builder
+	if err := accordion.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, "accordion-item-active:text-secondary") {
+		t.Error("Missing color of open items")
 	}
-	if !strings.Contains(html, `name="test-accordion-accordion-item2"`) {
-		t.Error("Missing unique name for item2 checkbox")
+	if !strings.Contains(html, `<div class="px-5 pb-4"><div id="nested" class="accordion`) {
+		t.Error("Missing nested accordion inside the outer item")
 	}
 }
 
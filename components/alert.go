@@ -12,30 +12,40 @@ import (
 
 // AlertComponent represents an alert UI component
 type AlertComponent struct {
-	classes    []string
-	attributes []g.Node
-	children   []g.Node
+	classes     []string
+	attributes  []g.Node
+	children    []g.Node
+	id          string
+	role        string
+	color       flyon.Color
+	colorSet    bool
+	title       string
+	icon        string
+	iconSet     bool
+	list        []string
+	actions     []g.Node
+	dismissible bool
 }
 
-// NewAlert creates a new alert component with the given children
+// alertIcons are the default icons of WithIcon per intent
+var alertIcons = map[flyon.Color]string{
+	flyon.Success: "icon-[tabler--circle-check]",
+	flyon.Warning: "icon-[tabler--alert-triangle]",
+	flyon.Error:   "icon-[tabler--alert-circle]",
+}
+
+// NewAlert creates a new alert component with the given children. Attribute
+// nodes are set on the alert element, except the ID and role, which are set
+// with WithID and WithRole.
 func NewAlert(children ...g.Node) *AlertComponent {
 	// Separate attributes from content children
 	var attributes []g.Node
 	var content []g.Node
 	
 	for _, child := range children {
-		// Check if this is an attribute by trying to render it and seeing if it produces attribute-like output
-		var buf strings.Builder
-		if err := child.Render(&buf); err == nil {
-			output := buf.String()
-			// If the output contains '=' it's likely an attribute
-			if strings.Contains(output, "=") || strings.Contains(output, "disabled") {
-				attributes = append(attributes, child)
-			} else {
-				content = append(content, child)
-			}
+		if typed, ok := child.(interface{ Type() g.NodeType }); ok && typed.Type() == g.AttributeType {
+			attributes = append(attributes, child)
 		} else {
-			// If we can't render it, assume it's content
 			content = append(content, child)
 		}
 	}
@@ -49,19 +59,14 @@ func NewAlert(children ...g.Node) *AlertComponent {
 
 // With applies modifiers to the alert and returns a new instance
 func (a *AlertComponent) With(modifiers ...any) flyon.Component {
-	newAlert := &AlertComponent{
-		classes:    make([]string, len(a.classes)),
-		attributes: make([]g.Node, len(a.attributes)),
-		children:   make([]g.Node, len(a.children)),
-	}
-	copy(newAlert.classes, a.classes)
-	copy(newAlert.attributes, a.attributes)
-	copy(newAlert.children, a.children)
+	newAlert := a.copy()
 
 	for _, modifier := range modifiers {
 		switch m := modifier.(type) {
 		case flyon.Color:
 			newAlert.classes = append(newAlert.classes, "alert-"+m.String())
+			newAlert.color = m
+			newAlert.colorSet = true
 		case flyon.Size:
 			newAlert.classes = append(newAlert.classes, "alert-"+m.String())
 		case flyon.Variant:
@@ -72,12 +77,159 @@ func (a *AlertComponent) With(modifiers ...any) flyon.Component {
 	return newAlert
 }
 
+// WithID sets the alert ID, which dismissible alerts need; one is generated
+// otherwise.
+func (a *AlertComponent) WithID(id string) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.id = id
+	return newAlert
+}
+
+// WithRole replaces the role derived from the alert color: "alert" for
+// errors and warnings, which interrupt screen readers, and a polite "status"
+// otherwise.
+func (a *AlertComponent) WithRole(role string) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.role = role
+	return newAlert
+}
+
+// WithTitle sets a heading above the content
+func (a *AlertComponent) WithTitle(title string) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.title = title
+	return newAlert
+}
+
+// WithIcon shows an icon class, e.g. "icon-[tabler--bell]", before the
+// content. An empty icon picks the default of the alert color.
+func (a *AlertComponent) WithIcon(icon string) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.icon = icon
+	newAlert.iconSet = true
+	return newAlert
+}
+
+// WithList adds a bulleted list below the content
+func (a *AlertComponent) WithList(items ...string) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.list = append(newAlert.list, items...)
+	return newAlert
+}
+
+// WithActions adds buttons or links below the content
+func (a *AlertComponent) WithActions(actions ...g.Node) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.actions = append(newAlert.actions, actions...)
+	return newAlert
+}
+
+// WithDismissible adds a close button removing the alert through FlyonUI's
+// data-remove-element, or the WASM behavior when FlyonUI is not loaded
+func (a *AlertComponent) WithDismissible(dismissible bool) *AlertComponent {
+	newAlert := a.copy()
+	newAlert.dismissible = dismissible
+	return newAlert
+}
+
+// copy creates a deep copy of the alert
+func (a *AlertComponent) copy() *AlertComponent {
+	newAlert := *a
+	newAlert.classes = make([]string, len(a.classes))
+	newAlert.attributes = make([]g.Node, len(a.attributes))
+	newAlert.children = make([]g.Node, len(a.children))
+	copy(newAlert.classes, a.classes)
+	copy(newAlert.attributes, a.attributes)
+	copy(newAlert.children, a.children)
+	newAlert.list = append([]string(nil), a.list...)
+	newAlert.actions = append([]g.Node(nil), a.actions...)
+	return &newAlert
+}
+
+// iconClass returns the icon to show, if any
+func (a *AlertComponent) iconClass() string {
+	if !a.iconSet {
+		return ""
+	}
+	if a.icon != "" {
+		return a.icon
+	}
+	if icon, ok := alertIcons[a.color]; ok && a.colorSet {
+		return icon
+	}
+	return "icon-[tabler--info-circle]"
+}
+
 // Render renders the alert component to HTML
 func (a *AlertComponent) Render(w io.Writer) error {
-	classAttr := h.Class(strings.Join(a.classes, " "))
-	allAttributes := append([]g.Node{classAttr}, a.attributes...)
-	allNodes := append(allAttributes, a.children...)
-	return h.Div(allNodes...).Render(w)
+	classes := a.classes
+	structured := a.title != "" || a.iconSet || len(a.list) > 0 || len(a.actions) > 0 || a.dismissible
+	if structured {
+		align := "items-center"
+		if a.title != "" || len(a.list) > 0 || len(a.actions) > 0 {
+			align = "items-start"
+		}
+		classes = append(append([]string(nil), classes...), "flex", align, "gap-4")
+	}
+	if a.dismissible {
+		classes = append(classes, "removing:translate-x-5", "removing:opacity-0", "transition", "duration-300", "ease-in-out")
+	}
+
+	allAttributes := []g.Node{h.Class(strings.Join(classes, " "))}
+	id := a.id
+	if a.dismissible && id == "" {
+		id = "alert-" + generateID()
+	}
+	if id != "" {
+		allAttributes = append(allAttributes, h.ID(id))
+	}
+	// Errors and warnings interrupt screen readers, other intents wait their turn
+	switch {
+	case a.role != "":
+		allAttributes = append(allAttributes, h.Role(a.role))
+	case a.colorSet && (a.color == flyon.Error || a.color == flyon.Warning):
+		allAttributes = append(allAttributes, h.Role("alert"))
+	default:
+		allAttributes = append(allAttributes, h.Role("status"), g.Attr("aria-live", "polite"))
+	}
+	allAttributes = append(allAttributes, a.attributes...)
+
+	if !structured {
+		return h.Div(append(allAttributes, a.children...)...).Render(w)
+	}
+
+	nodes := allAttributes
+	if icon := a.iconClass(); icon != "" {
+		nodes = append(nodes, h.Span(h.Class(icon+" size-6 shrink-0"), g.Attr("aria-hidden", "true")))
+	}
+	body := []g.Node{h.Class("flex flex-col gap-1")}
+	if a.title != "" {
+		body = append(body, h.H5(h.Class("text-lg font-semibold"), g.Text(a.title)))
+	}
+	body = append(body, a.children...)
+	if len(a.list) > 0 {
+		items := make([]g.Node, len(a.list))
+		for i, item := range a.list {
+			items[i] = h.Li(g.Text(item))
+		}
+		body = append(body, h.Ul(h.Class("mt-1.5 list-inside list-disc"), g.Group(items)))
+	}
+	if len(a.actions) > 0 {
+		body = append(body, h.Div(h.Class("mt-4 flex gap-2"), g.Group(a.actions)))
+	}
+	nodes = append(nodes, h.Div(body...))
+
+	if a.dismissible {
+		nodes = append(nodes, h.Button(
+			h.Type("button"),
+			h.Class("ms-auto cursor-pointer leading-none"),
+			g.Attr("aria-label", "Close"),
+			g.Attr("data-remove-element", "#"+id),
+			g.Attr("data-alert-dismiss", ""),
+			h.Span(h.Class("icon-[tabler--x] size-5"), g.Attr("aria-hidden", "true")),
+		))
+	}
+	return h.Div(nodes...).Render(w)
 }
 
 // Interface compliance checks
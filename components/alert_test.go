@@ -197,11 +197,9 @@ func TestAlert_CombinedModifiers(t *testing.T) {
 func TestAlert_HTMLAttributes(t *testing.T) {
 	t.Run("accepts HTML attributes", func(t *testing.T) {
 		alert := NewAlert(
-			h.ID("main-alert"),
 			h.DataAttr("testid", "alert-component"),
-			h.Role("alert"),
 			g.Text("Alert content"),
-		)
+		).WithID("main-alert").WithRole("alert")
 		html := renderToHTML(alert)
 		
 		doc, err := parseHTML(html)
@@ -226,6 +224,13 @@ func TestAlert_HTMLAttributes(t *testing.T) {
 			t.Errorf("Expected role='alert', got role='%s'", role)
 		}
 	})
+
+	t.Run("keeps text that looks like an attribute", func(t *testing.T) {
+		html := renderToHTML(NewAlert(g.Text("a=b"), g.Text(" disabled accounts")))
+		if !strings.Contains(html, `>a=b disabled accounts</div>`) {
+			t.Errorf("Expected text children to stay content, got: %s", html)
+		}
+	})
 }
 
 func TestAlert_ComponentInterface(t *testing.T) {
@@ -244,4 +249,51 @@ func TestAlert_ComponentInterface(t *testing.T) {
 			t.Error("With() should return a new instance, not modify the original")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestAlert_Structured(t *testing.T) {
+	t.Run("renders title, default icon, list and actions", func(t *testing.T) {
+		alert := NewAlert(g.Text("Check your password.")).With(flyon.Warning).(*AlertComponent).
+			WithTitle("Weak password").WithIcon("").WithList("At least 10 characters", "One symbol").
+			WithActions(h.A(h.Href("/help"), g.Text("Help")))
+		html := renderToHTML(alert)
+
+		for _, want := range []string{
+			`class="alert alert-warning flex items-start gap-4"`,
+			`role="alert"`,
+			`<span class="icon-[tabler--alert-triangle] size-6 shrink-0" aria-hidden="true"></span>`,
+			`<h5 class="text-lg font-semibold">Weak password</h5>`,
+			`<ul class="mt-1.5 list-inside list-disc"><li>At least 10 characters</li><li>One symbol</li></ul>`,
+			`<div class="mt-4 flex gap-2"><a href="/help">Help</a></div>`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("Expected %s in alert, got: %s", want, html)
+			}
+		}
+	})
+
+	t.Run("sets live region semantics by intent", func(t *testing.T) {
+		html := renderToHTML(NewAlert(g.Text("Saved")).With(flyon.Success))
+		if !strings.Contains(html, `role="status" aria-live="polite"`) {
+			t.Errorf("Expected a polite status, got: %s", html)
+		}
+		custom := renderToHTML(NewAlert(g.Text("Note")).WithRole("note").With(flyon.Error))
+		if strings.Count(custom, "role=") != 1 || !strings.Contains(custom, `role="note"`) {
+			t.Errorf("Expected a custom role to win, got: %s", custom)
+		}
+	})
+
+	t.Run("renders a dismiss button", func(t *testing.T) {
+		html := renderToHTML(NewAlert(g.Text("Hi")).WithID("hello").WithDismissible(true))
+		for _, want := range []string{
+			`id="hello"`,
+			`removing:opacity-0`,
+			`data-remove-element="#hello"`,
+			`data-alert-dismiss`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("Expected %s in alert, got: %s", want, html)
+			}
+		}
+	})
+}
@@ -11,35 +11,37 @@ import (
 
 // AutocompleteComponent represents an autocomplete input with dropdown options
 type AutocompleteComponent struct {
-	id         string
-	name       string
+	id          string
+	name        string
 	placeholder string
-	value      string
-	disabled   bool
-	color      flyon.Color
-	size       flyon.Size
-	colorSet   bool
-	sizeSet    bool
-	options    []string
-	classes    []string
-	attributes map[string]string
+	value       string
+	valueLabel  string
+	disabled    bool
+	color       flyon.Color
+	size        flyon.Size
+	colorSet    bool
+	sizeSet     bool
+	options     []string
+	source      *AutocompleteSource
+	classes     []string
+	attributes  map[string]string
 }
 
 // NewAutocomplete creates a new Autocomplete component with default values
 func NewAutocomplete() *AutocompleteComponent {
 	return &AutocompleteComponent{
-		id:         "",
-		name:       "",
+		id:          "",
+		name:        "",
 		placeholder: "",
-		value:      "",
-		disabled:   false,
-		color:      flyon.Primary,
-		size:       flyon.SizeMedium,
-		colorSet:   false,
-		sizeSet:    false,
-		options:    []string{},
-		classes:    []string{},
-		attributes: make(map[string]string),
+		value:       "",
+		disabled:    false,
+		color:       flyon.Primary,
+		size:        flyon.SizeMedium,
+		colorSet:    false,
+		sizeSet:     false,
+		options:     []string{},
+		classes:     []string{},
+		attributes:  make(map[string]string),
 	}
 }
 
@@ -71,6 +73,15 @@ func (ac *AutocompleteComponent) WithValue(value string) *AutocompleteComponent
 	return new
 }
 
+// WithValueLabel sets the text shown for the value of an autocomplete with a
+// remote source, whose options are only fetched as the user types. With a
+// source the value is submitted by the hidden input and never shown.
+func (ac *AutocompleteComponent) WithValueLabel(label string) *AutocompleteComponent {
+	new := ac.copy()
+	new.valueLabel = label
+	return new
+}
+
 // WithDisabled sets whether the autocomplete input is disabled
 func (ac *AutocompleteComponent) WithDisabled(disabled bool) *AutocompleteComponent {
 	new := ac.copy()
@@ -101,6 +112,13 @@ func (ac *AutocompleteComponent) WithOptions(options ...string) *AutocompleteCom
 	return new
 }
 
+// WithSource loads the dropdown options from a remote data source as the user types
+func (ac *AutocompleteComponent) WithSource(source AutocompleteSource) *AutocompleteComponent {
+	new := ac.copy()
+	new.source = &source
+	return new
+}
+
 // WithClasses adds CSS classes to the autocomplete input
 func (ac *AutocompleteComponent) WithClasses(classes ...string) *AutocompleteComponent {
 	new := ac.copy()
@@ -152,18 +170,20 @@ func (ac *AutocompleteComponent) copy() *AutocompleteComponent {
 	}
 
 	return &AutocompleteComponent{
-		id:         ac.id,
-		name:       ac.name,
+		id:          ac.id,
+		name:        ac.name,
 		placeholder: ac.placeholder,
-		value:      ac.value,
-		disabled:   ac.disabled,
-		color:      ac.color,
-		size:       ac.size,
-		colorSet:   ac.colorSet,
-		sizeSet:    ac.sizeSet,
-		options:    newOptions,
-		classes:    newClasses,
-		attributes: newAttributes,
+		value:       ac.value,
+		valueLabel:  ac.valueLabel,
+		disabled:    ac.disabled,
+		color:       ac.color,
+		size:        ac.size,
+		colorSet:    ac.colorSet,
+		sizeSet:     ac.sizeSet,
+		options:     newOptions,
+		source:      ac.source,
+		classes:     newClasses,
+		attributes:  newAttributes,
 	}
 }
 
@@ -205,23 +225,40 @@ func (ac *AutocompleteComponent) Render(w io.Writer) error {
 	// Add custom classes
 	classes = append(classes, ac.classes...)
 
+	// Generate ID if not provided; the listbox and its options derive from it
+	id := ac.id
+	if id == "" {
+		id = "autocomplete-" + generateID()
+	}
+	listboxID := id + "-listbox"
+
 	// Build input attributes
 	inputAttrs := []g.Node{
 		h.Type("text"),
 		h.Class(strings.Join(classes, " ")),
+		h.ID(id),
 	}
+	inputAttrs = append(inputAttrs, comboboxInputAttrs(listboxID)...)
 
-	if ac.id != "" {
-		inputAttrs = append(inputAttrs, h.ID(ac.id))
-	}
+	// Remote results carry values distinct from their labels, so the name moves
+	// to a hidden input kept in sync by the WASM behavior
+	var hiddenInput g.Node
 	if ac.name != "" {
-		inputAttrs = append(inputAttrs, h.Name(ac.name))
+		if ac.source != nil {
+			hiddenInput = comboboxHiddenInput(ac.name, ac.value)
+		} else {
+			inputAttrs = append(inputAttrs, h.Name(ac.name))
+		}
 	}
 	if ac.placeholder != "" {
 		inputAttrs = append(inputAttrs, h.Placeholder(ac.placeholder))
 	}
-	if ac.value != "" {
-		inputAttrs = append(inputAttrs, h.Value(ac.value))
+	displayValue := ac.value
+	if ac.source != nil {
+		displayValue = ac.valueLabel
+	}
+	if displayValue != "" {
+		inputAttrs = append(inputAttrs, h.Value(displayValue))
 	}
 	if ac.disabled {
 		inputAttrs = append(inputAttrs, h.Disabled())
@@ -234,24 +271,35 @@ func (ac *AutocompleteComponent) Render(w io.Writer) error {
 
 	// Build dropdown options
 	var dropdownItems []g.Node
-	for _, option := range ac.options {
-		dropdownItems = append(dropdownItems, h.Li(
-			h.A(
-				h.Href("#"),
-				g.Text(option),
-			),
+	for i, option := range ac.options {
+		dropdownItems = append(dropdownItems, listboxOption(
+			listboxOptionID(listboxID, i),
+			AutocompleteOption{Value: option, Label: option},
+			option == ac.value,
+			false,
 		))
 	}
 
+	// Remote source settings are read by the WASM behavior from the wrapper
+	var sourceAttrs []g.Node
+	if ac.source != nil {
+		sourceAttrs = ac.source.attributes()
+	}
+
 	// Create the autocomplete structure with dropdown
 	node := h.Div(
 		h.Class("dropdown"),
+		g.Attr("data-combobox", "autocomplete"),
+		g.Group(sourceAttrs),
 		h.Input(inputAttrs...),
+		hiddenInput,
 		h.Ul(
+			h.ID(listboxID),
 			h.Class("dropdown-content menu bg-base-100 rounded-box z-[1] w-52 p-2 shadow"),
+			h.Role("listbox"),
 			g.Group(dropdownItems),
 		),
 	)
 
 	return node.Render(w)
-}
\ No newline at end of file
+}
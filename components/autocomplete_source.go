@@ -0,0 +1,156 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	g "maragu.dev/gomponents"
+)
+
+// AutocompleteOption represents a single result returned by a remote data source
+type AutocompleteOption struct {
+	Value       string `json:"value"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+}
+
+// AutocompleteSource configures the remote data source of an autocomplete or combobox.
+// It is rendered as data-autocomplete-* attributes picked up by the WASM behavior.
+type AutocompleteSource struct {
+	// URL is the endpoint queried with the q, limit and format parameters
+	URL string
+	// Debounce is the delay after the last keystroke before a request is sent
+	Debounce time.Duration
+	// MinChars is the minimum query length that triggers a request
+	MinChars int
+	// Limit is the maximum number of results requested
+	Limit int
+}
+
+// Default values used when an AutocompleteSource leaves a setting unset
+const (
+	DefaultAutocompleteDebounce = 250 * time.Millisecond
+	DefaultAutocompleteMinChars = 1
+	DefaultAutocompleteLimit    = 10
+)
+
+// attributes returns the data attributes describing the source
+func (s AutocompleteSource) attributes() []g.Node {
+	debounce := s.Debounce
+	if debounce <= 0 {
+		debounce = DefaultAutocompleteDebounce
+	}
+	minChars := s.MinChars
+	if minChars <= 0 {
+		minChars = DefaultAutocompleteMinChars
+	}
+	limit := s.Limit
+	if limit <= 0 {
+		limit = DefaultAutocompleteLimit
+	}
+
+	return []g.Node{
+		g.Attr("data-autocomplete-url", s.URL),
+		g.Attr("data-autocomplete-debounce", strconv.FormatInt(debounce.Milliseconds(), 10)),
+		g.Attr("data-autocomplete-min-chars", strconv.Itoa(minChars)),
+		g.Attr("data-autocomplete-limit", strconv.Itoa(limit)),
+	}
+}
+
+// AutocompleteItem renders a single option as a listbox option.
+// The remote handler uses it for HTML fragments so server and client markup match;
+// the WASM behavior assigns IDs to fetched options.
+func AutocompleteItem(option AutocompleteOption) g.Node {
+	return listboxOption("", option, false, false)
+}
+
+// AutocompleteSearchFunc looks up at most limit options matching query
+type AutocompleteSearchFunc func(ctx context.Context, query string, limit int) ([]AutocompleteOption, error)
+
+// AutocompleteHandler is an http.Handler serving autocomplete results from a search func.
+// Results are returned as JSON, or as an HTML fragment of menu items when the
+// request asks for format=html or only accepts text/html.
+type AutocompleteHandler struct {
+	search       AutocompleteSearchFunc
+	defaultLimit int
+	maxLimit     int
+}
+
+// NewAutocompleteHandler creates a new handler backed by the given search func
+func NewAutocompleteHandler(search AutocompleteSearchFunc) *AutocompleteHandler {
+	return &AutocompleteHandler{
+		search:       search,
+		defaultLimit: DefaultAutocompleteLimit,
+		maxLimit:     50,
+	}
+}
+
+// WithMaxLimit caps the number of results a client may request
+func (a *AutocompleteHandler) WithMaxLimit(limit int) *AutocompleteHandler {
+	newHandler := *a
+	newHandler.maxLimit = limit
+	return &newHandler
+}
+
+// ServeHTTP implements http.Handler
+func (a *AutocompleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	limit := a.defaultLimit
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if a.maxLimit > 0 && limit > a.maxLimit {
+		limit = a.maxLimit
+	}
+
+	options, err := a.search(r.Context(), query, limit)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if len(options) > limit {
+		options = options[:limit]
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if wantsHTMLFragment(r) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		items := make([]g.Node, 0, len(options))
+		for _, option := range options {
+			items = append(items, AutocompleteItem(option))
+		}
+		_ = g.Group(items).Render(w)
+		return
+	}
+
+	if options == nil {
+		options = []AutocompleteOption{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(options)
+}
+
+// wantsHTMLFragment reports whether the request asks for an HTML response
+func wantsHTMLFragment(r *http.Request) bool {
+	switch r.URL.Query().Get("format") {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}
+
+// Ensure AutocompleteHandler implements http.Handler
+var _ http.Handler = (*AutocompleteHandler)(nil)
@@ -0,0 +1,201 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAutocompleteComponent_WithSource(t *testing.T) {
+	original := NewAutocomplete()
+	modified := original.WithSource(AutocompleteSource{URL: "/customers", Debounce: 300 * time.Millisecond, MinChars: 2})
+	html := renderToStringAutocomplete(modified)
+
+	expected := []string{
+		`data-autocomplete-url="/customers"`,
+		`data-autocomplete-debounce="300"`,
+		`data-autocomplete-min-chars="2"`,
+		`data-autocomplete-limit="10"`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+
+	if original.source != nil {
+		t.Error("Original autocomplete should not be modified")
+	}
+}
+
+func TestAutocompleteComponent_WithSourceValue(t *testing.T) {
+	html := renderToStringAutocomplete(NewAutocomplete().
+		WithName("customer").
+		WithValue("42").
+		WithValueLabel("Ada Lovelace").
+		WithSource(AutocompleteSource{URL: "/customers"}))
+
+	if !strings.Contains(html, `value="Ada Lovelace"`) {
+		t.Errorf("Expected the visible input to show the label, got %s", html)
+	}
+	if !strings.Contains(html, `name="customer" value="42"`) || strings.Count(html, `value="42"`) != 1 {
+		t.Errorf("Expected the value only in the hidden input, got %s", html)
+	}
+
+	unlabelled := renderToStringAutocomplete(NewAutocomplete().WithName("customer").WithValue("42").WithSource(AutocompleteSource{URL: "/customers"}))
+	if strings.Count(unlabelled, `value="42"`) != 1 {
+		t.Errorf("Expected the visible input not to show the raw value, got %s", unlabelled)
+	}
+}
+
+func TestComboboxComponent_WithSource(t *testing.T) {
+	combobox := NewCombobox().WithSource(AutocompleteSource{URL: "/customers"})
+	html := renderToStringAutocomplete(combobox)
+
+	if !strings.Contains(html, `data-autocomplete-url="/customers"`) {
+		t.Errorf("Expected HTML to contain source URL, got %s", html)
+	}
+	if !strings.Contains(html, `data-autocomplete-debounce="250"`) {
+		t.Errorf("Expected HTML to contain default debounce, got %s", html)
+	}
+	// The menu must exist so results have somewhere to go
+	if !strings.Contains(html, "<ul") {
+		t.Errorf("Expected HTML to contain an empty menu, got %s", html)
+	}
+}
+
+func TestAutocompleteItem(t *testing.T) {
+	html := renderNodeToString(AutocompleteItem(AutocompleteOption{Value: "42", Label: "Acme", Description: "Berlin"}))
+
+	expected := []string{`role="option"`, `data-value="42"`, `data-label="Acme"`, `<span data-option-label>Acme</span>`, `Berlin</span>`}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+
+	html = renderNodeToString(AutocompleteItem(AutocompleteOption{Value: "1", Label: "Plain"}))
+	if html != `<li class="dropdown-item" role="option" data-value="1" data-label="Plain" aria-selected="false"><span data-option-label>Plain</span></li>` {
+		t.Errorf("Unexpected item markup: %s", html)
+	}
+}
+
+func newTestAutocompleteHandler(gotQuery *string, gotLimit *int) *AutocompleteHandler {
+	return NewAutocompleteHandler(func(ctx context.Context, query string, limit int) ([]AutocompleteOption, error) {
+		*gotQuery = query
+		*gotLimit = limit
+		if query == "fail" {
+			return nil, errors.New("boom")
+		}
+		return []AutocompleteOption{
+			{Value: "1", Label: "Acme <Corp>"},
+			{Value: "2", Label: "Acme Ltd"},
+		}, nil
+	})
+}
+
+func TestAutocompleteHandler_JSON(t *testing.T) {
+	var query string
+	var limit int
+	handler := newTestAutocompleteHandler(&query, &limit)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?q=+acme+&limit=5", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	if query != "acme" || limit != 5 {
+		t.Errorf("Expected search(acme, 5), got search(%q, %d)", query, limit)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Expected JSON content type, got %s", ct)
+	}
+
+	var options []AutocompleteOption
+	if err := json.Unmarshal(rec.Body.Bytes(), &options); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(options) != 2 || options[0].Label != "Acme <Corp>" {
+		t.Errorf("Unexpected options: %+v", options)
+	}
+}
+
+func TestAutocompleteHandler_HTMLFragment(t *testing.T) {
+	var query string
+	var limit int
+	handler := newTestAutocompleteHandler(&query, &limit)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?q=acme&format=html", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("Expected HTML content type, got %s", ct)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `<span data-option-label>Acme &lt;Corp&gt;</span>`) {
+		t.Errorf("Expected escaped item markup, got %s", body)
+	}
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/?q=acme", nil)
+	req.Header.Set("Accept", "text/html")
+	handler.ServeHTTP(rec, req)
+	if !strings.Contains(rec.Body.String(), "<li ") {
+		t.Errorf("Expected Accept: text/html to produce a fragment, got %s", rec.Body.String())
+	}
+}
+
+func TestAutocompleteHandler_Limits(t *testing.T) {
+	var query string
+	var limit int
+	handler := newTestAutocompleteHandler(&query, &limit).WithMaxLimit(1)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?q=a&limit=500", nil))
+
+	if limit != 1 {
+		t.Errorf("Expected limit to be capped at 1, got %d", limit)
+	}
+	var options []AutocompleteOption
+	if err := json.Unmarshal(rec.Body.Bytes(), &options); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(options) != 1 {
+		t.Errorf("Expected results to be truncated to the limit, got %d", len(options))
+	}
+
+	rec = httptest.NewRecorder()
+	NewAutocompleteHandler(func(context.Context, string, int) ([]AutocompleteOption, error) {
+		return nil, nil
+	}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?q=a", nil))
+	if strings.TrimSpace(rec.Body.String()) != "[]" {
+		t.Errorf("Expected empty JSON array, got %s", rec.Body.String())
+	}
+}
+
+func TestAutocompleteHandler_Errors(t *testing.T) {
+	var query string
+	var limit int
+	handler := newTestAutocompleteHandler(&query, &limit)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?q=fail", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "boom") {
+		t.Errorf("Expected search errors not to leak to the client, got %s", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", rec.Code)
+	}
+}
@@ -261,4 +261,46 @@ func TestAutocompleteComponent_Immutability(t *testing.T) {
 	if modified.id != "new-id" {
 		t.Errorf("Expected modified ID to be 'new-id', got %s", modified.id)
 	}
-}
\ No newline at end of file
+}
+
+func TestAutocompleteComponent_ARIA(t *testing.T) {
+	html := renderToStringAutocomplete(NewAutocomplete().
+		WithID("city").
+		WithName("city").
+		WithValue("Paris").
+		WithOptions("Berlin", "Paris"))
+
+	expected := []string{
+		`data-combobox="autocomplete"`,
+		`role="combobox"`,
+		`aria-controls="city-listbox"`,
+		`<ul id="city-listbox"`,
+		`role="listbox"`,
+		`id="city-listbox-option-1"`,
+		`data-value="Paris" data-label="Paris" aria-selected="true"`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+
+	// Static options submit the typed text, so the input keeps its name
+	if strings.Contains(html, `type="hidden"`) {
+		t.Errorf("Expected no hidden input for static options, got %s", html)
+	}
+}
+
+func TestAutocompleteComponent_ARIARemoteHiddenInput(t *testing.T) {
+	html := renderToStringAutocomplete(NewAutocomplete().
+		WithName("customer").
+		WithValue("42").
+		WithSource(AutocompleteSource{URL: "/customers"}))
+
+	if !strings.Contains(html, `<input type="hidden" name="customer" value="42" data-combobox-value>`) {
+		t.Errorf("Expected hidden input carrying the value, got %s", html)
+	}
+	if strings.Count(html, `name="customer"`) != 1 {
+		t.Errorf("Expected only the hidden input to be named, got %s", html)
+	}
+}
@@ -14,6 +14,7 @@ type ButtonComponent struct {
 	children   []gomponents.Node
 	attributes []gomponents.Node
 	classes    []string
+	loading    bool
 }
 
 // NewButton creates a new button component with FlyonUI styling
@@ -53,6 +54,7 @@ func (b *ButtonComponent) With(modifiers ...any) flyon.Component {
 		children:   make([]gomponents.Node, len(b.children)),
 		attributes: make([]gomponents.Node, len(b.attributes)),
 		classes:    make([]string, len(b.classes)),
+		loading:    b.loading,
 	}
 	
 	// Copy children, attributes, and classes
@@ -69,12 +71,26 @@ func (b *ButtonComponent) With(modifiers ...any) flyon.Component {
 			newBtn.classes = append(newBtn.classes, "btn-"+m.String())
 		case flyon.Variant:
 			newBtn.classes = append(newBtn.classes, "btn-"+m.String())
+		case string:
+			newBtn.classes = append(newBtn.classes, m)
 		}
 	}
 	
 	return newBtn
 }
 
+// WithLoading shows a spinner before the content and disables the button
+func (b *ButtonComponent) WithLoading(loading bool) *ButtonComponent {
+	newBtn := b.With().(*ButtonComponent)
+	newBtn.loading = loading
+	return newBtn
+}
+
+// buttonSpinner renders the spinner of a loading button
+func buttonSpinner() gomponents.Node {
+	return h.Span(h.Class("loading loading-spinner loading-sm"), gomponents.Attr("data-button-loading", ""))
+}
+
 // Render implements the gomponents.Node interface
 func (b *ButtonComponent) Render(w io.Writer) error {
 	// Build the class attribute
@@ -84,6 +100,13 @@ func (b *ButtonComponent) Render(w io.Writer) error {
 	allNodes := make([]gomponents.Node, 0, len(b.attributes)+len(b.children)+1)
 	allNodes = append(allNodes, h.Class(classAttr))
 	allNodes = append(allNodes, b.attributes...)
+	if b.loading {
+		allNodes = append(allNodes,
+			h.Disabled(),
+			gomponents.Attr("aria-busy", "true"),
+			buttonSpinner(),
+		)
+	}
 	allNodes = append(allNodes, b.children...)
 	
 	buttonEl := h.Button(allNodes...)
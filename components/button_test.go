@@ -302,4 +302,18 @@ func TestButton_ComponentInterface(t *testing.T) {
 			t.Error("With() should return a new instance, not modify the original")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestButtonComponent_WithLoading(t *testing.T) {
+	button := NewButton(gomponents.Text("Save")).WithLoading(true).With(flyon.Primary)
+	var sb strings.Builder
+	if err := button.Render(&sb); err != nil {
+		t.Fatal(err)
+	}
+	html := sb.String()
+	for _, want := range []string{`class="btn btn-primary"`, `disabled`, `aria-busy="true"`, `<span class="loading loading-spinner loading-sm" data-button-loading=""></span>Save`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in loading button, got: %s", want, html)
+		}
+	}
+}
@@ -0,0 +1,481 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// CalendarView selects the period shown by a CalendarComponent
+type CalendarView int
+
+const (
+	// CalendarMonthView shows the weeks of a month
+	CalendarMonthView CalendarView = iota
+	// CalendarWeekView shows a single week
+	CalendarWeekView
+)
+
+// String returns the name of the view, suitable for query parameters
+func (v CalendarView) String() string {
+	if v == CalendarWeekView {
+		return "week"
+	}
+	return "month"
+}
+
+// CalendarEvent is an event shown on the calendar
+type CalendarEvent struct {
+	Start time.Time
+	// End is exclusive when it falls on midnight; a zero End makes the event
+	// last a single day
+	End   time.Time
+	Title string
+	Color flyon.Color
+}
+
+// CalendarURLFunc builds the URL of the calendar for a view and date. It is
+// used for the previous/next/today navigation, the view switch and the
+// "+N more" links.
+type CalendarURLFunc func(view CalendarView, date time.Time) string
+
+// CalendarLocale holds the texts and the first day of the week of a calendar
+type CalendarLocale struct {
+	FirstWeekday time.Weekday
+	// MonthNames are indexed by time.Month - 1
+	MonthNames [12]string
+	// DayNames are short weekday names indexed by time.Weekday
+	DayNames [7]string
+	Previous string
+	Next     string
+	Today    string
+	Month    string
+	Week     string
+	// More is a fmt format receiving the number of hidden events
+	More string
+}
+
+// DefaultCalendarLocale is the English locale with weeks starting on Sunday
+var DefaultCalendarLocale = CalendarLocale{
+	FirstWeekday: time.Sunday,
+	MonthNames: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	DayNames: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Previous: "Previous",
+	Next:     "Next",
+	Today:    "Today",
+	Month:    "Month",
+	Week:     "Week",
+	More:     "+%d more",
+}
+
+// DefaultCalendarMaxEvents is the number of event lanes shown per day in the month view
+const DefaultCalendarMaxEvents = 3
+
+// CalendarComponent renders a month or week calendar entirely on the server
+type CalendarComponent struct {
+	id         string
+	view       CalendarView
+	date       time.Time
+	today      time.Time
+	location   *time.Location
+	events     []CalendarEvent
+	locale     CalendarLocale
+	maxEvents  int
+	urlFunc    CalendarURLFunc
+	color      flyon.Color
+	classes    []string
+	attributes map[string]string
+}
+
+// NewCalendar creates a month calendar showing the current date
+func NewCalendar() *CalendarComponent {
+	now := time.Now()
+	return &CalendarComponent{
+		view:       CalendarMonthView,
+		date:       now,
+		today:      now,
+		location:   time.Local,
+		locale:     DefaultCalendarLocale,
+		maxEvents:  DefaultCalendarMaxEvents,
+		color:      flyon.Primary,
+		classes:    []string{},
+		attributes: make(map[string]string),
+	}
+}
+
+// WithID sets the ID attribute
+func (c *CalendarComponent) WithID(id string) *CalendarComponent {
+	new := c.copy()
+	new.id = id
+	return new
+}
+
+// WithView sets the month or week view
+func (c *CalendarComponent) WithView(view CalendarView) *CalendarComponent {
+	new := c.copy()
+	new.view = view
+	return new
+}
+
+// WithDate sets a date within the month or week to show
+func (c *CalendarComponent) WithDate(date time.Time) *CalendarComponent {
+	new := c.copy()
+	new.date = date
+	return new
+}
+
+// WithToday sets the date highlighted as today
+func (c *CalendarComponent) WithToday(today time.Time) *CalendarComponent {
+	new := c.copy()
+	new.today = today
+	return new
+}
+
+// WithLocation sets the time zone days are computed in
+func (c *CalendarComponent) WithLocation(location *time.Location) *CalendarComponent {
+	new := c.copy()
+	new.location = location
+	return new
+}
+
+// WithEvents adds events to the calendar
+func (c *CalendarComponent) WithEvents(events ...CalendarEvent) *CalendarComponent {
+	new := c.copy()
+	new.events = append(new.events, events...)
+	return new
+}
+
+// WithLocale sets the texts and first weekday
+func (c *CalendarComponent) WithLocale(locale CalendarLocale) *CalendarComponent {
+	new := c.copy()
+	new.locale = locale
+	return new
+}
+
+// WithFirstWeekday sets the first day of the week, overriding the locale
+func (c *CalendarComponent) WithFirstWeekday(day time.Weekday) *CalendarComponent {
+	new := c.copy()
+	new.locale.FirstWeekday = day
+	return new
+}
+
+// WithMaxEvents sets how many event lanes a day shows in the month view
+// before collapsing the rest into a "+N more" link
+func (c *CalendarComponent) WithMaxEvents(max int) *CalendarComponent {
+	new := c.copy()
+	new.maxEvents = max
+	return new
+}
+
+// WithURLBuilder enables the navigation links, built with fn
+func (c *CalendarComponent) WithURLBuilder(fn CalendarURLFunc) *CalendarComponent {
+	new := c.copy()
+	new.urlFunc = fn
+	return new
+}
+
+// WithClasses adds custom CSS classes
+func (c *CalendarComponent) WithClasses(classes ...string) *CalendarComponent {
+	new := c.copy()
+	new.classes = append(new.classes, classes...)
+	return new
+}
+
+// WithAttribute sets a custom attribute
+func (c *CalendarComponent) WithAttribute(key, value string) *CalendarComponent {
+	new := c.copy()
+	new.attributes[key] = value
+	return new
+}
+
+// With applies modifiers to the calendar. A color sets the today highlight.
+func (c *CalendarComponent) With(modifiers ...any) flyon.Component {
+	new := c.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			new.color = m
+		case string:
+			new.classes = append(new.classes, m)
+		}
+	}
+	return new
+}
+
+// copy creates a deep copy of the component for immutability
+func (c *CalendarComponent) copy() *CalendarComponent {
+	newClasses := make([]string, len(c.classes))
+	copy(newClasses, c.classes)
+
+	newAttributes := make(map[string]string)
+	for k, v := range c.attributes {
+		newAttributes[k] = v
+	}
+
+	newEvents := make([]CalendarEvent, len(c.events))
+	copy(newEvents, c.events)
+
+	return &CalendarComponent{
+		id:         c.id,
+		view:       c.view,
+		date:       c.date,
+		today:      c.today,
+		location:   c.location,
+		events:     newEvents,
+		locale:     c.locale,
+		maxEvents:  c.maxEvents,
+		urlFunc:    c.urlFunc,
+		color:      c.color,
+		classes:    newClasses,
+		attributes: newAttributes,
+	}
+}
+
+// loc returns the location days are computed in
+func (c *CalendarComponent) loc() *time.Location {
+	if c.location == nil {
+		return time.Local
+	}
+	return c.location
+}
+
+// Render generates the HTML for the calendar component
+func (c *CalendarComponent) Render(w io.Writer) error {
+	loc := c.loc()
+	start, end := calendarRange(c.view, c.date, c.locale.FirstWeekday, loc)
+	weeks := calendarWeeks(start, end, c.events, loc)
+
+	classes := append([]string{"calendar", "card", "border", "border-base-content/10"}, c.classes...)
+	attrs := []g.Node{
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-calendar-view", c.view.String()),
+	}
+	if c.id != "" {
+		attrs = append(attrs, h.ID(c.id))
+	}
+	for key, value := range c.attributes {
+		attrs = append(attrs, g.Attr(key, value))
+	}
+
+	weekdays := make([]g.Node, 7)
+	for i := range weekdays {
+		day := time.Weekday((int(c.locale.FirstWeekday) + i) % 7)
+		weekdays[i] = h.Div(h.Class("py-2"), g.Attr("role", "columnheader"), g.Text(c.locale.DayNames[day]))
+	}
+
+	rows := make([]g.Node, len(weeks))
+	for i, week := range weeks {
+		rows[i] = c.renderWeek(week)
+	}
+
+	return h.Div(append(attrs,
+		c.renderHeader(),
+		h.Div(
+			g.Attr("role", "grid"),
+			g.Attr("aria-label", c.title()),
+			h.Div(
+				h.Class("grid grid-cols-7 border-b border-base-content/10 text-center text-sm font-medium text-base-content/70"),
+				g.Attr("role", "row"),
+				g.Group(weekdays),
+			),
+			g.Group(rows),
+		),
+	)...).Render(w)
+}
+
+// title returns the heading of the shown period
+func (c *CalendarComponent) title() string {
+	loc := c.loc()
+	day := startOfDay(c.date, loc)
+	if c.view == CalendarMonthView {
+		return fmt.Sprintf("%s %d", c.locale.MonthNames[day.Month()-1], day.Year())
+	}
+
+	start, end := calendarRange(c.view, c.date, c.locale.FirstWeekday, loc)
+	switch {
+	case start.Year() != end.Year():
+		return fmt.Sprintf("%s %d, %d – %s %d, %d",
+			c.locale.MonthNames[start.Month()-1], start.Day(), start.Year(),
+			c.locale.MonthNames[end.Month()-1], end.Day(), end.Year())
+	case start.Month() != end.Month():
+		return fmt.Sprintf("%s %d – %s %d, %d",
+			c.locale.MonthNames[start.Month()-1], start.Day(),
+			c.locale.MonthNames[end.Month()-1], end.Day(), end.Year())
+	default:
+		return fmt.Sprintf("%s %d – %d, %d", c.locale.MonthNames[start.Month()-1], start.Day(), end.Day(), end.Year())
+	}
+}
+
+// renderHeader renders the title and, with a URL builder, the navigation
+func (c *CalendarComponent) renderHeader() g.Node {
+	heading := h.H2(h.Class("text-lg font-semibold"), g.Text(c.title()))
+	if c.urlFunc == nil {
+		return h.Div(h.Class("card-header flex items-center justify-between gap-4"), heading)
+	}
+
+	day := startOfDay(c.date, c.loc())
+	var prev, next time.Time
+	if c.view == CalendarMonthView {
+		// Step from the first of the month so e.g. January 31 does not skip February
+		first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		prev, next = first.AddDate(0, -1, 0), first.AddDate(0, 1, 0)
+	} else {
+		prev, next = day.AddDate(0, 0, -7), day.AddDate(0, 0, 7)
+	}
+
+	navLink := func(href, label string, content g.Node) g.Node {
+		return h.A(h.Class("btn btn-sm btn-soft join-item"), h.Href(href), g.Attr("aria-label", label), content)
+	}
+	viewLink := func(view CalendarView, label string) g.Node {
+		classes := "btn btn-sm join-item"
+		attrs := []g.Node{h.Href(c.urlFunc(view, c.date))}
+		if view == c.view {
+			classes += " btn-active"
+			attrs = append(attrs, g.Attr("aria-current", "page"))
+		}
+		return h.A(append(attrs, h.Class(classes), g.Text(label))...)
+	}
+
+	return h.Div(
+		h.Class("card-header flex flex-wrap items-center justify-between gap-4"),
+		heading,
+		h.Div(
+			h.Class("flex items-center gap-2"),
+			h.Nav(
+				h.Class("join"),
+				g.Attr("aria-label", c.title()),
+				navLink(c.urlFunc(c.view, prev), c.locale.Previous, h.Span(h.Class("icon-[tabler--chevron-left] size-4"), g.Attr("aria-hidden", "true"))),
+				h.A(h.Class("btn btn-sm btn-soft join-item"), h.Href(c.urlFunc(c.view, c.today)), g.Text(c.locale.Today)),
+				navLink(c.urlFunc(c.view, next), c.locale.Next, h.Span(h.Class("icon-[tabler--chevron-right] size-4"), g.Attr("aria-hidden", "true"))),
+			),
+			h.Div(
+				h.Class("join"),
+				viewLink(CalendarMonthView, c.locale.Month),
+				viewLink(CalendarWeekView, c.locale.Week),
+			),
+		),
+	)
+}
+
+// renderWeek renders one row of the grid. The row is a CSS grid whose first
+// grid row holds the day numbers, followed by one grid row per event lane and,
+// in the month view, a final row for the "+N more" links.
+func (c *CalendarComponent) renderWeek(week calendarWeek) g.Node {
+	visible := week.lanes
+	if c.view == CalendarMonthView && c.maxEvents >= 0 && visible > c.maxEvents {
+		visible = c.maxEvents
+	}
+	hidden := hiddenCounts(week.segments, visible)
+	totalRows := visible + 2
+
+	minHeight := "min-h-28"
+	if c.view == CalendarWeekView {
+		minHeight = "min-h-96"
+	}
+
+	loc := c.loc()
+	today := startOfDay(c.today, loc)
+	month := startOfDay(c.date, loc).Month()
+
+	var nodes []g.Node
+	for col, day := range week.days {
+		classes := []string{minHeight}
+		if col < 6 {
+			classes = append(classes, "border-e", "border-base-content/10")
+		}
+		outside := c.view == CalendarMonthView && day.Month() != month
+		if outside {
+			classes = append(classes, "bg-base-200/50")
+		}
+		nodes = append(nodes, h.Div(
+			h.Class(strings.Join(classes, " ")),
+			g.Attr("role", "gridcell"),
+			g.Attr("style", fmt.Sprintf("grid-column: %d; grid-row: 1 / span %d", col+1, totalRows)),
+		))
+
+		numberClasses := "inline-flex size-7 items-center justify-center rounded-full text-sm"
+		numberAttrs := []g.Node{h.DateTime(day.Format("2006-01-02"))}
+		switch {
+		case day.Equal(today):
+			numberClasses += " bg-" + c.color.String() + " text-" + c.color.String() + "-content font-semibold"
+			numberAttrs = append(numberAttrs, g.Attr("aria-current", "date"))
+		case outside:
+			numberClasses += " text-base-content/40"
+		}
+		nodes = append(nodes, h.Div(
+			h.Class("p-1 text-end"),
+			g.Attr("style", fmt.Sprintf("grid-column: %d; grid-row: 1", col+1)),
+			h.Time(append(numberAttrs, h.Class(numberClasses), g.Text(strconv.Itoa(day.Day())))...),
+		))
+	}
+
+	for _, s := range week.segments {
+		if s.lane >= visible {
+			continue
+		}
+		nodes = append(nodes, c.renderSegment(s))
+	}
+
+	for col, count := range hidden {
+		if count == 0 {
+			continue
+		}
+		label := fmt.Sprintf(c.locale.More, count)
+		style := g.Attr("style", fmt.Sprintf("grid-column: %d; grid-row: %d", col+1, visible+2))
+		if c.urlFunc != nil {
+			nodes = append(nodes, h.A(h.Class("link link-hover px-2 text-xs"), style,
+				h.Href(c.urlFunc(CalendarWeekView, week.days[col])), g.Text(label)))
+		} else {
+			nodes = append(nodes, h.Span(h.Class("px-2 text-xs text-base-content/70"), style, g.Text(label)))
+		}
+	}
+
+	return h.Div(
+		h.Class("grid grid-cols-7 gap-y-1 border-b border-base-content/10 pb-1 last:border-b-0"),
+		g.Attr("role", "row"),
+		g.Attr("style", fmt.Sprintf("grid-template-rows: auto repeat(%d, minmax(0, auto))", totalRows-1)),
+		g.Group(nodes),
+	)
+}
+
+// renderSegment renders the bar of an event within a week row
+func (c *CalendarComponent) renderSegment(s calendarSegment) g.Node {
+	classes := []string{"badge", "badge-soft", "badge-" + s.event.Color.String(), "block", "truncate", "text-start"}
+	// Bars continuing into the previous or next row touch the cell edge
+	if s.continuesBefore {
+		classes = append(classes, "rounded-s-none")
+	} else {
+		classes = append(classes, "ms-1")
+	}
+	if s.continuesAfter {
+		classes = append(classes, "rounded-e-none")
+	} else {
+		classes = append(classes, "me-1")
+	}
+
+	// Timed events show their start time on the first bar
+	title := s.event.Title
+	loc := c.loc()
+	start := s.event.Start.In(loc)
+	if !s.continuesBefore && !start.Equal(startOfDay(start, loc)) {
+		title = start.Format("15:04") + " " + title
+	}
+
+	return h.Div(
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("style", fmt.Sprintf("grid-column: %d / span %d; grid-row: %d", s.col+1, s.span, s.lane+2)),
+		h.Title(title),
+		g.Text(title),
+	)
+}
+
+// Ensure CalendarComponent implements flyon.Component
+var _ flyon.Component = (*CalendarComponent)(nil)
@@ -0,0 +1,181 @@
+package components
+
+import (
+	"sort"
+	"time"
+)
+
+// calendarWeek is one row of the calendar grid
+type calendarWeek struct {
+	// days holds the seven dates of the row, at midnight
+	days [7]time.Time
+	// segments are the event bars of the row, already assigned to lanes
+	segments []calendarSegment
+	// lanes is the number of lanes used by segments
+	lanes int
+}
+
+// calendarSegment is the part of an event that falls within one week row
+type calendarSegment struct {
+	event CalendarEvent
+	// col is the first column (0-6) and span the number of columns covered
+	col  int
+	span int
+	lane int
+	// continuesBefore and continuesAfter report whether the event extends
+	// beyond the row, so the bar can be drawn without a rounded edge
+	continuesBefore bool
+	continuesAfter  bool
+}
+
+// startOfDay returns midnight of the day of t in loc
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// startOfWeek returns the first day of the week containing day
+func startOfWeek(day time.Time, first time.Weekday) time.Time {
+	offset := (int(day.Weekday()) - int(first) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// calendarRange returns the first and last day shown for view around date,
+// including the leading and trailing days that complete the first and last week
+func calendarRange(view CalendarView, date time.Time, first time.Weekday, loc *time.Location) (time.Time, time.Time) {
+	day := startOfDay(date, loc)
+	if view == CalendarWeekView {
+		start := startOfWeek(day, first)
+		return start, start.AddDate(0, 0, 6)
+	}
+
+	monthStart := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, loc)
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	start := startOfWeek(monthStart, first)
+	end := startOfWeek(monthEnd, first).AddDate(0, 0, 6)
+	return start, end
+}
+
+// eventDays returns the first and last day an event occupies. An end at
+// midnight is exclusive, so an event ending at 00:00 does not spill into the
+// following day; a missing or inverted end makes it a single-day event.
+func eventDays(event CalendarEvent, loc *time.Location) (time.Time, time.Time) {
+	first := startOfDay(event.Start, loc)
+	if event.End.IsZero() || !event.End.After(event.Start) {
+		return first, first
+	}
+	last := startOfDay(event.End, loc)
+	if event.End.In(loc).Equal(last) {
+		last = last.AddDate(0, 0, -1)
+	}
+	if last.Before(first) {
+		last = first
+	}
+	return first, last
+}
+
+// daysBetween returns the number of calendar days from a to b. It counts
+// dates rather than hours so daylight saving changes do not skew the result.
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+// calendarWeeks computes the rows of the grid between start and end and lays
+// out the events: each event is split into one segment per row it touches and
+// segments are packed into lanes so that multi-day bars never overlap.
+func calendarWeeks(start, end time.Time, events []CalendarEvent, loc *time.Location) []calendarWeek {
+	var weeks []calendarWeek
+	for weekStart := start; !weekStart.After(end); weekStart = weekStart.AddDate(0, 0, 7) {
+		var week calendarWeek
+		for i := range week.days {
+			week.days[i] = weekStart.AddDate(0, 0, i)
+		}
+		weekEnd := week.days[6]
+
+		for _, event := range events {
+			first, last := eventDays(event, loc)
+			if last.Before(weekStart) || first.After(weekEnd) {
+				continue
+			}
+			segment := calendarSegment{event: event}
+			from, to := first, last
+			if from.Before(weekStart) {
+				from = weekStart
+				segment.continuesBefore = true
+			}
+			if to.After(weekEnd) {
+				to = weekEnd
+				segment.continuesAfter = true
+			}
+			segment.col = daysBetween(weekStart, from)
+			segment.span = daysBetween(from, to) + 1
+			week.segments = append(week.segments, segment)
+		}
+
+		week.lanes = assignLanes(week.segments)
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// assignLanes sorts segments by column, longest first, and puts each one into
+// the first lane that is free for all of its columns. It returns the number of
+// lanes used.
+func assignLanes(segments []calendarSegment) int {
+	sort.SliceStable(segments, func(i, j int) bool {
+		a, b := segments[i], segments[j]
+		if a.col != b.col {
+			return a.col < b.col
+		}
+		if a.span != b.span {
+			return a.span > b.span
+		}
+		return a.event.Start.Before(b.event.Start)
+	})
+
+	var occupied [][7]bool
+	for i := range segments {
+		s := &segments[i]
+		lane := 0
+		for ; lane < len(occupied); lane++ {
+			if laneFree(occupied[lane], s.col, s.span) {
+				break
+			}
+		}
+		if lane == len(occupied) {
+			occupied = append(occupied, [7]bool{})
+		}
+		for c := s.col; c < s.col+s.span; c++ {
+			occupied[lane][c] = true
+		}
+		s.lane = lane
+	}
+	return len(occupied)
+}
+
+// laneFree reports whether columns col to col+span-1 are unused in lane
+func laneFree(lane [7]bool, col, span int) bool {
+	for c := col; c < col+span; c++ {
+		if lane[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// hiddenCounts returns, per column, how many segments do not fit into the
+// first visible lanes
+func hiddenCounts(segments []calendarSegment, visible int) [7]int {
+	var counts [7]int
+	for _, s := range segments {
+		if s.lane < visible {
+			continue
+		}
+		for c := s.col; c < s.col+s.span; c++ {
+			counts[c]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,166 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func renderToStringCalendar(component flyon.Component) string {
+	var sb strings.Builder
+	component.Render(&sb)
+	return sb.String()
+}
+
+func calendarDay(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarRange(t *testing.T) {
+	// February 2024 starts on a Thursday and ends on a Thursday
+	start, end := calendarRange(CalendarMonthView, calendarDay(2024, 2, 14), time.Sunday, time.UTC)
+	if !start.Equal(calendarDay(2024, 1, 28)) || !end.Equal(calendarDay(2024, 3, 2)) {
+		t.Errorf("Expected Jan 28 - Mar 2, got %v - %v", start, end)
+	}
+
+	start, end = calendarRange(CalendarMonthView, calendarDay(2024, 2, 14), time.Monday, time.UTC)
+	if !start.Equal(calendarDay(2024, 1, 29)) || !end.Equal(calendarDay(2024, 3, 3)) {
+		t.Errorf("Expected Jan 29 - Mar 3 with Monday weeks, got %v - %v", start, end)
+	}
+
+	start, end = calendarRange(CalendarWeekView, calendarDay(2024, 2, 14), time.Monday, time.UTC)
+	if !start.Equal(calendarDay(2024, 2, 12)) || !end.Equal(calendarDay(2024, 2, 18)) {
+		t.Errorf("Expected Feb 12 - Feb 18, got %v - %v", start, end)
+	}
+}
+
+func TestCalendarWeeks_MultiDaySpans(t *testing.T) {
+	events := []CalendarEvent{
+		// Saturday to Tuesday crosses a row boundary; a midnight end is exclusive
+		{Title: "Trip", Start: calendarDay(2024, 2, 10), End: calendarDay(2024, 2, 14)},
+		{Title: "Lunch", Start: time.Date(2024, 2, 12, 12, 0, 0, 0, time.UTC)},
+	}
+	weeks := calendarWeeks(calendarDay(2024, 2, 4), calendarDay(2024, 2, 17), events, time.UTC)
+	if len(weeks) != 2 {
+		t.Fatalf("Expected 2 weeks, got %d", len(weeks))
+	}
+
+	first := weeks[0].segments
+	if len(first) != 1 || first[0].col != 6 || first[0].span != 1 || !first[0].continuesAfter {
+		t.Errorf("Unexpected first week segments: %+v", first)
+	}
+
+	second := weeks[1].segments
+	if len(second) != 2 {
+		t.Fatalf("Expected 2 segments in the second week, got %+v", second)
+	}
+	trip, lunch := second[0], second[1]
+	if trip.event.Title != "Trip" || trip.col != 0 || trip.span != 3 || !trip.continuesBefore || trip.continuesAfter {
+		t.Errorf("Unexpected trip segment: %+v", trip)
+	}
+	if lunch.lane != 1 || weeks[1].lanes != 2 {
+		t.Errorf("Expected lunch to move to a second lane, got %+v", lunch)
+	}
+}
+
+func TestCalendarComponent_Render(t *testing.T) {
+	calendar := NewCalendar().
+		WithLocation(time.UTC).
+		WithDate(calendarDay(2024, 2, 14)).
+		WithToday(calendarDay(2024, 2, 14)).
+		WithEvents(CalendarEvent{Title: "Launch", Start: time.Date(2024, 2, 20, 9, 30, 0, 0, time.UTC), Color: flyon.Success})
+	html := renderToStringCalendar(calendar)
+
+	expected := []string{
+		"February 2024",
+		`role="grid"`,
+		`<div class="py-2" role="columnheader">Sun</div>`,
+		`<time datetime="2024-01-28" class="inline-flex size-7 items-center justify-center rounded-full text-sm text-base-content/40">28</time>`,
+		`aria-current="date"`,
+		"bg-primary text-primary-content",
+		"badge-success",
+		`title="09:30 Launch"`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+	if strings.Contains(html, "<nav") {
+		t.Errorf("Expected no navigation without a URL builder, got %s", html)
+	}
+}
+
+func TestCalendarComponent_Overflow(t *testing.T) {
+	var events []CalendarEvent
+	for i := 0; i < 5; i++ {
+		events = append(events, CalendarEvent{Title: "Meeting", Start: time.Date(2024, 2, 14, 9+i, 0, 0, 0, time.UTC)})
+	}
+	calendar := NewCalendar().WithLocation(time.UTC).WithDate(calendarDay(2024, 2, 14)).WithMaxEvents(2).WithEvents(events...)
+	html := renderToStringCalendar(calendar)
+
+	if count := strings.Count(html, "badge-soft"); count != 2 {
+		t.Errorf("Expected 2 visible events, got %d", count)
+	}
+	if !strings.Contains(html, "+3 more") {
+		t.Errorf("Expected overflow label, got %s", html)
+	}
+
+	// The week view shows everything
+	html = renderToStringCalendar(calendar.WithView(CalendarWeekView))
+	if count := strings.Count(html, "badge-soft"); count != 5 {
+		t.Errorf("Expected all 5 events in the week view, got %d", count)
+	}
+	if strings.Contains(html, "more") {
+		t.Errorf("Expected no overflow in the week view, got %s", html)
+	}
+}
+
+func TestCalendarComponent_Navigation(t *testing.T) {
+	urlFor := func(view CalendarView, date time.Time) string {
+		return "/bookings?view=" + view.String() + "&date=" + date.Format("2006-01-02")
+	}
+	calendar := NewCalendar().
+		WithLocation(time.UTC).
+		WithDate(calendarDay(2024, 1, 31)).
+		WithToday(calendarDay(2024, 2, 14)).
+		WithFirstWeekday(time.Monday).
+		WithURLBuilder(urlFor)
+	html := renderToStringCalendar(calendar)
+
+	expected := []string{
+		`href="/bookings?view=month&amp;date=2023-12-01"`,
+		`href="/bookings?view=month&amp;date=2024-02-01"`,
+		`href="/bookings?view=month&amp;date=2024-02-14"`,
+		`href="/bookings?view=week&amp;date=2024-01-31"`,
+		`aria-current="page"`,
+		`<div class="py-2" role="columnheader">Mon</div>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+
+	html = renderToStringCalendar(calendar.WithView(CalendarWeekView))
+	if !strings.Contains(html, "January 29 – February 4, 2024") {
+		t.Errorf("Expected week title, got %s", html)
+	}
+	if !strings.Contains(html, `href="/bookings?view=week&amp;date=2024-01-24"`) {
+		t.Errorf("Expected previous week link, got %s", html)
+	}
+}
+
+func TestCalendarComponent_Immutability(t *testing.T) {
+	original := NewCalendar()
+	modified := original.WithEvents(CalendarEvent{Title: "A"}).WithView(CalendarWeekView)
+
+	if len(original.events) != 0 || original.view != CalendarMonthView {
+		t.Error("Expected original calendar to remain unchanged")
+	}
+	if len(modified.events) != 1 {
+		t.Errorf("Expected modified calendar to have 1 event, got %d", len(modified.events))
+	}
+}
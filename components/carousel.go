@@ -0,0 +1,301 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// carouselLoadingClass hides the slides until they are laid out by script
+const carouselLoadingClass = "opacity-0"
+
+// CarouselComponent shows slides one or more at a time with controls,
+// indicators or thumbnails, built on FlyonUI's HSCarousel markup
+type CarouselComponent struct {
+	id         string
+	slides     []gomponents.Node
+	thumbnails []gomponents.Node
+	indicators bool
+	controls   bool
+	autoplay   time.Duration
+	loop       bool
+	snap       bool
+	perView    map[string]int // Slides per view keyed by breakpoint, "xs" being the base
+	start      int
+	label      string
+	classes    []string
+}
+
+// carouselData is the data-carousel JSON read by HSCarousel and the WASM
+// fallback
+type carouselData struct {
+	LoadingClasses string         `json:"loadingClasses"`
+	CurrentIndex   int            `json:"currentIndex,omitempty"`
+	IsAutoPlay     bool           `json:"isAutoPlay,omitempty"`
+	Speed          int64          `json:"speed,omitempty"`
+	IsInfiniteLoop bool           `json:"isInfiniteLoop,omitempty"`
+	IsSnap         bool           `json:"isSnap,omitempty"`
+	SlidesQty      map[string]int `json:"slidesQty,omitempty"`
+}
+
+// NewCarousel creates a carousel of slides, which may be any nodes, with
+// prev/next controls and indicators, showing one slide at a time
+func NewCarousel(slides ...gomponents.Node) *CarouselComponent {
+	return &CarouselComponent{
+		slides:     slides,
+		indicators: true,
+		controls:   true,
+		label:      "Carousel",
+		classes:    []string{},
+	}
+}
+
+// WithID sets a custom ID for the carousel
+func (c *CarouselComponent) WithID(id string) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.id = id
+	return newCarousel
+}
+
+// WithLabel sets the accessible name of the carousel, "Carousel" by default
+func (c *CarouselComponent) WithLabel(label string) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.label = label
+	return newCarousel
+}
+
+// WithIndicators shows or hides the dots below the slides
+func (c *CarouselComponent) WithIndicators(indicators bool) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.indicators = indicators
+	return newCarousel
+}
+
+// WithControls shows or hides the prev/next buttons
+func (c *CarouselComponent) WithControls(controls bool) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.controls = controls
+	return newCarousel
+}
+
+// WithAutoplay advances the slides every interval while the pointer is not
+// over the carousel. Zero disables autoplay.
+func (c *CarouselComponent) WithAutoplay(interval time.Duration) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.autoplay = interval
+	return newCarousel
+}
+
+// WithLoop wraps around from the last slide to the first and back
+func (c *CarouselComponent) WithLoop(loop bool) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.loop = loop
+	return newCarousel
+}
+
+// WithSnap scrolls the slides natively with CSS scroll snapping instead of
+// sliding them, so they can be swiped on touch devices
+func (c *CarouselComponent) WithSnap(snap bool) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.snap = snap
+	return newCarousel
+}
+
+// WithSlidesPerView shows count slides at once from the breakpoint up, e.g.
+// "md"; an empty breakpoint sets the count of the smallest screens. Call it
+// once per breakpoint.
+func (c *CarouselComponent) WithSlidesPerView(breakpoint string, count int) *CarouselComponent {
+	newCarousel := c.copy()
+	if breakpoint == "" {
+		breakpoint = "xs"
+	}
+	newCarousel.perView[breakpoint] = count
+	return newCarousel
+}
+
+// WithThumbnails replaces the indicators with thumbnails, one per slide in
+// order, e.g. small images of the slides
+func (c *CarouselComponent) WithThumbnails(thumbnails ...gomponents.Node) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.thumbnails = thumbnails
+	return newCarousel
+}
+
+// WithStart sets the index of the slide shown first
+func (c *CarouselComponent) WithStart(index int) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.start = index
+	return newCarousel
+}
+
+// WithClasses adds CSS classes to the slide viewport, e.g. a height such as
+// "h-80"
+func (c *CarouselComponent) WithClasses(classes ...string) *CarouselComponent {
+	newCarousel := c.copy()
+	newCarousel.classes = append(newCarousel.classes, classes...)
+	return newCarousel
+}
+
+// With applies modifiers to the carousel and returns a new instance
+func (c *CarouselComponent) With(modifiers ...any) flyon.Component {
+	newCarousel := c.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case time.Duration:
+			newCarousel.autoplay = m
+		case string:
+			newCarousel.classes = append(newCarousel.classes, m)
+		}
+	}
+	return newCarousel
+}
+
+// copy creates a deep copy of the carousel
+func (c *CarouselComponent) copy() *CarouselComponent {
+	newCarousel := *c
+	newCarousel.slides = append([]gomponents.Node(nil), c.slides...)
+	newCarousel.thumbnails = append([]gomponents.Node(nil), c.thumbnails...)
+	newCarousel.classes = append([]string{}, c.classes...)
+	newCarousel.perView = make(map[string]int, len(c.perView))
+	for breakpoint, count := range c.perView {
+		newCarousel.perView[breakpoint] = count
+	}
+	return &newCarousel
+}
+
+// Render implements the gomponents.Node interface
+func (c *CarouselComponent) Render(w io.Writer) error {
+	id := c.id
+	if id == "" {
+		id = "carousel-" + generateID()
+	}
+
+	start := c.start
+	if start < 0 || start >= len(c.slides) {
+		start = 0
+	}
+	config, _ := json.Marshal(carouselData{
+		LoadingClasses: carouselLoadingClass,
+		CurrentIndex:   start,
+		IsAutoPlay:     c.autoplay > 0,
+		Speed:          c.autoplay.Milliseconds(),
+		IsInfiniteLoop: c.loop,
+		IsSnap:         c.snap,
+		SlidesQty:      c.perView,
+	})
+
+	bodyClasses := "carousel-body h-full " + carouselLoadingClass
+	slideClasses := "carousel-slide"
+	if c.snap {
+		bodyClasses += " snap-x snap-mandatory overflow-x-auto"
+		slideClasses += " snap-start shrink-0"
+	}
+
+	slides := make([]gomponents.Node, 0, len(c.slides))
+	for i, slide := range c.slides {
+		slides = append(slides, h.Div(
+			h.Class(slideClasses),
+			h.Role("group"),
+			gomponents.Attr("aria-roledescription", "slide"),
+			h.Aria("label", fmt.Sprintf("%d of %d", i+1, len(c.slides))),
+			slide,
+		))
+	}
+
+	children := []gomponents.Node{
+		h.ID(id),
+		h.Class("relative w-full"),
+		gomponents.Attr("data-carousel", string(config)),
+		h.Role("region"),
+		gomponents.Attr("aria-roledescription", "carousel"),
+		h.Aria("label", c.label),
+		h.Div(
+			h.Class(strings.Join(append([]string{"carousel", "rounded-box"}, c.classes...), " ")),
+			h.Div(
+				h.Class(bodyClasses),
+				h.Aria("live", liveRegion(c.autoplay)),
+				gomponents.Group(slides),
+			),
+		),
+	}
+
+	if c.controls {
+		children = append(children,
+			carouselControl("carousel-prev start-5 max-sm:start-3", "icon-[tabler--chevron-left]", "Previous"),
+			carouselControl("carousel-next end-5 max-sm:end-3", "icon-[tabler--chevron-right]", "Next"),
+		)
+	}
+
+	switch {
+	case len(c.thumbnails) > 0:
+		items := make([]gomponents.Node, 0, len(c.thumbnails))
+		for i, thumbnail := range c.thumbnails {
+			items = append(items, carouselPaginationItem(
+				"carousel-pagination-item carousel-active:opacity-100 carousel-active:border-primary rounded-box shrink-0 cursor-pointer overflow-hidden border-2 border-transparent opacity-50",
+				i, start, thumbnail,
+			))
+		}
+		children = append(children, h.Div(
+			h.Class("carousel-pagination mt-3 flex justify-center gap-2 overflow-x-auto"),
+			gomponents.Group(items),
+		))
+	case c.indicators:
+		items := make([]gomponents.Node, 0, len(c.slides))
+		for i := range c.slides {
+			items = append(items, carouselPaginationItem(
+				"carousel-pagination-item carousel-active:bg-primary carousel-active:border-primary border-base-content/30 size-2.5 cursor-pointer rounded-full border",
+				i, start, nil,
+			))
+		}
+		children = append(children, h.Div(
+			h.Class("carousel-pagination absolute bottom-3 end-0 start-0 flex justify-center gap-3"),
+			gomponents.Group(items),
+		))
+	}
+
+	return h.Div(children...).Render(w)
+}
+
+// liveRegion returns the aria-live politeness of the slides: off while they
+// rotate on their own, so screen readers are not interrupted
+func liveRegion(autoplay time.Duration) string {
+	if autoplay > 0 {
+		return "off"
+	}
+	return "polite"
+}
+
+// carouselControl renders a prev or next button of a carousel
+func carouselControl(classes, icon, label string) gomponents.Node {
+	return h.Button(
+		h.Type("button"),
+		h.Class(classes+" carousel-disabled:opacity-50 size-9.5 bg-base-100 shadow-base-300/20 flex items-center justify-center rounded-full shadow-sm"),
+		h.Span(h.Class(icon+" size-5 cursor-pointer rtl:rotate-180"), h.Aria("hidden", "true")),
+		h.Span(h.Class("sr-only"), gomponents.Text(label)),
+	)
+}
+
+// carouselPaginationItem renders the indicator or thumbnail going to slide
+// index, active when it is the start slide
+func carouselPaginationItem(classes string, index, start int, content gomponents.Node) gomponents.Node {
+	if index == start {
+		classes += " active"
+	}
+	return h.Span(
+		h.Class(classes),
+		h.Role("button"),
+		gomponents.Attr("tabindex", "0"),
+		h.Aria("label", fmt.Sprintf("Go to slide %d", index+1)),
+		gomponents.If(index == start, h.Aria("current", "true")),
+		content,
+	)
+}
+
+// Ensure CarouselComponent implements flyon.Component
+var _ flyon.Component = (*CarouselComponent)(nil)
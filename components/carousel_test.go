@@ -0,0 +1,101 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func TestCarouselComponent_Render(t *testing.T) {
+	carousel := NewCarousel(g.Text("First"), g.Text("Second"), g.Text("Third")).WithID("product").WithClasses("h-80")
+
+	var buf strings.Builder
+	if err := carousel.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	expected := []string{
+		`id="product" class="relative w-full" data-carousel="{&#34;loadingClasses&#34;:&#34;opacity-0&#34;}" role="region" aria-roledescription="carousel" aria-label="Carousel"`,
+		`class="carousel rounded-box h-80"`,
+		`class="carousel-body h-full opacity-0" aria-live="polite"`,
+		`<div class="carousel-slide" role="group" aria-roledescription="slide" aria-label="1 of 3">First</div>`,
+		`aria-label="3 of 3">Third</div>`,
+		`class="carousel-prev start-5`,
+		`<span class="sr-only">Next</span>`,
+		`class="carousel-pagination absolute bottom-3`,
+		`rounded-full border active" role="button" tabindex="0" aria-label="Go to slide 1" aria-current="true"`,
+		`aria-label="Go to slide 3"`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(html, exp) {
+			t.Errorf("Expected %q in %s", exp, html)
+		}
+	}
+}
+
+func TestCarouselComponent_RenderConfig(t *testing.T) {
+	carousel := NewCarousel(g.Text("First"), g.Text("Second"), g.Text("Third")).
+		WithAutoplay(3*time.Second).
+		WithLoop(true).
+		WithSnap(true).
+		WithSlidesPerView("", 1).
+		WithSlidesPerView("lg", 3).
+		WithStart(1)
+
+	var buf strings.Builder
+	if err := carousel.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	expected := []string{
+		`data-carousel="{&#34;loadingClasses&#34;:&#34;opacity-0&#34;,&#34;currentIndex&#34;:1,&#34;isAutoPlay&#34;:true,&#34;speed&#34;:3000,&#34;isInfiniteLoop&#34;:true,&#34;isSnap&#34;:true,&#34;slidesQty&#34;:{&#34;lg&#34;:3,&#34;xs&#34;:1}}"`,
+		`class="carousel-body h-full opacity-0 snap-x snap-mandatory overflow-x-auto" aria-live="off"`,
+		`class="carousel-slide snap-start shrink-0"`,
+		`active" role="button" tabindex="0" aria-label="Go to slide 2" aria-current="true"`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(html, exp) {
+			t.Errorf("Expected %q in %s", exp, html)
+		}
+	}
+}
+
+func TestCarouselComponent_RenderThumbnails(t *testing.T) {
+	carousel := NewCarousel(g.Text("First"), g.Text("Second")).
+		WithThumbnails(h.Img(h.Src("/1.png")), h.Img(h.Src("/2.png"))).
+		WithControls(false)
+
+	var buf strings.Builder
+	if err := carousel.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `class="carousel-pagination mt-3 flex`) {
+		t.Error("Missing thumbnail strip")
+	}
+	if !strings.Contains(html, `aria-label="Go to slide 2"><img src="/2.png">`) {
+		t.Error("Missing second thumbnail")
+	}
+	if strings.Contains(html, "rounded-full border") {
+		t.Error("Thumbnails should replace the indicators")
+	}
+	if strings.Contains(html, "carousel-prev") || strings.Contains(html, "carousel-next") {
+		t.Error("Controls should be hidden")
+	}
+}
+
+func TestCarouselComponent_RenderWithoutIndicators(t *testing.T) {
+	var buf strings.Builder
+	if err := NewCarousel(g.Text("First")).WithIndicators(false).Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "carousel-pagination") {
+		t.Error("Indicators should be hidden")
+	}
+}
@@ -19,6 +19,11 @@ type CheckboxComponent struct {
 	color    flyon.Color
 	size     flyon.Size
 	classes  []string
+	label    choiceLabel
+	// indeterminate is applied by the WASM behavior, HTML has no attribute for it
+	indeterminate bool
+	selectAll     string
+	group         string
 }
 
 // NewCheckbox creates a new checkbox component
@@ -64,6 +69,49 @@ func (c *CheckboxComponent) WithDisabled(disabled bool) *CheckboxComponent {
 	return newCheckbox
 }
 
+// WithLabel wraps the checkbox in a label with text
+func (c *CheckboxComponent) WithLabel(text string) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.label.text = text
+	return newCheckbox
+}
+
+// WithDescription adds helper text below the label
+func (c *CheckboxComponent) WithDescription(description string) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.label.description = description
+	return newCheckbox
+}
+
+// WithLabelPlacement places the label before or after the checkbox
+func (c *CheckboxComponent) WithLabelPlacement(placement LabelPlacement) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.label.placement = placement
+	return newCheckbox
+}
+
+// WithIndeterminate shows the checkbox as partially checked
+func (c *CheckboxComponent) WithIndeterminate(indeterminate bool) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.indeterminate = indeterminate
+	return newCheckbox
+}
+
+// WithSelectAll makes the checkbox check and uncheck every checkbox of group.
+// It turns indeterminate when only some of them are checked.
+func (c *CheckboxComponent) WithSelectAll(group string) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.selectAll = group
+	return newCheckbox
+}
+
+// WithGroup adds the checkbox to a group controlled by a select all checkbox
+func (c *CheckboxComponent) WithGroup(group string) *CheckboxComponent {
+	newCheckbox := c.copy()
+	newCheckbox.group = group
+	return newCheckbox
+}
+
 // WithColor sets the checkbox color
 func (c *CheckboxComponent) WithColor(color flyon.Color) *CheckboxComponent {
 	newCheckbox := c.copy()
@@ -152,5 +200,26 @@ func (c *CheckboxComponent) Render(w io.Writer) error {
 		attrs = append(attrs, h.Disabled())
 	}
 	
-	return h.Input(attrs...).Render(w)
+	id := c.id
+	if c.label.description != "" && id == "" {
+		id = "checkbox-" + generateID()
+		attrs = append(attrs, h.ID(id))
+	}
+	if describedBy := c.label.descriptionID(id); describedBy != "" {
+		attrs = append(attrs, g.Attr("aria-describedby", describedBy))
+	}
+	
+	if c.indeterminate {
+		attrs = append(attrs, g.Attr("data-indeterminate", ""))
+	}
+	
+	if c.selectAll != "" {
+		attrs = append(attrs, g.Attr("data-checkbox-select-all", c.selectAll))
+	}
+	
+	if c.group != "" {
+		attrs = append(attrs, g.Attr("data-checkbox-group", c.group))
+	}
+	
+	return c.label.wrap(h.Input(attrs...), id).Render(w)
 }
\ No newline at end of file
@@ -217,4 +217,49 @@ func TestCheckboxComponent_Immutability(t *testing.T) {
 	if !strings.Contains(modifiedHTML, `checked`) {
 		t.Error("Modified checkbox should be checked")
 	}
-}
\ No newline at end of file
+}
+
+func TestCheckboxComponent_Label(t *testing.T) {
+	html := renderToStringCheckbox(NewCheckbox().WithID("terms").WithLabel("Accept terms").WithDescription("Required to continue"))
+	for _, want := range []string{
+		`<label class="flex cursor-pointer gap-3 items-start"><input type="checkbox"`,
+		`aria-describedby="terms-description"`,
+		`<span class="label-text text-base">Accept terms</span>`,
+		`id="terms-description"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in labelled checkbox, got: %s", want, html)
+		}
+	}
+
+	start := renderToStringCheckbox(NewCheckbox().WithLabel("Remember me").WithLabelPlacement(LabelStart))
+	if strings.Index(start, "Remember me") > strings.Index(start, "<input") {
+		t.Errorf("Expected label before the input, got: %s", start)
+	}
+}
+
+func TestCheckboxComponent_SelectAll(t *testing.T) {
+	parent := renderToStringCheckbox(NewCheckbox().WithSelectAll("files").WithIndeterminate(true))
+	if !strings.Contains(parent, `data-indeterminate`) || !strings.Contains(parent, `data-checkbox-select-all="files"`) {
+		t.Errorf("Expected select all attributes, got: %s", parent)
+	}
+	child := renderToStringCheckbox(NewCheckbox().WithGroup("files"))
+	if !strings.Contains(child, `data-checkbox-group="files"`) {
+		t.Errorf("Expected group attribute, got: %s", child)
+	}
+
+	for _, tc := range []struct {
+		children               []bool
+		checked, indeterminate bool
+	}{
+		{nil, false, false},
+		{[]bool{false, false}, false, false},
+		{[]bool{true, false}, false, true},
+		{[]bool{true, true}, true, false},
+	} {
+		checked, indeterminate := SelectAllState(tc.children)
+		if checked != tc.checked || indeterminate != tc.indeterminate {
+			t.Errorf("SelectAllState(%v) = %v, %v, want %v, %v", tc.children, checked, indeterminate, tc.checked, tc.indeterminate)
+		}
+	}
+}
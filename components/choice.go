@@ -0,0 +1,215 @@
+package components
+
+import (
+	"io"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// LabelPlacement places the label of a checkbox, radio or toggle relative to the input
+type LabelPlacement int
+
+const (
+	// LabelEnd shows the label after the input
+	LabelEnd LabelPlacement = iota
+	// LabelStart shows the label before the input
+	LabelStart
+)
+
+// choiceLabel is the optional label wrapper shared by checkboxes, radios and toggles
+type choiceLabel struct {
+	text        string
+	description string
+	placement   LabelPlacement
+}
+
+// descriptionID returns the ID of the description of the input with id, or
+// an empty string when there is no description
+func (l choiceLabel) descriptionID(id string) string {
+	if l.description == "" || id == "" {
+		return ""
+	}
+	return id + "-description"
+}
+
+// wrap renders input inside a label with the text and description. Inputs
+// without label text are returned unchanged.
+func (l choiceLabel) wrap(input g.Node, id string) g.Node {
+	if l.text == "" && l.description == "" {
+		return input
+	}
+
+	text := []g.Node{
+		h.Class("flex flex-col"),
+		g.If(l.text != "", h.Span(h.Class("label-text text-base"), g.Text(l.text))),
+	}
+	if l.description != "" {
+		text = append(text, h.Span(
+			g.If(l.descriptionID(id) != "", h.ID(l.descriptionID(id))),
+			h.Class("label-text-alt text-base-content/60"),
+			g.Text(l.description),
+		))
+	}
+
+	align := "items-center"
+	if l.description != "" {
+		// Keep the input on the line of the label text
+		align = "items-start"
+	}
+	children := []g.Node{input, h.Span(text...)}
+	if l.placement == LabelStart {
+		children = []g.Node{h.Span(text...), input}
+	}
+	return h.Label(
+		h.Class("flex cursor-pointer gap-3 "+align),
+		g.Group(children),
+	)
+}
+
+// SelectAllState returns the state of a select all checkbox for the checked
+// states of its children: checked when all are, indeterminate when only some are
+func SelectAllState(children []bool) (checked, indeterminate bool) {
+	count := 0
+	for _, c := range children {
+		if c {
+			count++
+		}
+	}
+	return len(children) > 0 && count == len(children), count > 0 && count < len(children)
+}
+
+// ChoiceGroupComponent groups checkboxes, radios or toggles in a fieldset
+// with a legend and a shared error message
+type ChoiceGroupComponent struct {
+	id          string
+	legend      string
+	description string
+	error       string
+	required    bool
+	horizontal  bool
+	choices     []flyon.Component
+	classes     []string
+}
+
+// NewChoiceGroup creates a new fieldset grouping choices under legend
+func NewChoiceGroup(legend string, choices ...flyon.Component) *ChoiceGroupComponent {
+	return &ChoiceGroupComponent{
+		legend:  legend,
+		choices: append([]flyon.Component(nil), choices...),
+		classes: make([]string, 0),
+	}
+}
+
+// WithID sets the fieldset ID
+func (cg *ChoiceGroupComponent) WithID(id string) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.id = id
+	return newGroup
+}
+
+// WithDescription sets the helper text below the legend
+func (cg *ChoiceGroupComponent) WithDescription(description string) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.description = description
+	return newGroup
+}
+
+// WithError sets the error message shown below the choices
+func (cg *ChoiceGroupComponent) WithError(message string) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.error = message
+	return newGroup
+}
+
+// WithRequired marks the legend as required
+func (cg *ChoiceGroupComponent) WithRequired(required bool) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.required = required
+	return newGroup
+}
+
+// WithHorizontal lays the choices out in a row
+func (cg *ChoiceGroupComponent) WithHorizontal(horizontal bool) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.horizontal = horizontal
+	return newGroup
+}
+
+// WithChoices appends choices to the group
+func (cg *ChoiceGroupComponent) WithChoices(choices ...flyon.Component) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.choices = append(newGroup.choices, choices...)
+	return newGroup
+}
+
+// WithClasses adds additional CSS classes to the fieldset
+func (cg *ChoiceGroupComponent) WithClasses(classes ...string) *ChoiceGroupComponent {
+	newGroup := cg.copy()
+	newGroup.classes = append(newGroup.classes, classes...)
+	return newGroup
+}
+
+// With applies modifiers to the group
+func (cg *ChoiceGroupComponent) With(modifiers ...any) flyon.Component {
+	newGroup := cg.copy()
+	for _, modifier := range modifiers {
+		if class, ok := modifier.(string); ok {
+			newGroup.classes = append(newGroup.classes, class)
+		}
+	}
+	return newGroup
+}
+
+// copy creates a deep copy of the group
+func (cg *ChoiceGroupComponent) copy() *ChoiceGroupComponent {
+	newGroup := *cg
+	newGroup.choices = append([]flyon.Component(nil), cg.choices...)
+	newGroup.classes = make([]string, len(cg.classes))
+	copy(newGroup.classes, cg.classes)
+	return &newGroup
+}
+
+// Render generates the HTML for the group
+func (cg *ChoiceGroupComponent) Render(w io.Writer) error {
+	id := cg.id
+	if id == "" && (cg.description != "" || cg.error != "") {
+		id = "choices-" + generateID()
+	}
+	var describedBy []string
+	if cg.description != "" {
+		describedBy = append(describedBy, id+"-description")
+	}
+	if cg.error != "" {
+		describedBy = append(describedBy, id+"-error")
+	}
+
+	layout := "flex flex-col gap-2"
+	if cg.horizontal {
+		layout = "flex flex-wrap gap-x-5 gap-y-2"
+	}
+	choices := make([]g.Node, len(cg.choices))
+	for i, choice := range cg.choices {
+		choices[i] = choice
+	}
+
+	return h.FieldSet(
+		g.If(id != "", h.ID(id)),
+		h.Class(strings.Join(append([]string{"min-w-0"}, cg.classes...), " ")),
+		g.If(len(describedBy) > 0, g.Attr("aria-describedby", strings.Join(describedBy, " "))),
+		h.Legend(
+			h.Class("label-text text-base-content mb-2 text-base font-medium"),
+			g.Text(cg.legend),
+			g.If(cg.required, g.Text(" *")),
+		),
+		g.If(cg.description != "", h.P(h.ID(id+"-description"), h.Class("label-text-alt text-base-content/60 mb-2"), g.Text(cg.description))),
+		h.Div(h.Class(layout), g.Group(choices)),
+		g.If(cg.error != "", h.P(h.ID(id+"-error"), h.Class("label-text-alt text-error mt-2"), g.Attr("role", "alert"), g.Text(cg.error))),
+	).Render(w)
+}
+
+// Ensure ChoiceGroupComponent implements flyon.Component
+var _ flyon.Component = (*ChoiceGroupComponent)(nil)
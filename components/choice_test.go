@@ -0,0 +1,33 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChoiceGroupComponent_Render(t *testing.T) {
+	group := NewChoiceGroup("Plan",
+		NewRadio().WithName("plan").WithValue("free").WithLabel("Free"),
+		NewRadio().WithName("plan").WithValue("pro").WithLabel("Pro"),
+	).WithID("plan").WithDescription("Choose one").WithError("Select a plan").WithRequired(true)
+	html := renderToStringInput(group)
+
+	for _, want := range []string{
+		`<fieldset id="plan"`,
+		`aria-describedby="plan-description plan-error"`,
+		`<legend class="label-text text-base-content mb-2 text-base font-medium">Plan *</legend>`,
+		`id="plan-description"`,
+		`<p id="plan-error" class="label-text-alt text-error mt-2" role="alert">Select a plan</p>`,
+		`value="free"`,
+		`value="pro"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in choice group, got: %s", want, html)
+		}
+	}
+
+	horizontal := renderToStringInput(NewChoiceGroup("Days").WithHorizontal(true))
+	if !strings.Contains(horizontal, "flex-wrap") || strings.Contains(horizontal, "aria-describedby") {
+		t.Errorf("Expected horizontal group without descriptions, got: %s", horizontal)
+	}
+}
@@ -0,0 +1,189 @@
+package components
+
+import (
+	"io"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// ColorPickerComponent represents a color picker combining the native color
+// input, an editable hex value and optional preset swatches
+type ColorPickerComponent struct {
+	id       string
+	name     string
+	value    string
+	swatches []string
+	hideText bool
+	disabled bool
+	classes  []string
+}
+
+// NewColorPicker creates a new color picker component
+func NewColorPicker() *ColorPickerComponent {
+	return &ColorPickerComponent{
+		value:   "#000000",
+		classes: make([]string, 0),
+	}
+}
+
+// WithID sets the ID of the color input
+func (c *ColorPickerComponent) WithID(id string) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.id = id
+	return newPicker
+}
+
+// WithName sets the color input name attribute
+func (c *ColorPickerComponent) WithName(name string) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.name = name
+	return newPicker
+}
+
+// WithValue sets the selected color as a hex value such as "#3b82f6" or "#38f"
+func (c *ColorPickerComponent) WithValue(value string) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.value = value
+	return newPicker
+}
+
+// WithSwatches sets preset colors offered next to the picker
+func (c *ColorPickerComponent) WithSwatches(colors ...string) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.swatches = append([]string(nil), colors...)
+	return newPicker
+}
+
+// WithHexInput shows or hides the editable hex value
+func (c *ColorPickerComponent) WithHexInput(show bool) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.hideText = !show
+	return newPicker
+}
+
+// WithDisabled sets the color picker disabled state
+func (c *ColorPickerComponent) WithDisabled(disabled bool) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.disabled = disabled
+	return newPicker
+}
+
+// WithClasses adds additional CSS classes to the wrapper
+func (c *ColorPickerComponent) WithClasses(classes ...string) *ColorPickerComponent {
+	newPicker := c.copy()
+	newPicker.classes = append(newPicker.classes, classes...)
+	return newPicker
+}
+
+// With applies modifiers to the color picker
+func (c *ColorPickerComponent) With(modifiers ...any) flyon.Component {
+	newPicker := c.copy()
+	for _, modifier := range modifiers {
+		if class, ok := modifier.(string); ok {
+			newPicker.classes = append(newPicker.classes, class)
+		}
+	}
+	return newPicker
+}
+
+// copy creates a deep copy of the color picker component
+func (c *ColorPickerComponent) copy() *ColorPickerComponent {
+	newPicker := *c
+	newPicker.swatches = append([]string(nil), c.swatches...)
+	newPicker.classes = make([]string, len(c.classes))
+	copy(newPicker.classes, c.classes)
+	return &newPicker
+}
+
+// Render generates the HTML for the color picker
+func (c *ColorPickerComponent) Render(w io.Writer) error {
+	value, ok := NormalizeHexColor(c.value)
+	if !ok {
+		value = "#000000"
+	}
+
+	classes := append([]string{"flex", "flex-wrap", "items-center", "gap-2"}, c.classes...)
+	nodes := []g.Node{
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-color-picker", ""),
+		h.Input(
+			h.Type("color"),
+			h.Class("rounded-field border-base-content/20 h-9 w-12 cursor-pointer border bg-transparent p-0.5"),
+			g.If(c.id != "", h.ID(c.id)),
+			g.If(c.name != "", h.Name(c.name)),
+			h.Value(value),
+			g.If(c.disabled, h.Disabled()),
+			g.Attr("data-color-picker-input", ""),
+		),
+	}
+
+	if !c.hideText {
+		nodes = append(nodes, h.Input(
+			h.Type("text"),
+			h.Class("input input-bordered w-28 font-mono"),
+			h.Value(value),
+			h.MaxLength("7"),
+			h.Pattern("#[0-9a-fA-F]{6}"),
+			g.Attr("spellcheck", "false"),
+			g.Attr("aria-label", "Hex color"),
+			g.If(c.disabled, h.Disabled()),
+			g.Attr("data-color-picker-text", ""),
+		))
+	}
+
+	if len(c.swatches) > 0 {
+		swatches := make([]g.Node, 0, len(c.swatches))
+		for _, swatch := range c.swatches {
+			color, ok := NormalizeHexColor(swatch)
+			if !ok {
+				continue
+			}
+			pressed := "false"
+			if color == value {
+				pressed = "true"
+			}
+			swatches = append(swatches, h.Button(
+				h.Type("button"),
+				h.Class("border-base-content/20 aria-pressed:ring-primary size-6 cursor-pointer rounded-full border aria-pressed:ring-2 aria-pressed:ring-offset-1"),
+				h.Style("background-color: "+color),
+				g.Attr("aria-label", color),
+				g.Attr("aria-pressed", pressed),
+				g.If(c.disabled, h.Disabled()),
+				g.Attr("data-color-picker-swatch", color),
+			))
+		}
+		nodes = append(nodes, h.Div(
+			h.Class("flex flex-wrap gap-1.5"),
+			h.Role("group"),
+			g.Attr("aria-label", "Preset colors"),
+			g.Group(swatches),
+		))
+	}
+
+	return h.Div(nodes...).Render(w)
+}
+
+// NormalizeHexColor returns value as a lowercase "#rrggbb" color. It accepts
+// the short "#rgb" form and a missing "#", and reports false for anything else.
+func NormalizeHexColor(value string) (string, bool) {
+	hex := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "#"))
+	for _, r := range hex {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return "", false
+		}
+	}
+	switch len(hex) {
+	case 3:
+		return "#" + string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]}), true
+	case 6:
+		return "#" + hex, true
+	}
+	return "", false
+}
+
+// Ensure ColorPickerComponent implements flyon.Component
+var _ flyon.Component = (*ColorPickerComponent)(nil)
@@ -0,0 +1,56 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColorPickerComponent_Render(t *testing.T) {
+	picker := NewColorPicker().WithID("brand").WithName("brand").WithValue("#38F").WithSwatches("#3388ff", "#f00", "nope")
+	html := renderToStringInput(picker)
+
+	for _, want := range []string{
+		`type="color"`,
+		`id="brand"`,
+		`name="brand"`,
+		`value="#3388ff"`,
+		`data-color-picker-text`,
+		`data-color-picker-swatch="#3388ff"`,
+		`data-color-picker-swatch="#ff0000"`,
+		`style="background-color: #ff0000"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in color picker, got: %s", want, html)
+		}
+	}
+	if strings.Count(html, "data-color-picker-swatch=") != 2 {
+		t.Errorf("Expected invalid swatches to be skipped, got: %s", html)
+	}
+	if strings.Count(html, `aria-pressed="true"`) != 1 {
+		t.Errorf("Expected the swatch of the value to be pressed, got: %s", html)
+	}
+}
+
+func TestColorPickerComponent_Options(t *testing.T) {
+	html := renderToStringInput(NewColorPicker().WithValue("invalid").WithHexInput(false))
+	if !strings.Contains(html, `value="#000000"`) {
+		t.Errorf("Expected an invalid value to fall back to black, got: %s", html)
+	}
+	if strings.Contains(html, "data-color-picker-text") {
+		t.Errorf("Expected no hex input, got: %s", html)
+	}
+}
+
+func TestNormalizeHexColor(t *testing.T) {
+	tests := map[string]string{"#ABC": "#aabbcc", "3b82f6": "#3b82f6", " #3B82F6 ": "#3b82f6"}
+	for value, want := range tests {
+		if got, ok := NormalizeHexColor(value); !ok || got != want {
+			t.Errorf("Expected %q for %q, got %q", want, value, got)
+		}
+	}
+	for _, value := range []string{"", "#12", "#gggggg", "#12345678"} {
+		if _, ok := NormalizeHexColor(value); ok {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
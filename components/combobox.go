@@ -2,10 +2,11 @@ package components
 
 import (
 	"io"
+	"strconv"
 	"strings"
 
-	g "maragu.dev/gomponents"
 	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
 )
 
@@ -18,18 +19,19 @@ type ComboboxOption struct {
 
 // ComboboxComponent represents a combobox input with dropdown functionality
 type ComboboxComponent struct {
-	id         string
-	name       string
+	id          string
+	name        string
 	placeholder string
-	value      string
-	disabled   bool
-	color      flyon.Color
-	size       flyon.Size
-	colorSet   bool
-	sizeSet    bool
-	options    []ComboboxOption
-	classes    []string
-	attributes map[string]string
+	value       string
+	disabled    bool
+	color       flyon.Color
+	size        flyon.Size
+	colorSet    bool
+	sizeSet     bool
+	options     []ComboboxOption
+	source      *AutocompleteSource
+	classes     []string
+	attributes  map[string]string
 }
 
 // NewCombobox creates a new combobox component
@@ -102,6 +104,13 @@ func (c *ComboboxComponent) WithOptions(options []ComboboxOption) *ComboboxCompo
 	return new
 }
 
+// WithSource loads the dropdown options from a remote data source as the user types
+func (c *ComboboxComponent) WithSource(source AutocompleteSource) *ComboboxComponent {
+	new := c.copy()
+	new.source = &source
+	return new
+}
+
 // WithClasses adds custom CSS classes
 func (c *ComboboxComponent) WithClasses(classes ...string) *ComboboxComponent {
 	new := c.copy()
@@ -137,18 +146,19 @@ func (c *ComboboxComponent) With(modifiers ...any) flyon.Component {
 // copy creates a deep copy of the component
 func (c *ComboboxComponent) copy() *ComboboxComponent {
 	new := &ComboboxComponent{
-		id:         c.id,
-		name:       c.name,
+		id:          c.id,
+		name:        c.name,
 		placeholder: c.placeholder,
-		value:      c.value,
-		disabled:   c.disabled,
-		color:      c.color,
-		size:       c.size,
-		colorSet:   c.colorSet,
-		sizeSet:    c.sizeSet,
-		options:    make([]ComboboxOption, len(c.options)),
-		classes:    make([]string, len(c.classes)),
-		attributes: make(map[string]string),
+		value:       c.value,
+		disabled:    c.disabled,
+		color:       c.color,
+		size:        c.size,
+		colorSet:    c.colorSet,
+		sizeSet:     c.sizeSet,
+		options:     make([]ComboboxOption, len(c.options)),
+		source:      c.source,
+		classes:     make([]string, len(c.classes)),
+		attributes:  make(map[string]string),
 	}
 
 	copy(new.options, c.options)
@@ -181,23 +191,36 @@ func (c *ComboboxComponent) Render(w io.Writer) error {
 	// Add custom classes
 	inputClasses = append(inputClasses, c.classes...)
 
+	// Generate ID if not provided; the listbox and its options derive from it
+	id := c.id
+	if id == "" {
+		id = "combobox-" + generateID()
+	}
+	listboxID := id + "-listbox"
+
+	// The visible input shows the selected label; the value is submitted
+	// through a hidden input kept in sync by the WASM behavior
+	displayValue := c.value
+	for _, option := range c.options {
+		if option.Value == c.value && c.value != "" {
+			displayValue = option.Label
+			break
+		}
+	}
+
 	// Build input attributes
 	attrs := []g.Node{
 		h.Type("text"),
 		h.Class(strings.Join(inputClasses, " ")),
+		h.ID(id),
 	}
+	attrs = append(attrs, comboboxInputAttrs(listboxID)...)
 
-	if c.id != "" {
-		attrs = append(attrs, h.ID(c.id))
-	}
-	if c.name != "" {
-		attrs = append(attrs, h.Name(c.name))
-	}
 	if c.placeholder != "" {
 		attrs = append(attrs, h.Placeholder(c.placeholder))
 	}
-	if c.value != "" {
-		attrs = append(attrs, h.Value(c.value))
+	if displayValue != "" {
+		attrs = append(attrs, h.Value(displayValue))
 	}
 	if c.disabled {
 		attrs = append(attrs, h.Disabled())
@@ -212,30 +235,93 @@ func (c *ComboboxComponent) Render(w io.Writer) error {
 	dropdownContent := []g.Node{
 		h.Input(attrs...),
 	}
+	if c.name != "" {
+		dropdownContent = append(dropdownContent, comboboxHiddenInput(c.name, c.value))
+	}
 
-	// Add dropdown menu if options exist
-	if len(c.options) > 0 {
-		menuItems := make([]g.Node, 0, len(c.options))
-		for _, option := range c.options {
-			itemAttrs := []g.Node{
-				h.Class("dropdown-item"),
-				g.Attr("data-value", option.Value),
-			}
-			if option.Disabled {
-				itemAttrs = append(itemAttrs, h.Disabled())
-			}
-			menuItems = append(menuItems, h.Li(append(itemAttrs, g.Text(option.Label))...))
-		}
+	// The listbox is always rendered so aria-controls points at an element,
+	// and remote results have somewhere to go
+	menuItems := make([]g.Node, 0, len(c.options))
+	for i, option := range c.options {
+		menuItems = append(menuItems, listboxOption(
+			listboxOptionID(listboxID, i),
+			AutocompleteOption{Value: option.Value, Label: option.Label},
+			c.value != "" && option.Value == c.value,
+			option.Disabled,
+		))
+	}
+
+	dropdownMenu := h.Ul(
+		h.ID(listboxID),
+		h.Class("dropdown-content menu bg-base-100 rounded-box z-[1] w-52 p-2 shadow"),
+		h.Role("listbox"),
+		g.Group(menuItems),
+	)
+	dropdownContent = append(dropdownContent, dropdownMenu)
 
-		dropdownMenu := h.Ul(
-			h.Class("dropdown-content menu bg-base-100 rounded-box z-[1] w-52 p-2 shadow"),
-			g.Group(menuItems),
-		)
-		dropdownContent = append(dropdownContent, dropdownMenu)
+	// Remote source settings are read by the WASM behavior from the wrapper
+	var sourceAttrs []g.Node
+	if c.source != nil {
+		sourceAttrs = c.source.attributes()
 	}
 
 	return h.Div(
 		h.Class(strings.Join(dropdownClasses, " ")),
+		g.Attr("data-combobox", "combobox"),
+		g.Group(sourceAttrs),
 		g.Group(dropdownContent),
 	).Render(w)
-}
\ No newline at end of file
+}
+
+// comboboxInputAttrs returns the WAI-ARIA attributes of an input controlling a listbox
+func comboboxInputAttrs(listboxID string) []g.Node {
+	return []g.Node{
+		h.Role("combobox"),
+		h.AutoComplete("off"),
+		h.Aria("autocomplete", "list"),
+		h.Aria("expanded", "false"),
+		h.Aria("controls", listboxID),
+	}
+}
+
+// comboboxHiddenInput renders the hidden input submitting the selected option value
+func comboboxHiddenInput(name, value string) g.Node {
+	return h.Input(
+		h.Type("hidden"),
+		h.Name(name),
+		h.Value(value),
+		g.Attr("data-combobox-value"),
+	)
+}
+
+// listboxOptionID returns the ID of the option at index within a listbox
+func listboxOptionID(listboxID string, index int) string {
+	return listboxID + "-option-" + strconv.Itoa(index)
+}
+
+// listboxOption renders a single listbox option. The label is wrapped in a
+// data-option-label span so the WASM behavior can highlight matched text.
+func listboxOption(id string, option AutocompleteOption, selected, disabled bool) g.Node {
+	attrs := []g.Node{
+		h.Class("dropdown-item"),
+		h.Role("option"),
+		g.Attr("data-value", option.Value),
+		g.Attr("data-label", option.Label),
+		h.Aria("selected", strconv.FormatBool(selected)),
+	}
+	if id != "" {
+		attrs = append(attrs, h.ID(id))
+	}
+	if disabled {
+		attrs = append(attrs, h.Aria("disabled", "true"))
+	}
+
+	return h.Li(
+		g.Group(attrs),
+		h.Span(g.Attr("data-option-label"), g.Text(option.Label)),
+		g.If(option.Description != "", h.Span(
+			h.Class("block text-base-content/60 text-xs"),
+			g.Text(option.Description),
+		)),
+	)
+}
@@ -276,4 +276,52 @@ func TestComboboxComponent_Immutability(t *testing.T) {
 	if original == modified {
 		t.Error("Expected original and modified to be different instances")
 	}
-}
\ No newline at end of file
+}
+
+func TestComboboxComponent_ARIA(t *testing.T) {
+	combobox := NewCombobox().
+		WithID("country").
+		WithName("country").
+		WithValue("de").
+		WithOptions([]ComboboxOption{
+			{Value: "de", Label: "Germany"},
+			{Value: "fr", Label: "France", Disabled: true},
+		})
+	html := renderToStringCombobox(combobox)
+
+	expected := []string{
+		`data-combobox="combobox"`,
+		`role="combobox"`,
+		`aria-autocomplete="list"`,
+		`aria-expanded="false"`,
+		`aria-controls="country-listbox"`,
+		`autocomplete="off"`,
+		`<ul id="country-listbox"`,
+		`role="listbox"`,
+		`id="country-listbox-option-0"`,
+		`data-value="de" data-label="Germany" aria-selected="true"`,
+		`aria-disabled="true"`,
+		`<input type="hidden" name="country" value="de" data-combobox-value>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+
+	// The visible input shows the label and no longer carries the name
+	if !strings.Contains(html, `id="country" role="combobox"`) || !strings.Contains(html, `value="Germany"`) {
+		t.Errorf("Expected visible input to show the selected label, got %s", html)
+	}
+	if strings.Count(html, `name="country"`) != 1 {
+		t.Errorf("Expected only the hidden input to be named, got %s", html)
+	}
+}
+
+func TestComboboxComponent_ARIAGeneratedID(t *testing.T) {
+	html := renderToStringCombobox(NewCombobox())
+
+	if !strings.Contains(html, `id="combobox-`) || !strings.Contains(html, `-listbox"`) {
+		t.Errorf("Expected generated IDs wiring input and listbox, got %s", html)
+	}
+}
@@ -0,0 +1,164 @@
+package components
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+)
+
+// Defaults of CSRFProtection
+const (
+	DefaultCSRFField  = "csrf_token"
+	DefaultCSRFHeader = "X-CSRF-Token"
+	DefaultCSRFCookie = "csrf"
+)
+
+// CSRFTokenProvider supplies the hidden CSRF field of a FormComponent
+type CSRFTokenProvider interface {
+	// CSRFToken returns the field name and token for the request being rendered
+	CSRFToken(r *http.Request) (field, token string)
+}
+
+// CSRFTokenProviderFunc adapts a function to CSRFTokenProvider
+type CSRFTokenProviderFunc func(r *http.Request) (field, token string)
+
+// CSRFToken calls f(r)
+func (f CSRFTokenProviderFunc) CSRFToken(r *http.Request) (field, token string) {
+	return f(r)
+}
+
+// csrfContextKey stores the cookie value of the current request
+type csrfContextKey struct{}
+
+// CSRFProtection guards unsafe requests with signed double-submit tokens: a
+// random value lives in a cookie, and forms carry its HMAC. A forged request
+// can send the cookie but cannot read it to compute the token.
+type CSRFProtection struct {
+	secret []byte
+	field  string
+	header string
+	cookie string
+	secure bool
+}
+
+// NewCSRFProtection creates a new CSRF protection signing tokens with secret
+func NewCSRFProtection(secret []byte) *CSRFProtection {
+	return &CSRFProtection{
+		secret: secret,
+		field:  DefaultCSRFField,
+		header: DefaultCSRFHeader,
+		cookie: DefaultCSRFCookie,
+		secure: true,
+	}
+}
+
+// WithFieldName sets the name of the hidden form field
+func (c *CSRFProtection) WithFieldName(field string) *CSRFProtection {
+	newCSRF := *c
+	newCSRF.field = field
+	return &newCSRF
+}
+
+// WithHeaderName sets the request header accepted instead of the form field,
+// for requests sent by scripts
+func (c *CSRFProtection) WithHeaderName(header string) *CSRFProtection {
+	newCSRF := *c
+	newCSRF.header = header
+	return &newCSRF
+}
+
+// WithCookieName sets the name of the cookie
+func (c *CSRFProtection) WithCookieName(cookie string) *CSRFProtection {
+	newCSRF := *c
+	newCSRF.cookie = cookie
+	return &newCSRF
+}
+
+// WithSecureCookie sets the Secure flag of the cookie, on by default;
+// disable it for development over plain HTTP
+func (c *CSRFProtection) WithSecureCookie(secure bool) *CSRFProtection {
+	newCSRF := *c
+	newCSRF.secure = secure
+	return &newCSRF
+}
+
+// CSRFToken returns the token for r. It is empty unless r passed through the
+// Middleware.
+func (c *CSRFProtection) CSRFToken(r *http.Request) (field, token string) {
+	value, _ := r.Context().Value(csrfContextKey{}).(string)
+	if value == "" {
+		return c.field, ""
+	}
+	return c.field, c.sign(value)
+}
+
+// Middleware issues the CSRF cookie and rejects POST, PUT, PATCH and DELETE
+// requests without a valid token in the form field or header with 403
+// Forbidden
+func (c *CSRFProtection) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var value string
+		if cookie, err := r.Cookie(c.cookie); err == nil && cookie.Value != "" {
+			value = cookie.Value
+		}
+
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		default:
+			if value == "" || !c.valid(value, c.submitted(r)) {
+				http.Error(w, "invalid CSRF token", http.StatusForbidden)
+				return
+			}
+		}
+
+		if value == "" {
+			b := make([]byte, 32)
+			if _, err := rand.Read(b); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			value = base64.RawURLEncoding.EncodeToString(b)
+			http.SetCookie(w, &http.Cookie{
+				Name:     c.cookie,
+				Value:    value,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   c.secure,
+				SameSite: http.SameSiteLaxMode,
+			})
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), csrfContextKey{}, value)))
+	})
+}
+
+// submitted returns the token sent with r
+func (c *CSRFProtection) submitted(r *http.Request) string {
+	if token := r.Header.Get(c.header); token != "" {
+		return token
+	}
+	return r.PostFormValue(c.field)
+}
+
+// sign returns the token for a cookie value
+func (c *CSRFProtection) sign(value string) string {
+	return tokenSignature(c.secret, signingCSRF, value)
+}
+
+// valid reports whether token belongs to the cookie value
+func (c *CSRFProtection) valid(value, token string) bool {
+	return token != "" && hmac.Equal([]byte(token), []byte(c.sign(value)))
+}
+
+// csrfHeader returns the request header provider accepts the token in, for
+// requests sent by scripts
+func csrfHeader(provider CSRFTokenProvider) string {
+	if c, ok := provider.(*CSRFProtection); ok {
+		return c.header
+	}
+	return DefaultCSRFHeader
+}
+
+// Ensure CSRFProtection implements CSRFTokenProvider
+var _ CSRFTokenProvider = (*CSRFProtection)(nil)
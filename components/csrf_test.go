@@ -0,0 +1,66 @@
+package components
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestCSRFProtection_Middleware(t *testing.T) {
+	csrf := NewCSRFProtection([]byte("secret")).WithSecureCookie(false)
+	var rendered string
+	handler := csrf.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var sb strings.Builder
+		NewForm().WithCSRF(csrf, r).Render(&sb)
+		rendered = sb.String()
+	}))
+
+	// A first GET issues the cookie and renders its token
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != DefaultCSRFCookie || !cookies[0].HttpOnly {
+		t.Fatalf("Expected an HttpOnly CSRF cookie, got %v", cookies)
+	}
+	_, token := csrf.CSRFToken(httptest.NewRequest(http.MethodGet, "/", nil))
+	if token != "" {
+		t.Errorf("Expected no token outside the middleware, got %q", token)
+	}
+	token = csrf.sign(cookies[0].Value)
+	if !strings.Contains(rendered, `name="csrf_token" value="`+token+`"`) {
+		t.Errorf("Expected the token in the form, got: %s", rendered)
+	}
+
+	post := func(form url.Values, header string, withCookie bool) int {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if header != "" {
+			r.Header.Set(DefaultCSRFHeader, header)
+		}
+		if withCookie {
+			r.AddCookie(cookies[0])
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec.Code
+	}
+
+	for name, tc := range map[string]struct {
+		form       url.Values
+		header     string
+		withCookie bool
+		want       int
+	}{
+		"form field":    {url.Values{"csrf_token": {token}}, "", true, http.StatusOK},
+		"header":        {nil, token, true, http.StatusOK},
+		"missing token": {nil, "", true, http.StatusForbidden},
+		"wrong token":   {url.Values{"csrf_token": {"forged"}}, "", true, http.StatusForbidden},
+		"no cookie":     {url.Values{"csrf_token": {token}}, "", false, http.StatusForbidden},
+	} {
+		if got := post(tc.form, tc.header, tc.withCookie); got != tc.want {
+			t.Errorf("%s: expected status %d, got %d", name, tc.want, got)
+		}
+	}
+}
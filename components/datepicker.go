@@ -1,7 +1,9 @@
 package components
 
 import (
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,20 +15,24 @@ import (
 
 // DatePickerComponent represents a date picker input component
 type DatePickerComponent struct {
-	id         string
-	name       string
+	id          string
+	name        string
 	placeholder string
-	value      time.Time
-	disabled   bool
-	color      flyon.Color
-	size       flyon.Size
-	colorSet   bool
-	sizeSet    bool
-	format     string
-	minDate    time.Time
-	maxDate    time.Time
-	classes    []string
-	attributes map[string]string
+	value       time.Time
+	disabled    bool
+	color       flyon.Color
+	size        flyon.Size
+	colorSet    bool
+	sizeSet     bool
+	format      string
+	formatSet   bool
+	minDate     time.Time
+	maxDate     time.Time
+	values      []time.Time
+	location    *time.Location
+	config      *DatePickerConfig
+	classes     []string
+	attributes  map[string]string
 }
 
 // NewDatePicker creates a new DatePicker component with default values
@@ -65,6 +71,36 @@ func (d *DatePickerComponent) WithPlaceholder(placeholder string) *DatePickerCom
 func (d *DatePickerComponent) WithValue(value time.Time) *DatePickerComponent {
 	new := d.copy()
 	new.value = value
+	new.values = nil
+	return new
+}
+
+// WithValues sets several dates, e.g. the start and end of a range or the
+// dates of a multiple selection. It requires WithConfig.
+func (d *DatePickerComponent) WithValues(values ...time.Time) *DatePickerComponent {
+	new := d.copy()
+	new.values = append([]time.Time(nil), values...)
+	if len(values) > 0 {
+		new.value = values[0]
+	} else {
+		new.value = time.Time{}
+	}
+	return new
+}
+
+// WithLocation sets the time zone values are displayed and parsed in.
+// Values, min/max and disabled dates are converted to it before formatting,
+// so a time.Time stored in UTC shows the local day of the user.
+func (d *DatePickerComponent) WithLocation(location *time.Location) *DatePickerComponent {
+	new := d.copy()
+	new.location = location
+	return new
+}
+
+// WithConfig turns the input into a FlyonUI flatpickr datepicker
+func (d *DatePickerComponent) WithConfig(config DatePickerConfig) *DatePickerComponent {
+	new := d.copy()
+	new.config = &config
 	return new
 }
 
@@ -95,6 +131,7 @@ func (d *DatePickerComponent) WithSize(size flyon.Size) *DatePickerComponent {
 func (d *DatePickerComponent) WithFormat(format string) *DatePickerComponent {
 	new := d.copy()
 	new.format = format
+	new.formatSet = true
 	return new
 }
 
@@ -154,21 +191,40 @@ func (d *DatePickerComponent) copy() *DatePickerComponent {
 		newAttributes[k] = v
 	}
 
+	var newValues []time.Time
+	if d.values != nil {
+		newValues = make([]time.Time, len(d.values))
+		copy(newValues, d.values)
+	}
+
+	var newConfig *DatePickerConfig
+	if d.config != nil {
+		config := *d.config
+		config.DisabledDates = append([]time.Time(nil), d.config.DisabledDates...)
+		config.DisabledRanges = append([]DateRange(nil), d.config.DisabledRanges...)
+		config.DisabledWeekdays = append([]time.Weekday(nil), d.config.DisabledWeekdays...)
+		newConfig = &config
+	}
+
 	return &DatePickerComponent{
-		id:         d.id,
-		name:       d.name,
+		id:          d.id,
+		name:        d.name,
 		placeholder: d.placeholder,
-		value:      d.value,
-		disabled:   d.disabled,
-		color:      d.color,
-		size:       d.size,
-		colorSet:   d.colorSet,
-		sizeSet:    d.sizeSet,
-		format:     d.format,
-		minDate:    d.minDate,
-		maxDate:    d.maxDate,
-		classes:    newClasses,
-		attributes: newAttributes,
+		value:       d.value,
+		disabled:    d.disabled,
+		color:       d.color,
+		size:        d.size,
+		colorSet:    d.colorSet,
+		sizeSet:     d.sizeSet,
+		format:      d.format,
+		formatSet:   d.formatSet,
+		minDate:     d.minDate,
+		maxDate:     d.maxDate,
+		values:      newValues,
+		location:    d.location,
+		config:      newConfig,
+		classes:     newClasses,
+		attributes:  newAttributes,
 	}
 }
 
@@ -213,6 +269,10 @@ func (d *DatePickerComponent) Render(w io.Writer) error {
 	// Add custom classes
 	classes = append(classes, d.classes...)
 
+	if d.config != nil {
+		return d.renderFlatpickr(w, classes)
+	}
+
 	// Build attributes
 	attrs := []g.Node{
 		h.Type("date"),
@@ -229,16 +289,65 @@ func (d *DatePickerComponent) Render(w io.Writer) error {
 		attrs = append(attrs, h.Placeholder(d.placeholder))
 	}
 	if !d.value.IsZero() {
-		attrs = append(attrs, h.Value(d.value.Format("2006-01-02")))
+		attrs = append(attrs, h.Value(d.inLocation(d.value).Format("2006-01-02")))
 	}
 	if d.disabled {
 		attrs = append(attrs, h.Disabled())
 	}
 	if !d.minDate.IsZero() {
-		attrs = append(attrs, h.Min(d.minDate.Format("2006-01-02")))
+		attrs = append(attrs, h.Min(d.inLocation(d.minDate).Format("2006-01-02")))
 	}
 	if !d.maxDate.IsZero() {
-		attrs = append(attrs, h.Max(d.maxDate.Format("2006-01-02")))
+		attrs = append(attrs, h.Max(d.inLocation(d.maxDate).Format("2006-01-02")))
+	}
+
+	// Add custom attributes
+	for key, value := range d.attributes {
+		attrs = append(attrs, g.Attr(key, value))
+	}
+
+	return h.Input(attrs...).Render(w)
+}
+
+// renderFlatpickr renders the text input initialized as a flatpickr datepicker
+func (d *DatePickerComponent) renderFlatpickr(w io.Writer, classes []string) error {
+	config, err := marshalConfig(d.flatpickrData())
+	if err != nil {
+		return err
+	}
+
+	attrs := []g.Node{
+		h.Type("text"),
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-datepicker", config),
+	}
+
+	if d.id != "" {
+		attrs = append(attrs, h.ID(d.id))
+	}
+	if d.name != "" {
+		attrs = append(attrs, h.Name(d.name))
+	}
+	if d.placeholder != "" {
+		attrs = append(attrs, h.Placeholder(d.placeholder))
+	}
+	if value := d.formatValue(); value != "" {
+		attrs = append(attrs, h.Value(value))
+	}
+	if d.disabled {
+		attrs = append(attrs, h.Disabled())
+	}
+	// Weekday rules and first-day overrides need JavaScript functions and
+	// locale merging, so they are applied by the WASM behavior
+	if len(d.config.DisabledWeekdays) > 0 {
+		days := make([]string, len(d.config.DisabledWeekdays))
+		for i, day := range d.config.DisabledWeekdays {
+			days[i] = strconv.Itoa(int(day))
+		}
+		attrs = append(attrs, g.Attr("data-datepicker-disable-weekdays", strings.Join(days, ",")))
+	}
+	if d.config.FirstDayOfWeek != nil {
+		attrs = append(attrs, g.Attr("data-datepicker-first-day", strconv.Itoa(int(*d.config.FirstDayOfWeek))))
 	}
 
 	// Add custom attributes
@@ -247,4 +356,129 @@ func (d *DatePickerComponent) Render(w io.Writer) error {
 	}
 
 	return h.Input(attrs...).Render(w)
-}
\ No newline at end of file
+}
+
+// flatpickrData builds the flatpickr options from the config
+func (d *DatePickerComponent) flatpickrData() flatpickrData {
+	c := d.config
+	layout := d.layout()
+	data := flatpickrData{
+		DateFormat:      flatpickrFormat(layout),
+		EnableTime:      c.EnableTime || c.NoCalendar,
+		NoCalendar:      c.NoCalendar,
+		EnableSeconds:   c.EnableSeconds,
+		Time24Hour:      c.Time24Hour,
+		MinuteIncrement: c.MinuteIncrement,
+		Inline:          c.Inline,
+		WeekNumbers:     c.WeekNumbers,
+		Locale:          c.Locale,
+	}
+	if c.Mode != DatePickerModeSingle {
+		data.Mode = c.Mode.String()
+	}
+	if c.Mode == DatePickerModeRange {
+		data.RangeSeparator = datePickerRangeSeparator
+	}
+	if conjunction := c.conjunction(layout); c.Mode == DatePickerModeMultiple && conjunction != datePickerDefaultConjunction {
+		data.Conjunction = conjunction
+	}
+	if c.AltFormat != "" {
+		data.AltInput = true
+		data.AltFormat = flatpickrFormat(c.AltFormat)
+	}
+	if !d.minDate.IsZero() {
+		data.MinDate = d.inLocation(d.minDate).Format(layout)
+	}
+	if !d.maxDate.IsZero() {
+		data.MaxDate = d.inLocation(d.maxDate).Format(layout)
+	}
+	for _, date := range c.DisabledDates {
+		data.Disable = append(data.Disable, d.inLocation(date).Format(layout))
+	}
+	for _, r := range c.DisabledRanges {
+		data.Disable = append(data.Disable, flatpickrRange{
+			From: d.inLocation(r.From).Format(layout),
+			To:   d.inLocation(r.To).Format(layout),
+		})
+	}
+	return data
+}
+
+// layout returns the Go layout of the submitted value
+func (d *DatePickerComponent) layout() string {
+	if d.formatSet || d.config == nil {
+		return d.format
+	}
+	return d.config.defaultLayout()
+}
+
+// separator returns the string flatpickr puts between several dates
+func (d *DatePickerComponent) separator() string {
+	if d.config != nil && d.config.Mode == DatePickerModeRange {
+		return datePickerRangeSeparator
+	}
+	if d.config != nil {
+		return d.config.conjunction(d.layout())
+	}
+	return datePickerDefaultConjunction
+}
+
+// inLocation converts t to the configured location, if any
+func (d *DatePickerComponent) inLocation(t time.Time) time.Time {
+	if d.location == nil {
+		return t
+	}
+	return t.In(d.location)
+}
+
+// formatValue formats the value, or all values, as submitted by flatpickr
+func (d *DatePickerComponent) formatValue() string {
+	values := d.values
+	if len(values) == 0 && !d.value.IsZero() {
+		values = []time.Time{d.value}
+	}
+	layout := d.layout()
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, d.inLocation(v).Format(layout))
+	}
+	return strings.Join(parts, d.separator())
+}
+
+// ParseValue parses a submitted value using the layout, mode and location of
+// the datepicker. Range and multiple values yield one time per date; times are
+// interpreted in the location set with WithLocation, or UTC.
+func (d *DatePickerComponent) ParseValue(value string) ([]time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	location := d.location
+	if location == nil {
+		location = time.UTC
+	}
+	layout := d.layout()
+	if d.config == nil {
+		// Native date inputs always submit ISO dates
+		layout = "2006-01-02"
+	}
+
+	parts := []string{value}
+	if d.config != nil && d.config.Mode != DatePickerModeSingle {
+		parts = strings.Split(value, d.separator())
+	}
+
+	times := make([]time.Time, 0, len(parts))
+	for _, part := range parts {
+		t, err := time.ParseInLocation(layout, strings.TrimSpace(part), location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q: %w", part, err)
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+// Ensure DatePickerComponent implements flyon.Component
+var _ flyon.Component = (*DatePickerComponent)(nil)
@@ -0,0 +1,192 @@
+package components
+
+import (
+	"strings"
+	"time"
+)
+
+// DatePickerMode selects how many dates a flatpickr datepicker accepts
+type DatePickerMode int
+
+const (
+	// DatePickerModeSingle selects a single date
+	DatePickerModeSingle DatePickerMode = iota
+	// DatePickerModeMultiple selects any number of dates
+	DatePickerModeMultiple
+	// DatePickerModeRange selects a start and an end date
+	DatePickerModeRange
+)
+
+// String returns the flatpickr name of the mode
+func (m DatePickerMode) String() string {
+	switch m {
+	case DatePickerModeMultiple:
+		return "multiple"
+	case DatePickerModeRange:
+		return "range"
+	default:
+		return "single"
+	}
+}
+
+// DateRange is an inclusive range of dates
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// DatePickerConfig configures the flatpickr-backed datepicker.
+// All formats are Go time layouts; they are translated to flatpickr tokens
+// when rendered, so the server can format and parse values with the same layout.
+type DatePickerConfig struct {
+	// Mode selects single, multiple or range selection
+	Mode DatePickerMode
+	// EnableTime adds hour and minute selection
+	EnableTime bool
+	// NoCalendar hides the calendar, turning the picker into a time picker
+	NoCalendar bool
+	// EnableSeconds adds seconds to the time selection
+	EnableSeconds bool
+	// Time24Hour uses a 24 hour clock instead of AM/PM
+	Time24Hour bool
+	// MinuteIncrement is the step of the minute input
+	MinuteIncrement int
+	// Inline renders the calendar permanently below the input
+	Inline bool
+	// WeekNumbers shows ISO week numbers
+	WeekNumbers bool
+	// Locale is the name of a loaded flatpickr locale, e.g. "de"
+	Locale string
+	// FirstDayOfWeek overrides the first day of the week of the locale
+	FirstDayOfWeek *time.Weekday
+	// DisabledDates are days that cannot be selected
+	DisabledDates []time.Time
+	// DisabledRanges are ranges of days that cannot be selected
+	DisabledRanges []DateRange
+	// DisabledWeekdays are weekdays that cannot be selected, e.g. weekends
+	DisabledWeekdays []time.Weekday
+	// AltFormat is the layout shown to the user; the input still submits
+	// values in the layout set with WithFormat
+	AltFormat string
+	// Conjunction separates dates in multiple mode, ", " by default, or
+	// "; " when the layout itself contains ", "
+	Conjunction string
+}
+
+// Separators flatpickr puts between submitted dates. The range separator
+// comes from the flatpickr locale, so it is rendered explicitly to keep
+// every locale in line with formatValue and ParseValue.
+const (
+	datePickerRangeSeparator     = " to "
+	datePickerDefaultConjunction = ", "
+	datePickerLayoutConjunction  = "; "
+)
+
+// flatpickrData is the JSON representation of DatePickerConfig
+type flatpickrData struct {
+	Mode            string `json:"mode,omitempty"`
+	DateFormat      string `json:"dateFormat"`
+	EnableTime      bool   `json:"enableTime,omitempty"`
+	NoCalendar      bool   `json:"noCalendar,omitempty"`
+	EnableSeconds   bool   `json:"enableSeconds,omitempty"`
+	Time24Hour      bool   `json:"time_24hr,omitempty"`
+	MinuteIncrement int    `json:"minuteIncrement,omitempty"`
+	Inline          bool   `json:"inline,omitempty"`
+	WeekNumbers     bool   `json:"weekNumbers,omitempty"`
+	Locale          string `json:"locale,omitempty"`
+	AltInput        bool   `json:"altInput,omitempty"`
+	AltFormat       string `json:"altFormat,omitempty"`
+	Conjunction     string `json:"conjunction,omitempty"`
+	RangeSeparator  string `json:"rangeSeparator,omitempty"`
+	MinDate         string `json:"minDate,omitempty"`
+	MaxDate         string `json:"maxDate,omitempty"`
+	Disable         []any  `json:"disable,omitempty"`
+}
+
+// flatpickrRange is the JSON representation of a disabled DateRange
+type flatpickrRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// conjunction returns the separator used between dates in multiple mode.
+// The default gives way when the layout contains it, since the dates could
+// not be told apart otherwise.
+func (c DatePickerConfig) conjunction(layout string) string {
+	if c.Conjunction != "" {
+		return c.Conjunction
+	}
+	if strings.Contains(layout, datePickerDefaultConjunction) {
+		return datePickerLayoutConjunction
+	}
+	return datePickerDefaultConjunction
+}
+
+// defaultLayout returns the submitted layout matching the enabled features
+func (c DatePickerConfig) defaultLayout() string {
+	clock := "15:04"
+	if c.EnableSeconds {
+		clock += ":05"
+	}
+	switch {
+	case c.NoCalendar:
+		return clock
+	case c.EnableTime:
+		return "2006-01-02 " + clock
+	default:
+		return "2006-01-02"
+	}
+}
+
+// flatpickrTokens maps Go layout elements to flatpickr formatting tokens.
+// Longer elements come first so that e.g. "January" wins over "Jan".
+var flatpickrTokens = []struct {
+	layout string
+	token  string
+}{
+	{"January", "F"},
+	{"Monday", "l"},
+	{"2006", "Y"},
+	{"Jan", "M"},
+	{"Mon", "D"},
+	{"_2", "j"},
+	{"01", "m"},
+	{"02", "d"},
+	{"03", "G"},
+	{"04", "i"},
+	{"05", "S"},
+	{"06", "y"},
+	{"15", "H"},
+	{"PM", "K"},
+	{"pm", "K"},
+	{"1", "n"},
+	{"2", "j"},
+	{"3", "h"},
+	{"4", "i"},
+	{"5", "s"},
+}
+
+// flatpickrFormat translates a Go time layout into a flatpickr format string.
+// Literal letters are escaped so flatpickr does not read them as tokens;
+// layout elements without a flatpickr equivalent (time zones, fractional
+// seconds) are emitted literally.
+func flatpickrFormat(layout string) string {
+	var sb strings.Builder
+outer:
+	for len(layout) > 0 {
+		for _, t := range flatpickrTokens {
+			if strings.HasPrefix(layout, t.layout) {
+				sb.WriteString(t.token)
+				layout = layout[len(t.layout):]
+				continue outer
+			}
+		}
+		c := layout[0]
+		if c == '\\' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+		layout = layout[1:]
+	}
+	return sb.String()
+}
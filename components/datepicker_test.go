@@ -301,4 +301,144 @@ func TestDatePickerComponent_Immutability(t *testing.T) {
 	if modified.id != "new-id" {
 		t.Errorf("Expected modified ID to be 'new-id', got %s", modified.id)
 	}
-}
\ No newline at end of file
+}
+
+func TestDatePickerComponent_WithLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	// 23:30 UTC is already the next day in Berlin
+	value := time.Date(2024, 1, 15, 23, 30, 0, 0, time.UTC)
+	html := renderToStringDatePicker(NewDatePicker().WithValue(value).WithLocation(berlin))
+
+	if !strings.Contains(html, `value="2024-01-16"`) {
+		t.Errorf("Expected value in the configured location, got %s", html)
+	}
+}
+
+func TestDatePickerComponent_WithConfig(t *testing.T) {
+	monday := time.Monday
+	datePicker := NewDatePicker().
+		WithValue(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)).
+		WithMinDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithConfig(DatePickerConfig{
+			WeekNumbers:      true,
+			Inline:           true,
+			Locale:           "de",
+			FirstDayOfWeek:   &monday,
+			DisabledDates:    []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+			DisabledRanges:   []DateRange{{From: time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 8, 14, 0, 0, 0, 0, time.UTC)}},
+			DisabledWeekdays: []time.Weekday{time.Saturday, time.Sunday},
+			AltFormat:        "January 2, 2006",
+		})
+	html := renderToStringDatePicker(datePicker)
+
+	expected := []string{
+		`type="text"`,
+		`value="2024-01-15"`,
+		`&#34;dateFormat&#34;:&#34;Y-m-d&#34;`,
+		`&#34;inline&#34;:true`,
+		`&#34;weekNumbers&#34;:true`,
+		`&#34;locale&#34;:&#34;de&#34;`,
+		`&#34;altInput&#34;:true`,
+		`&#34;altFormat&#34;:&#34;F j, Y&#34;`,
+		`&#34;minDate&#34;:&#34;2024-01-01&#34;`,
+		`&#34;disable&#34;:[&#34;2024-12-25&#34;,{&#34;from&#34;:&#34;2024-08-01&#34;,&#34;to&#34;:&#34;2024-08-14&#34;}]`,
+		`data-datepicker-disable-weekdays="6,0"`,
+		`data-datepicker-first-day="1"`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+	if strings.Contains(html, `min="`) {
+		t.Errorf("Expected min date to move into the config, got %s", html)
+	}
+}
+
+func TestDatePickerComponent_RangeAndTime(t *testing.T) {
+	from := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC)
+	datePicker := NewDatePicker().
+		WithValues(from, to).
+		WithConfig(DatePickerConfig{Mode: DatePickerModeRange, EnableTime: true, Time24Hour: true})
+	html := renderToStringDatePicker(datePicker)
+
+	if !strings.Contains(html, `value="2024-03-01 09:30 to 2024-03-05 17:00"`) {
+		t.Errorf("Expected range value, got %s", html)
+	}
+	if !strings.Contains(html, `&#34;dateFormat&#34;:&#34;Y-m-d H:i&#34;`) || !strings.Contains(html, `&#34;mode&#34;:&#34;range&#34;`) {
+		t.Errorf("Expected datetime range config, got %s", html)
+	}
+	// Locales have their own separator, so the one ParseValue expects is explicit
+	if !strings.Contains(html, `&#34;rangeSeparator&#34;:&#34; to &#34;`) {
+		t.Errorf("Expected explicit range separator, got %s", html)
+	}
+
+	parsed, err := datePicker.ParseValue("2024-03-01 09:30 to 2024-03-05 17:00")
+	if err != nil {
+		t.Fatalf("Expected value to parse, got %v", err)
+	}
+	if len(parsed) != 2 || !parsed[0].Equal(from) || !parsed[1].Equal(to) {
+		t.Errorf("Expected parsed range to round-trip, got %v", parsed)
+	}
+	if _, err := datePicker.ParseValue("tomorrow"); err == nil {
+		t.Error("Expected an error for an invalid value")
+	}
+}
+
+func TestDatePickerComponent_ParseValueLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	datePicker := NewDatePicker().WithLocation(tokyo).WithFormat("02.01.2006").WithConfig(DatePickerConfig{})
+
+	parsed, err := datePicker.ParseValue("16.01.2024")
+	if err != nil {
+		t.Fatalf("Expected value to parse, got %v", err)
+	}
+	if want := time.Date(2024, 1, 16, 0, 0, 0, 0, tokyo); !parsed[0].Equal(want) {
+		t.Errorf("Expected %v, got %v", want, parsed[0])
+	}
+}
+
+func TestDatePickerComponent_MultipleLayoutConjunction(t *testing.T) {
+	first := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)
+	datePicker := NewDatePicker().
+		WithFormat("Jan 2, 2006").
+		WithValues(first, second).
+		WithConfig(DatePickerConfig{Mode: DatePickerModeMultiple})
+	html := renderToStringDatePicker(datePicker)
+
+	// The layout contains ", ", so the dates are joined with "; " instead
+	if !strings.Contains(html, `value="Jan 2, 2024; Feb 3, 2024"`) {
+		t.Errorf("Expected dates joined with the layout conjunction, got %s", html)
+	}
+	if !strings.Contains(html, `&#34;conjunction&#34;:&#34;; &#34;`) {
+		t.Errorf("Expected explicit conjunction, got %s", html)
+	}
+
+	parsed, err := datePicker.ParseValue("Jan 2, 2024; Feb 3, 2024")
+	if err != nil {
+		t.Fatalf("Expected value to parse, got %v", err)
+	}
+	if len(parsed) != 2 || !parsed[0].Equal(first) || !parsed[1].Equal(second) {
+		t.Errorf("Expected parsed dates to round-trip, got %v", parsed)
+	}
+}
+
+func TestFlatpickrFormat(t *testing.T) {
+	tests := map[string]string{
+		"2006-01-02":          "Y-m-d",
+		"2006-01-02 15:04:05": "Y-m-d H:i:S",
+		"Mon, Jan 2 3:04 PM":  "D, M j h:i K",
+		"Monday 02.01.06":     "l d.m.y",
+		"2 January at 15h":    "j F \\a\\t H\\h",
+	}
+	for layout, expected := range tests {
+		if got := flatpickrFormat(layout); got != expected {
+			t.Errorf("flatpickrFormat(%q) = %q, expected %q", layout, got, expected)
+		}
+	}
+}
@@ -13,18 +13,26 @@ import (
 type DrawerSide int
 
 const (
-	DrawerLeft DrawerSide = iota
-	DrawerRight
+	DrawerStart DrawerSide = iota
+	DrawerEnd
 	DrawerTop
 	DrawerBottom
 )
 
+// DrawerLeft and DrawerRight are the former names of DrawerStart and DrawerEnd.
+//
+// Deprecated: Use DrawerStart and DrawerEnd, which follow the text direction.
+const (
+	DrawerLeft  = DrawerStart
+	DrawerRight = DrawerEnd
+)
+
 // String returns the CSS class for the drawer side.
 func (ds DrawerSide) String() string {
 	switch ds {
-	case DrawerLeft:
+	case DrawerStart:
 		return "drawer-start"
-	case DrawerRight:
+	case DrawerEnd:
 		return "drawer-end"
 	case DrawerTop:
 		return "drawer-top"
@@ -35,30 +43,92 @@ func (ds DrawerSide) String() string {
 	}
 }
 
-// DrawerComponent represents a drawer/sidebar component.
+// vertical reports whether the drawer slides in from the top or bottom.
+func (ds DrawerSide) vertical() bool {
+	return ds == DrawerTop || ds == DrawerBottom
+}
+
+// shownClass returns the utility moving the drawer into view.
+func (ds DrawerSide) shownClass() string {
+	if ds.vertical() {
+		return "translate-y-0"
+	}
+	return "translate-x-0"
+}
+
+// DrawerSize represents the width of a start or end drawer, or the height
+// of a top or bottom drawer.
+type DrawerSize int
+
+const (
+	DrawerSizeDefault DrawerSize = iota // FlyonUI default size
+	DrawerSizeSmall
+	DrawerSizeMedium
+	DrawerSizeLarge
+	DrawerSizeExtraLarge
+	DrawerSizeFull
+)
+
+// class returns the size utility for a drawer on side.
+func (s DrawerSize) class(side DrawerSide) string {
+	prefix := "max-w-"
+	if side.vertical() {
+		prefix = "max-h-"
+	}
+	switch s {
+	case DrawerSizeSmall:
+		return prefix + "xs"
+	case DrawerSizeMedium:
+		return prefix + "sm"
+	case DrawerSizeLarge:
+		return prefix + "md"
+	case DrawerSizeExtraLarge:
+		return prefix + "xl"
+	case DrawerSizeFull:
+		return prefix + "full"
+	default:
+		return ""
+	}
+}
+
+// DrawerComponent represents an offcanvas drawer built on FlyonUI's overlay.
 type DrawerComponent struct {
 	id       string
+	title    string
 	side     DrawerSide
+	size     DrawerSize
 	open     bool
-	overlay  bool // Show overlay when open
-	content  gomponents.Node // Main content
-	sidebar  gomponents.Node // Drawer sidebar content
+	backdrop bool
+	closable bool
+	keyboard bool
+	pinned   string // Breakpoint from which the drawer is always visible
+	header   []gomponents.Node
+	body     []gomponents.Node
+	footer   []gomponents.Node
 	classes  []string
 }
 
-// NewDrawer creates a new drawer component with the given content and sidebar.
-func NewDrawer(content, sidebar gomponents.Node) *DrawerComponent {
+// NewDrawer creates a new drawer with the given title and body content. It
+// is hidden until opened by a DrawerToggleButton or from Go, and renders
+// only the drawer: render the main page content next to it.
+func NewDrawer(title string, body ...gomponents.Node) *DrawerComponent {
 	return &DrawerComponent{
-		id:      generateID(),
-		side:    DrawerLeft,
-		open:    false,
-		overlay: true, // Default to overlay mode
-		content: content,
-		sidebar: sidebar,
-		classes: []string{},
+		id:       "drawer-" + generateID(),
+		title:    title,
+		side:     DrawerStart,
+		backdrop: true,
+		closable: true,
+		keyboard: true,
+		body:     body,
+		classes:  []string{},
 	}
 }
 
+// ID returns the ID of the drawer, for use with DrawerToggleButton.
+func (dc *DrawerComponent) ID() string {
+	return dc.id
+}
+
 // WithID sets the ID for the drawer component.
 func (dc *DrawerComponent) WithID(id string) *DrawerComponent {
 	newDC := dc.copy()
@@ -73,6 +143,13 @@ func (dc *DrawerComponent) WithSide(side DrawerSide) *DrawerComponent {
 	return newDC
 }
 
+// WithSize sets the width, or the height for top and bottom drawers.
+func (dc *DrawerComponent) WithSize(size DrawerSize) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.size = size
+	return newDC
+}
+
 // WithOpen sets the initial open state of the drawer.
 func (dc *DrawerComponent) WithOpen(open bool) *DrawerComponent {
 	newDC := dc.copy()
@@ -80,10 +157,63 @@ func (dc *DrawerComponent) WithOpen(open bool) *DrawerComponent {
 	return newDC
 }
 
-// WithOverlay enables or disables the overlay when drawer is open.
+// WithBackdrop controls whether the drawer has a backdrop when open.
+func (dc *DrawerComponent) WithBackdrop(backdrop bool) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.backdrop = backdrop
+	return newDC
+}
+
+// WithOverlay enables or disables the backdrop when drawer is open.
+//
+// Deprecated: Use WithBackdrop.
 func (dc *DrawerComponent) WithOverlay(overlay bool) *DrawerComponent {
+	return dc.WithBackdrop(overlay)
+}
+
+// WithClosable controls whether the drawer shows a close button and can be
+// closed by clicking the backdrop or pressing escape.
+func (dc *DrawerComponent) WithClosable(closable bool) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.closable = closable
+	return newDC
+}
+
+// WithKeyboard controls whether a closable drawer can be closed with the
+// Escape key.
+func (dc *DrawerComponent) WithKeyboard(keyboard bool) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.keyboard = keyboard
+	return newDC
+}
+
+// WithPinned keeps the drawer visible from the breakpoint up, e.g. "lg",
+// and offcanvas below it. Use it for app shell sidebars; offset the page
+// content from the breakpoint, e.g. with lg:ps-64 for a start drawer.
+func (dc *DrawerComponent) WithPinned(breakpoint string) *DrawerComponent {
 	newDC := dc.copy()
-	newDC.overlay = overlay
+	newDC.pinned = breakpoint
+	return newDC
+}
+
+// WithHeader adds nodes to the header, next to the title.
+func (dc *DrawerComponent) WithHeader(nodes ...gomponents.Node) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.header = append(newDC.header, nodes...)
+	return newDC
+}
+
+// WithBody replaces the body content.
+func (dc *DrawerComponent) WithBody(nodes ...gomponents.Node) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.body = append([]gomponents.Node(nil), nodes...)
+	return newDC
+}
+
+// WithFooter sets the footer content, typically action buttons.
+func (dc *DrawerComponent) WithFooter(nodes ...gomponents.Node) *DrawerComponent {
+	newDC := dc.copy()
+	newDC.footer = append([]gomponents.Node(nil), nodes...)
 	return newDC
 }
 
@@ -101,6 +231,10 @@ func (dc *DrawerComponent) With(modifiers ...any) flyon.Component {
 		switch m := modifier.(type) {
 		case DrawerSide:
 			newDC.side = m
+		case DrawerSize:
+			newDC.size = m
+		case string:
+			newDC.classes = append(newDC.classes, m)
 		}
 	}
 	return newDC
@@ -108,87 +242,108 @@ func (dc *DrawerComponent) With(modifiers ...any) flyon.Component {
 
 // copy creates a deep copy of the drawer component.
 func (dc *DrawerComponent) copy() *DrawerComponent {
-	newClasses := make([]string, len(dc.classes))
-	copy(newClasses, dc.classes)
-	
-	return &DrawerComponent{
-		id:      dc.id,
-		side:    dc.side,
-		open:    dc.open,
-		overlay: dc.overlay,
-		content: dc.content,
-		sidebar: dc.sidebar,
-		classes: newClasses,
-	}
+	newDC := *dc
+	newDC.header = append([]gomponents.Node(nil), dc.header...)
+	newDC.body = append([]gomponents.Node(nil), dc.body...)
+	newDC.footer = append([]gomponents.Node(nil), dc.footer...)
+	newDC.classes = append([]string{}, dc.classes...)
+	return &newDC
 }
 
 // Render renders the drawer component to HTML.
 func (dc *DrawerComponent) Render(w io.Writer) error {
+	options := newOverlayOptions(dc.backdrop, dc.closable, dc.keyboard)
+
 	// Build CSS classes
-	classes := []string{"drawer"}
-	classes = append(classes, dc.side.String())
+	classes := []string{"overlay", "overlay-open:" + dc.side.shownClass(), "drawer", dc.side.String()}
+	if size := dc.size.class(dc.side); size != "" {
+		classes = append(classes, size)
+	}
+	if class := options.class(); class != "" {
+		classes = append(classes, class)
+	}
+	if bp := dc.pinned; bp != "" {
+		// Closes itself when the viewport grows past the breakpoint and
+		// stays in the layout from there
+		classes = append(classes, "[--auto-close:"+bp+"]", bp+":shadow-none", bp+":z-0", bp+":flex", bp+":"+dc.side.shownClass())
+	}
 	classes = append(classes, dc.classes...)
-	
-	// Create the drawer component
-	drawerContainer := h.Div(
+	// An open drawer starts in the state the overlay gives it once opened
+	if dc.open {
+		classes = append(classes, "open", "opened")
+	} else {
+		classes = append(classes, "hidden")
+	}
+
+	attrs := []gomponents.Node{
 		h.ID(dc.id),
 		h.Class(strings.Join(classes, " ")),
+		h.Role("dialog"),
+		gomponents.Attr("tabindex", "-1"),
 		gomponents.Attr("data-component", "drawer"),
-		
-		// Hidden checkbox for state management
-		h.Input(
-			h.Type("checkbox"),
-			h.ID(dc.id+"-toggle"),
-			h.Class("drawer-toggle"),
-			gomponents.If(dc.open, h.Checked()),
-		),
-		
-		// Drawer content (main content area)
-		h.Div(
-			h.Class("drawer-content flex flex-col"),
-			dc.content,
-		),
-		
-		// Drawer side (sidebar)
-		h.Div(
-			h.Class("drawer-side"),
-			
-			// Overlay (if enabled)
-			gomponents.If(dc.overlay,
-				h.Label(
-					h.For(dc.id+"-toggle"),
-					h.Class("drawer-overlay"),
-					gomponents.Attr("aria-label", "close sidebar"),
-				),
-			),
-			
-			// Sidebar content
-		h.Aside(
-				h.Class("bg-base-200 min-h-full w-80 p-4"),
-				dc.sidebar,
-			),
-		),
-	)
-	
-	return drawerContainer.Render(w)
+	}
+	if dc.title != "" {
+		attrs = append(attrs, h.Aria("labelledby", dc.id+"-title"))
+	}
+	attrs = append(attrs, options.attributes()...)
+
+	// Header
+	var headerNodes []gomponents.Node
+	if dc.title != "" {
+		headerNodes = append(headerNodes, h.H3(
+			h.ID(dc.id+"-title"),
+			h.Class("drawer-title"),
+			gomponents.Text(dc.title),
+		))
+	}
+	headerNodes = append(headerNodes, dc.header...)
+	if dc.closable {
+		closeClasses := "btn btn-text btn-circle btn-sm absolute end-3 top-3"
+		// A pinned drawer cannot be closed from the breakpoint up
+		if dc.pinned != "" {
+			closeClasses += " " + dc.pinned + ":hidden"
+		}
+		headerNodes = append(headerNodes, h.Button(
+			h.Type("button"),
+			h.Class(closeClasses),
+			h.Aria("label", "Close"),
+			gomponents.Attr("data-overlay", "#"+dc.id),
+			h.Span(h.Class("icon-[tabler--x] size-5")),
+		))
+	}
+
+	drawer := h.Div(append(attrs,
+		gomponents.If(len(headerNodes) > 0, h.Div(h.Class("drawer-header"), gomponents.Group(headerNodes))),
+		h.Div(h.Class("drawer-body"), gomponents.Group(dc.body)),
+		gomponents.If(len(dc.footer) > 0, h.Div(h.Class("drawer-footer"), gomponents.Group(dc.footer))),
+	)...)
+
+	return drawer.Render(w)
 }
 
-// DrawerToggleButton creates a button that toggles the drawer.
+// DrawerToggleButton creates a button that opens or closes the drawer.
 func DrawerToggleButton(drawerID, text string) gomponents.Node {
-	return h.Label(
-		h.For(drawerID+"-toggle"),
+	return h.Button(
+		h.Type("button"),
 		h.Class("btn btn-square btn-ghost drawer-button"),
-		gomponents.Attr("aria-label", "toggle drawer"),
+		h.Aria("haspopup", "dialog"),
+		h.Aria("expanded", "false"),
+		h.Aria("controls", drawerID),
+		h.Aria("label", "Toggle drawer"),
+		gomponents.Attr("data-overlay", "#"+drawerID),
 		gomponents.Text(text),
 	)
 }
 
-// DrawerCloseButton creates a button that closes the drawer.
+// DrawerCloseButton creates a button that closes the drawer, e.g. in its footer.
 func DrawerCloseButton(drawerID, text string) gomponents.Node {
-	return h.Label(
-		h.For(drawerID+"-toggle"),
-		h.Class("btn btn-sm btn-circle btn-ghost absolute right-2 top-2"),
-		gomponents.Attr("aria-label", "close drawer"),
+	return h.Button(
+		h.Type("button"),
+		h.Class("btn btn-soft btn-secondary"),
+		gomponents.Attr("data-overlay", "#"+drawerID),
 		gomponents.Text(text),
 	)
-}
\ No newline at end of file
+}
+
+// Ensure DrawerComponent implements flyon.Component
+var _ flyon.Component = (*DrawerComponent)(nil)
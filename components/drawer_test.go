@@ -27,8 +27,8 @@ func TestDrawerSide_String(t *testing.T) {
 		side     DrawerSide
 		expected string
 	}{
-		{DrawerLeft, "drawer-start"},
-		{DrawerRight, "drawer-end"},
+		{DrawerStart, "drawer-start"},
+		{DrawerEnd, "drawer-end"},
 		{DrawerTop, "drawer-top"},
 		{DrawerBottom, "drawer-bottom"},
 		{DrawerSide(999), "drawer-start"}, // Invalid value defaults to left
@@ -42,7 +42,7 @@ func TestDrawerSide_String(t *testing.T) {
 }
 
 func TestDrawerComponent_WithID(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
 	modified := drawer.WithID("custom-id")
 	
 	if modified.id != "custom-id" {
@@ -56,7 +56,7 @@ func TestDrawerComponent_WithID(t *testing.T) {
 }
 
 func TestDrawerComponent_WithSide(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
 	modified := drawer.WithSide(DrawerRight)
 	
 	if modified.side != DrawerRight {
@@ -70,7 +70,7 @@ func TestDrawerComponent_WithSide(t *testing.T) {
 }
 
 func TestDrawerComponent_WithOpen(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
 	modified := drawer.WithOpen(true)
 	
 	if !modified.open {
@@ -83,22 +83,22 @@ func TestDrawerComponent_WithOpen(t *testing.T) {
 	}
 }
 
-func TestDrawerComponent_WithOverlay(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
-	modified := drawer.WithOverlay(false)
+func TestDrawerComponent_WithBackdrop(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
+	modified := drawer.WithBackdrop(false)
 	
-	if modified.overlay {
-		t.Error("Expected overlay to be disabled")
+	if modified.backdrop {
+		t.Error("Expected backdrop to be disabled")
 	}
 	
 	// Ensure original is unchanged (default is true)
-	if !drawer.overlay {
+	if !drawer.backdrop {
 		t.Error("Original drawer component should not be modified")
 	}
 }
 
 func TestDrawerComponent_WithClasses(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
 	modified := drawer.WithClasses("custom-class", "another-class")
 	
 	if len(modified.classes) != 2 {
@@ -115,7 +115,7 @@ func TestDrawerComponent_WithClasses(t *testing.T) {
 }
 
 func TestDrawerComponent_With(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content"))
 	modified := drawer.With(DrawerRight)
 	
 	modifiedDrawer, ok := modified.(*DrawerComponent)
@@ -129,71 +129,147 @@ func TestDrawerComponent_With(t *testing.T) {
 }
 
 func TestDrawerComponent_Render(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithID("nav")
 	html := renderToStringDrawer(drawer)
 	
-	// Check for basic structure
-	if !strings.Contains(html, `class="drawer drawer-start"`) {
-		t.Error("Expected drawer container with start class")
+	if !strings.Contains(html, `class="overlay overlay-open:translate-x-0 drawer drawer-start hidden"`) {
+		t.Errorf("Expected overlay drawer classes, got %s", html)
 	}
-	if !strings.Contains(html, `data-component="drawer"`) {
-		t.Error("Expected data-component attribute")
+	if !strings.Contains(html, `role="dialog"`) || !strings.Contains(html, `tabindex="-1"`) {
+		t.Error("Expected dialog role and tabindex")
 	}
-	if !strings.Contains(html, `type="checkbox"`) {
-		t.Error("Expected checkbox input")
+	if !strings.Contains(html, `aria-labelledby="nav-title"`) || !strings.Contains(html, `<h3 id="nav-title" class="drawer-title">Menu</h3>`) {
+		t.Error("Expected title labelling the drawer")
 	}
-	if !strings.Contains(html, `class="drawer-toggle"`) {
-		t.Error("Expected drawer-toggle class on checkbox")
+	if !strings.Contains(html, `data-overlay="#nav"`) || !strings.Contains(html, `aria-label="Close"`) {
+		t.Error("Expected close button targeting the drawer")
 	}
-	if !strings.Contains(html, `class="drawer-content flex flex-col"`) {
-		t.Error("Expected drawer-content class")
+	if !strings.Contains(html, `<div class="drawer-body">Sidebar content</div>`) {
+		t.Error("Expected drawer body with content")
 	}
-	if !strings.Contains(html, "Main content") {
-		t.Error("Expected main content text")
+	if strings.Contains(html, "drawer-footer") {
+		t.Error("Should not render an empty footer")
 	}
-	if !strings.Contains(html, `class="drawer-side"`) {
-		t.Error("Expected drawer-side class")
+	if strings.Contains(html, "drawer-toggle") || strings.Contains(html, `type="checkbox"`) {
+		t.Error("Should not render the checkbox toggle pattern")
 	}
-	if !strings.Contains(html, `class="drawer-overlay"`) {
-		t.Error("Expected drawer-overlay class")
+}
+
+func TestDrawerComponent_RenderOpen(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithOpen(true)
+	html := renderToStringDrawer(drawer)
+	
+	if strings.Contains(html, "hidden") {
+		t.Error("Expected open drawer not to be hidden")
 	}
-	if !strings.Contains(html, `class="bg-base-200 min-h-full w-80 p-4"`) {
-		t.Error("Expected sidebar styling classes")
+	if !strings.Contains(html, "drawer drawer-start open opened") {
+		t.Errorf("Expected open drawer to be in the open overlay state, got %s", html)
 	}
-	if !strings.Contains(html, "Sidebar content") {
-		t.Error("Expected sidebar content text")
+}
+
+func TestDrawerComponent_RenderSide(t *testing.T) {
+	tests := []struct {
+		side     DrawerSide
+		expected string
+	}{
+		{DrawerEnd, "overlay-open:translate-x-0 drawer drawer-end"},
+		{DrawerTop, "overlay-open:translate-y-0 drawer drawer-top"},
+		{DrawerBottom, "overlay-open:translate-y-0 drawer drawer-bottom"},
+	}
+	
+	for _, test := range tests {
+		html := renderToStringDrawer(NewDrawer("Menu").WithSide(test.side))
+		if !strings.Contains(html, test.expected) {
+			t.Errorf("Expected %q for side %d, got %s", test.expected, test.side, html)
+		}
 	}
 }
 
-func TestDrawerComponent_RenderOpen(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content")).WithOpen(true)
+func TestDrawerComponent_RenderSize(t *testing.T) {
+	if html := renderToStringDrawer(NewDrawer("Menu").WithSize(DrawerSizeSmall)); !strings.Contains(html, "max-w-xs") {
+		t.Error("Expected width class for start drawer")
+	}
+	if html := renderToStringDrawer(NewDrawer("Menu").WithSide(DrawerBottom).WithSize(DrawerSizeLarge)); !strings.Contains(html, "max-h-md") {
+		t.Error("Expected height class for bottom drawer")
+	}
+	if html := renderToStringDrawer(NewDrawer("Menu")); strings.Contains(html, "max-w-") {
+		t.Error("Default size should not render a size class")
+	}
+}
+
+func TestDrawerComponent_RenderSlots(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Body")).
+		WithHeader(g.Text("Subtitle")).
+		WithFooter(DrawerCloseButton("nav", "Close"), g.Text("Save"))
 	html := renderToStringDrawer(drawer)
 	
-	if !strings.Contains(html, "checked") {
-		t.Error("Expected checkbox to be checked when drawer is open")
+	if !strings.Contains(html, "Menu</h3>Subtitle") {
+		t.Error("Expected header nodes after the title")
+	}
+	if !strings.Contains(html, `<div class="drawer-footer"><button type="button" class="btn btn-soft btn-secondary" data-overlay="#nav">Close</button>Save</div>`) {
+		t.Errorf("Expected footer with close button, got %s", html)
 	}
 }
 
-func TestDrawerComponent_RenderSide(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content")).WithSide(DrawerRight)
+func TestDrawerComponent_RenderNoBackdrop(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithBackdrop(false)
 	html := renderToStringDrawer(drawer)
 	
-	if !strings.Contains(html, `class="drawer drawer-end"`) {
-		t.Error("Expected drawer container with end class")
+	if !strings.Contains(html, "[--overlay-backdrop:false]") {
+		t.Error("Expected backdrop to be disabled")
+	}
+	if !strings.Contains(html, `data-overlay-options="{&#34;backdrop&#34;:&#34;false&#34;,&#34;keyboard&#34;:true}"`) {
+		t.Error("Expected overlay options without backdrop")
 	}
 }
 
-func TestDrawerComponent_RenderNoOverlay(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content")).WithOverlay(false)
+func TestDrawerComponent_RenderNotClosable(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithClosable(false)
 	html := renderToStringDrawer(drawer)
 	
-	if strings.Contains(html, "drawer-overlay") {
-		t.Error("Should not contain overlay when disabled")
+	if strings.Contains(html, `aria-label="Close"`) {
+		t.Error("Should not render a close button")
+	}
+	if !strings.Contains(html, "[--overlay-backdrop:static]") || !strings.Contains(html, `data-overlay-keyboard="false"`) {
+		t.Error("Expected static backdrop and disabled keyboard")
+	}
+}
+
+func TestDrawerComponent_ClosableKeyboardOrder(t *testing.T) {
+	base := NewDrawer("Menu", g.Text("Sidebar content")).WithID("menu")
+	first := renderToStringDrawer(base.WithClosable(false).WithKeyboard(true))
+	second := renderToStringDrawer(base.WithKeyboard(true).WithClosable(false))
+	if first != second {
+		t.Errorf("Expected the same drawer whatever the call order, got:\n%s\n%s", first, second)
+	}
+
+	html := renderToStringDrawer(base.WithClosable(false).WithClosable(true))
+	if strings.Contains(html, `data-overlay-keyboard="false"`) {
+		t.Error("Expected the keyboard to be enabled again with the drawer closable")
+	}
+}
+
+func TestDrawerComponent_RenderPinned(t *testing.T) {
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithPinned("lg")
+	html := renderToStringDrawer(drawer)
+	
+	for _, class := range []string{"[--auto-close:lg]", "lg:shadow-none", "lg:z-0", "lg:flex", "lg:translate-x-0", "hidden"} {
+		if !strings.Contains(html, class) {
+			t.Errorf("Expected pinned class %q", class)
+		}
+	}
+	if !strings.Contains(html, "top-3 lg:hidden") {
+		t.Error("Expected close button hidden from the breakpoint")
+	}
+
+	top := renderToStringDrawer(NewDrawer("Menu").WithSide(DrawerTop).WithPinned("md"))
+	if !strings.Contains(top, "md:translate-y-0") || strings.Contains(top, "md:translate-x-0") {
+		t.Errorf("Expected a pinned top drawer to move vertically into view, got %s", top)
 	}
 }
 
 func TestDrawerComponent_RenderWithClasses(t *testing.T) {
-	drawer := NewDrawer(g.Text("Main content"), g.Text("Sidebar content")).WithClasses("custom-class")
+	drawer := NewDrawer("Menu", g.Text("Sidebar content")).WithClasses("custom-class")
 	html := renderToStringDrawer(drawer)
 	
 	if !strings.Contains(html, "custom-class") {
@@ -205,8 +281,11 @@ func TestDrawerToggleButton(t *testing.T) {
 	button := DrawerToggleButton("test-drawer", "☰")
 	html := renderNodeToString(button)
 	
-	if !strings.Contains(html, `for="test-drawer-toggle"`) {
-		t.Error("Expected for attribute pointing to drawer toggle")
+	if !strings.Contains(html, `data-overlay="#test-drawer"`) {
+		t.Error("Expected data-overlay targeting the drawer")
+	}
+	if !strings.Contains(html, `aria-controls="test-drawer"`) || !strings.Contains(html, `aria-haspopup="dialog"`) {
+		t.Error("Expected ARIA attributes for the drawer")
 	}
 	if !strings.Contains(html, `class="btn btn-square btn-ghost drawer-button"`) {
 		t.Error("Expected button classes")
@@ -220,11 +299,8 @@ func TestDrawerCloseButton(t *testing.T) {
 	button := DrawerCloseButton("test-drawer", "✕")
 	html := renderNodeToString(button)
 	
-	if !strings.Contains(html, `for="test-drawer-toggle"`) {
-		t.Error("Expected for attribute pointing to drawer toggle")
-	}
-	if !strings.Contains(html, `class="btn btn-sm btn-circle btn-ghost absolute right-2 top-2"`) {
-		t.Error("Expected close button classes")
+	if !strings.Contains(html, `data-overlay="#test-drawer"`) {
+		t.Error("Expected data-overlay targeting the drawer")
 	}
 	if !strings.Contains(html, "✕") {
 		t.Error("Expected close button text")
@@ -232,18 +308,19 @@ func TestDrawerCloseButton(t *testing.T) {
 }
 
 func TestDrawerComponent_Immutability(t *testing.T) {
-	original := NewDrawer(g.Text("Main content"), g.Text("Sidebar content"))
+	original := NewDrawer("Menu", g.Text("Sidebar content"))
 	originalID := original.id
 	originalSide := original.side
 	originalOpen := original.open
-	originalOverlay := original.overlay
+	originalBackdrop := original.backdrop
 	originalClassCount := len(original.classes)
 	
 	// Apply various modifications
 	_ = original.WithID("new-id")
 	_ = original.WithSide(DrawerRight)
 	_ = original.WithOpen(true)
-	_ = original.WithOverlay(false)
+	_ = original.WithBackdrop(false)
+	_ = original.WithFooter(g.Text("Footer"))
 	_ = original.WithClasses("new-class")
 	_ = original.With(DrawerBottom)
 	
@@ -257,8 +334,8 @@ func TestDrawerComponent_Immutability(t *testing.T) {
 	if original.open != originalOpen {
 		t.Error("Original open state should not change")
 	}
-	if original.overlay != originalOverlay {
-		t.Error("Original overlay state should not change")
+	if original.backdrop != originalBackdrop {
+		t.Error("Original backdrop state should not change")
 	}
 	if len(original.classes) != originalClassCount {
 		t.Error("Original classes should not change")
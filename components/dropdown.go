@@ -14,6 +14,7 @@ import (
 // DropdownComponent represents a FlyonUI dropdown component with interactive behavior
 type DropdownComponent struct {
 	trigger    gomponents.Node
+	custom     bool // Render the trigger as is instead of wrapping it in a button
 	content    []gomponents.Node
 	attributes []gomponents.Node
 	classes    []string
@@ -21,6 +22,10 @@ type DropdownComponent struct {
 	position   DropdownPosition
 	autoClose  bool
 	disabled   bool
+	placement  Placement
+	offset     *int
+	strategy   PositionStrategy
+	openOn     OpenTrigger
 }
 
 // DropdownPosition represents the position of the dropdown menu
@@ -85,6 +90,46 @@ func (d *DropdownComponent) WithPosition(position DropdownPosition) *DropdownCom
 	return newDropdown
 }
 
+// WithPlacement sets the floating placement of the menu, which FlyonUI
+// flips to stay in the viewport
+func (d *DropdownComponent) WithPlacement(placement Placement) *DropdownComponent {
+	newDropdown := d.copy()
+	newDropdown.placement = placement
+	return newDropdown
+}
+
+// WithOffset sets the distance between the trigger and the menu in pixels
+func (d *DropdownComponent) WithOffset(offset int) *DropdownComponent {
+	newDropdown := d.copy()
+	newDropdown.offset = &offset
+	return newDropdown
+}
+
+// WithStrategy sets the CSS position strategy of the menu
+func (d *DropdownComponent) WithStrategy(strategy PositionStrategy) *DropdownComponent {
+	newDropdown := d.copy()
+	newDropdown.strategy = strategy
+	return newDropdown
+}
+
+// WithTrigger sets whether the menu opens on click or on hover
+func (d *DropdownComponent) WithTrigger(trigger OpenTrigger) *DropdownComponent {
+	newDropdown := d.copy()
+	newDropdown.openOn = trigger
+	return newDropdown
+}
+
+// WithCustomTrigger renders trigger as the toggle instead of wrapping it in
+// a button, e.g. for a ButtonComponent or an avatar. FlyonUI uses the first
+// child of the dropdown as its toggle; give it DropdownToggleAttributes and
+// the dropdown-toggle class.
+func (d *DropdownComponent) WithCustomTrigger(trigger gomponents.Node) *DropdownComponent {
+	newDropdown := d.copy()
+	newDropdown.trigger = trigger
+	newDropdown.custom = true
+	return newDropdown
+}
+
 // WithAutoClose controls whether the dropdown closes automatically when clicking outside
 func (d *DropdownComponent) WithAutoClose(autoClose bool) *DropdownComponent {
 	newDropdown := d.copy()
@@ -123,6 +168,7 @@ func (d *DropdownComponent) With(modifiers ...any) flyon.Component {
 func (d *DropdownComponent) copy() *DropdownComponent {
 	newDropdown := &DropdownComponent{
 		trigger:   d.trigger,
+		custom:    d.custom,
 		content:   make([]gomponents.Node, len(d.content)),
 		attributes: make([]gomponents.Node, len(d.attributes)),
 		classes:   make([]string, len(d.classes)),
@@ -130,6 +176,10 @@ func (d *DropdownComponent) copy() *DropdownComponent {
 		position:  d.position,
 		autoClose: d.autoClose,
 		disabled:  d.disabled,
+		placement: d.placement,
+		offset:    d.offset,
+		strategy:  d.strategy,
+		openOn:    d.openOn,
 	}
 	
 	copy(newDropdown.content, d.content)
@@ -157,6 +207,8 @@ func (d *DropdownComponent) Render(w io.Writer) error {
 		classes = append(classes, "[--auto-close:outside]")
 	}
 
+	classes = append(classes, floatingClasses(d.placement, d.offset, d.strategy, d.openOn)...)
+
 	// Generate ID if not provided
 	id := d.id
 	if id == "" {
@@ -164,23 +216,21 @@ func (d *DropdownComponent) Render(w io.Writer) error {
 	}
 
 	// Create trigger with proper attributes
-	triggerAttrs := []gomponents.Node{
-		h.ID(id + "-toggle"),
-		h.Type("button"),
-		h.Class("dropdown-toggle"),
-		h.Aria("expanded", "false"),
-		h.Aria("haspopup", "menu"),
-	}
-
-	if d.disabled {
-		triggerAttrs = append(triggerAttrs, h.Disabled())
+	triggerElement := d.trigger
+	if !d.custom {
+		triggerAttrs := []gomponents.Node{
+			h.Type("button"),
+			h.Class("dropdown-toggle"),
+			DropdownToggleAttributes(id),
+		}
+		if d.disabled {
+			triggerAttrs = append(triggerAttrs, h.Disabled())
+		}
+		triggerElement = h.Button(
+			append(triggerAttrs, d.trigger)...,
+		)
 	}
 
-	// Wrap trigger if it's not already a button
-	triggerElement := h.Button(
-		append(triggerAttrs, d.trigger)...,
-	)
-
 	// Create dropdown menu
 	menuClasses := "dropdown-menu dropdown-open:opacity-100 hidden min-w-60"
 	menu := h.Ul(
@@ -203,6 +253,35 @@ func (d *DropdownComponent) Render(w io.Writer) error {
 	return dropdownEl.Render(w)
 }
 
+// DropdownToggleAttributes returns the ID and ARIA attributes of the toggle
+// of the dropdown with id, for triggers passed to WithCustomTrigger
+func DropdownToggleAttributes(id string) gomponents.Node {
+	return gomponents.Group([]gomponents.Node{
+		h.ID(id + "-toggle"),
+		h.Aria("haspopup", "menu"),
+		h.Aria("expanded", "false"),
+	})
+}
+
+// floatingClasses returns the FlyonUI CSS property classes configuring a
+// floating menu; unset values keep FlyonUI's defaults
+func floatingClasses(placement Placement, offset *int, strategy PositionStrategy, trigger OpenTrigger) []string {
+	var classes []string
+	if placement != "" {
+		classes = append(classes, "[--placement:"+string(placement)+"]")
+	}
+	if offset != nil {
+		classes = append(classes, fmt.Sprintf("[--offset:%d]", *offset))
+	}
+	if strategy != "" {
+		classes = append(classes, "[--strategy:"+string(strategy)+"]")
+	}
+	if trigger != "" {
+		classes = append(classes, "[--trigger:"+string(trigger)+"]")
+	}
+	return classes
+}
+
 // DropdownItem creates a dropdown menu item
 func DropdownItem(children ...gomponents.Node) gomponents.Node {
 	return h.Li(
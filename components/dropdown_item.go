@@ -0,0 +1,322 @@
+package components
+
+import (
+	"io"
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// dropdownItemKind is the kind of a DropdownItemComponent
+type dropdownItemKind int
+
+const (
+	dropdownLink dropdownItemKind = iota
+	dropdownCheckbox
+	dropdownRadio
+)
+
+// DropdownItemComponent is a dropdown menu item: a link, or a checkbox or
+// radio that keeps the menu open when toggled
+type DropdownItemComponent struct {
+	kind     dropdownItemKind
+	label    string
+	href     string
+	name     string
+	value    string
+	icon     string
+	shortcut string
+	active   bool
+	disabled bool
+	checked  bool
+	color    flyon.Color
+	classes  []string
+}
+
+// NewDropdownLink creates a menu item linking to href; an empty href
+// renders an action item handled by script
+func NewDropdownLink(label, href string) *DropdownItemComponent {
+	return &DropdownItemComponent{kind: dropdownLink, label: label, href: href, color: flyon.Primary}
+}
+
+// NewDropdownCheckbox creates a menu item toggling a checkbox submitted as
+// name=value
+func NewDropdownCheckbox(label, name, value string) *DropdownItemComponent {
+	return &DropdownItemComponent{kind: dropdownCheckbox, label: label, name: name, value: value, color: flyon.Primary}
+}
+
+// NewDropdownRadio creates a menu item selecting value among the radio
+// items sharing name
+func NewDropdownRadio(label, name, value string) *DropdownItemComponent {
+	return &DropdownItemComponent{kind: dropdownRadio, label: label, name: name, value: value, color: flyon.Primary}
+}
+
+// WithIcon sets an icon class shown before the label, e.g. "icon-[tabler--user]"
+func (i *DropdownItemComponent) WithIcon(icon string) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.icon = icon
+	return newItem
+}
+
+// WithShortcut shows a keyboard shortcut hint after the label, e.g. "⌘K".
+// It is a hint only; the application binds the shortcut.
+func (i *DropdownItemComponent) WithShortcut(shortcut string) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.shortcut = shortcut
+	return newItem
+}
+
+// WithActive marks a link as the current page
+func (i *DropdownItemComponent) WithActive(active bool) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.active = active
+	return newItem
+}
+
+// WithDisabled disables the item; it is skipped by keyboard navigation
+func (i *DropdownItemComponent) WithDisabled(disabled bool) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.disabled = disabled
+	return newItem
+}
+
+// WithChecked sets the initial state of a checkbox or radio item
+func (i *DropdownItemComponent) WithChecked(checked bool) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.checked = checked
+	return newItem
+}
+
+// WithColor sets the color of the checkbox or radio of the item
+func (i *DropdownItemComponent) WithColor(color flyon.Color) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.color = color
+	return newItem
+}
+
+// WithClasses adds CSS classes to the item
+func (i *DropdownItemComponent) WithClasses(classes ...string) *DropdownItemComponent {
+	newItem := i.copy()
+	newItem.classes = append(newItem.classes, classes...)
+	return newItem
+}
+
+// With applies modifiers to the item and returns a new instance
+func (i *DropdownItemComponent) With(modifiers ...any) flyon.Component {
+	newItem := i.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newItem.color = m
+		case string:
+			newItem.classes = append(newItem.classes, m)
+		}
+	}
+	return newItem
+}
+
+// copy creates a deep copy of the item
+func (i *DropdownItemComponent) copy() *DropdownItemComponent {
+	newItem := *i
+	newItem.classes = append([]string(nil), i.classes...)
+	return &newItem
+}
+
+// Render implements the gomponents.Node interface
+func (i *DropdownItemComponent) Render(w io.Writer) error {
+	classes := []string{"dropdown-item"}
+	if i.active {
+		classes = append(classes, "dropdown-active")
+	}
+	if i.disabled {
+		classes = append(classes, "dropdown-disabled")
+	}
+	classes = append(classes, i.classes...)
+
+	var content []gomponents.Node
+	if i.icon != "" {
+		content = append(content, h.Span(h.Class(i.icon+" size-4 shrink-0"), h.Aria("hidden", "true")))
+	}
+	content = append(content, h.Span(h.Class("grow"), gomponents.Text(i.label)))
+	if i.shortcut != "" {
+		content = append(content, h.Kbd(h.Class("kbd kbd-sm ms-auto"), gomponents.Text(i.shortcut)))
+	}
+
+	var item gomponents.Node
+	switch i.kind {
+	case dropdownCheckbox, dropdownRadio:
+		typ, role, class := "checkbox", "menuitemcheckbox", "checkbox"
+		if i.kind == dropdownRadio {
+			typ, role, class = "radio", "menuitemradio", "radio"
+		}
+		checked := "false"
+		if i.checked {
+			checked = "true"
+		}
+		item = h.Label(
+			h.Class(strings.Join(classes, " ")),
+			h.Role(role),
+			h.Aria("checked", checked),
+			gomponents.If(i.disabled, h.Aria("disabled", "true")),
+			h.Input(
+				h.Type(typ),
+				h.Class(class+" "+class+"-"+i.color.String()+" "+class+"-sm"),
+				h.Name(i.name),
+				h.Value(i.value),
+				gomponents.Attr("tabindex", "-1"),
+				gomponents.If(i.checked, h.Checked()),
+				gomponents.If(i.disabled, h.Disabled()),
+			),
+			gomponents.Group(content),
+		)
+	default:
+		attrs := []gomponents.Node{
+			h.Class(strings.Join(classes, " ")),
+			h.Role("menuitem"),
+		}
+		switch {
+		case i.disabled:
+			// Disabled links lose their href so they cannot be followed
+			attrs = append(attrs, h.Aria("disabled", "true"))
+		case i.href != "":
+			attrs = append(attrs, h.Href(i.href))
+		}
+		if i.active {
+			attrs = append(attrs, h.Aria("current", "page"))
+		}
+		item = h.A(append(attrs, content...)...)
+	}
+
+	return h.Li(item).Render(w)
+}
+
+// DropdownSubmenuComponent is a nested menu opened from an item of its
+// parent dropdown menu
+type DropdownSubmenuComponent struct {
+	label     string
+	icon      string
+	id        string
+	items     []gomponents.Node
+	placement Placement
+	offset    *int
+	openOn    OpenTrigger
+	disabled  bool
+}
+
+// NewDropdownSubmenu creates a submenu item labelled label holding items.
+// It opens to the end of the parent menu on click by default.
+func NewDropdownSubmenu(label string, items ...gomponents.Node) *DropdownSubmenuComponent {
+	return &DropdownSubmenuComponent{label: label, items: items, placement: PlacementRightStart}
+}
+
+// WithID sets the ID of the submenu
+func (s *DropdownSubmenuComponent) WithID(id string) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.id = id
+	return newSubmenu
+}
+
+// WithIcon sets an icon class shown before the label
+func (s *DropdownSubmenuComponent) WithIcon(icon string) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.icon = icon
+	return newSubmenu
+}
+
+// WithPlacement sets where the submenu opens relative to its item
+func (s *DropdownSubmenuComponent) WithPlacement(placement Placement) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.placement = placement
+	return newSubmenu
+}
+
+// WithOffset sets the distance between the item and the submenu in pixels
+func (s *DropdownSubmenuComponent) WithOffset(offset int) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.offset = &offset
+	return newSubmenu
+}
+
+// WithTrigger sets whether the submenu opens on click or on hover
+func (s *DropdownSubmenuComponent) WithTrigger(trigger OpenTrigger) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.openOn = trigger
+	return newSubmenu
+}
+
+// WithDisabled disables the submenu item
+func (s *DropdownSubmenuComponent) WithDisabled(disabled bool) *DropdownSubmenuComponent {
+	newSubmenu := s.copy()
+	newSubmenu.disabled = disabled
+	return newSubmenu
+}
+
+// With applies modifiers to the submenu and returns a new instance
+func (s *DropdownSubmenuComponent) With(modifiers ...any) flyon.Component {
+	newSubmenu := s.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case Placement:
+			newSubmenu.placement = m
+		case OpenTrigger:
+			newSubmenu.openOn = m
+		}
+	}
+	return newSubmenu
+}
+
+// copy creates a deep copy of the submenu
+func (s *DropdownSubmenuComponent) copy() *DropdownSubmenuComponent {
+	newSubmenu := *s
+	newSubmenu.items = append([]gomponents.Node(nil), s.items...)
+	return &newSubmenu
+}
+
+// Render implements the gomponents.Node interface
+func (s *DropdownSubmenuComponent) Render(w io.Writer) error {
+	id := s.id
+	if id == "" {
+		id = "dropdown-" + generateID()
+	}
+	classes := append([]string{"dropdown", "relative", "[--auto-close:inside]"}, floatingClasses(s.placement, s.offset, "", s.openOn)...)
+	toggleClasses := "dropdown-toggle dropdown-item dropdown-open:bg-base-content/10 justify-between"
+	if s.disabled {
+		toggleClasses += " dropdown-disabled"
+	}
+
+	var label []gomponents.Node
+	if s.icon != "" {
+		label = append(label, h.Span(h.Class(s.icon+" size-4 shrink-0"), h.Aria("hidden", "true")))
+	}
+	label = append(label, h.Span(h.Class("grow text-start"), gomponents.Text(s.label)))
+
+	return h.Li(
+		h.ID(id),
+		h.Class(strings.Join(classes, " ")),
+		h.Button(
+			h.Type("button"),
+			h.Class(toggleClasses),
+			h.Role("menuitem"),
+			DropdownToggleAttributes(id),
+			gomponents.If(s.disabled, h.Aria("disabled", "true")),
+			gomponents.If(s.disabled, h.Disabled()),
+			gomponents.Group(label),
+			h.Span(h.Class("icon-[tabler--chevron-right] size-4 rtl:rotate-180"), h.Aria("hidden", "true")),
+		),
+		h.Ul(
+			h.Class("dropdown-menu dropdown-open:opacity-100 hidden min-w-60"),
+			h.Aria("labelledby", id+"-toggle"),
+			h.Aria("orientation", "vertical"),
+			h.Role("menu"),
+			gomponents.Group(s.items),
+		),
+	).Render(w)
+}
+
+// Ensure the item components implement flyon.Component
+var (
+	_ flyon.Component = (*DropdownItemComponent)(nil)
+	_ flyon.Component = (*DropdownSubmenuComponent)(nil)
+)
@@ -0,0 +1,96 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"maragu.dev/gomponents"
+)
+
+func renderDropdownItem(t *testing.T, node gomponents.Node) string {
+	t.Helper()
+	var buf strings.Builder
+	if err := node.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	return buf.String()
+}
+
+func TestDropdownLink(t *testing.T) {
+	html := renderDropdownItem(t, NewDropdownLink("Search", "/search").WithIcon("icon-[tabler--search]").WithShortcut("⌘K"))
+
+	if !strings.Contains(html, `<a class="dropdown-item" role="menuitem" href="/search">`) {
+		t.Errorf("Expected link item, got %s", html)
+	}
+	if !strings.Contains(html, `icon-[tabler--search] size-4`) {
+		t.Error("Expected icon")
+	}
+	if !strings.Contains(html, `<kbd class="kbd kbd-sm ms-auto">⌘K</kbd>`) {
+		t.Error("Expected shortcut hint")
+	}
+}
+
+func TestDropdownLink_States(t *testing.T) {
+	active := renderDropdownItem(t, NewDropdownLink("Home", "/").WithActive(true))
+	if !strings.Contains(active, "dropdown-active") || !strings.Contains(active, `aria-current="page"`) {
+		t.Errorf("Expected active state, got %s", active)
+	}
+
+	disabled := renderDropdownItem(t, NewDropdownLink("Admin", "/admin").WithDisabled(true))
+	if !strings.Contains(disabled, "dropdown-disabled") || !strings.Contains(disabled, `aria-disabled="true"`) {
+		t.Errorf("Expected disabled state, got %s", disabled)
+	}
+	if strings.Contains(disabled, "href") {
+		t.Error("Disabled link should not be followable")
+	}
+}
+
+func TestDropdownCheckbox(t *testing.T) {
+	html := renderDropdownItem(t, NewDropdownCheckbox("Show grid", "view", "grid").WithChecked(true).WithColor(flyon.Success))
+
+	if !strings.Contains(html, `role="menuitemcheckbox"`) || !strings.Contains(html, `aria-checked="true"`) {
+		t.Errorf("Expected checkbox item role and state, got %s", html)
+	}
+	if !strings.Contains(html, `type="checkbox" class="checkbox checkbox-success checkbox-sm" name="view" value="grid" tabindex="-1" checked`) {
+		t.Errorf("Expected checked checkbox input, got %s", html)
+	}
+}
+
+func TestDropdownRadio(t *testing.T) {
+	html := renderDropdownItem(t, NewDropdownRadio("Newest", "sort", "new"))
+
+	if !strings.Contains(html, `role="menuitemradio"`) || !strings.Contains(html, `aria-checked="false"`) {
+		t.Errorf("Expected radio item role and state, got %s", html)
+	}
+	if !strings.Contains(html, `type="radio"`) || !strings.Contains(html, `name="sort"`) {
+		t.Error("Expected radio input")
+	}
+}
+
+func TestDropdownSubmenu(t *testing.T) {
+	submenu := NewDropdownSubmenu("Share", NewDropdownLink("Email", "/share/email")).
+		WithID("share").
+		WithTrigger(TriggerHover).
+		WithOffset(15)
+	html := renderDropdownItem(t, submenu)
+
+	if !strings.Contains(html, `<li id="share" class="dropdown relative [--auto-close:inside] [--placement:right-start] [--offset:15] [--trigger:hover]">`) {
+		t.Errorf("Expected nested dropdown item, got %s", html)
+	}
+	if !strings.Contains(html, `class="dropdown-toggle dropdown-item`) || !strings.Contains(html, `id="share-toggle"`) {
+		t.Error("Expected submenu toggle")
+	}
+	if !strings.Contains(html, `aria-labelledby="share-toggle"`) || !strings.Contains(html, "Email") {
+		t.Error("Expected nested menu with items")
+	}
+}
+
+func TestDropdownSubmenu_Immutability(t *testing.T) {
+	original := NewDropdownSubmenu("Share")
+	_ = original.WithPlacement(PlacementLeftStart).WithTrigger(TriggerHover).WithDisabled(true)
+
+	if original.placement != PlacementRightStart || original.openOn != "" || original.disabled {
+		t.Error("Original submenu should not change")
+	}
+}
@@ -434,4 +434,53 @@ func TestDropdownComponent_Immutability(t *testing.T) {
 	if !found {
 		t.Error("Modified5 should have size class")
 	}
-}
\ No newline at end of file
+}
+
+func TestDropdownComponent_RenderFloatingOptions(t *testing.T) {
+	dropdown := NewDropdown(gomponents.Text("Open")).
+		WithPlacement(PlacementBottomEnd).
+		WithOffset(8).
+		WithStrategy(StrategyFixed).
+		WithTrigger(TriggerHover)
+
+	var buf strings.Builder
+	if err := dropdown.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	html := buf.String()
+
+	for _, class := range []string{"[--placement:bottom-end]", "[--offset:8]", "[--strategy:fixed]", "[--trigger:hover]"} {
+		if !strings.Contains(html, class) {
+			t.Errorf("Expected %s in HTML, got %s", class, html)
+		}
+	}
+
+	buf.Reset()
+	if err := NewDropdown(gomponents.Text("Open")).Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "--placement") || strings.Contains(buf.String(), "--offset") {
+		t.Error("Unset floating options should keep FlyonUI defaults")
+	}
+}
+
+func TestDropdownComponent_RenderCustomTrigger(t *testing.T) {
+	trigger := NewButton(DropdownToggleAttributes("menu"), gomponents.Text("Actions")).With("dropdown-toggle")
+	dropdown := NewDropdown(nil).WithID("menu").WithCustomTrigger(trigger)
+
+	var buf strings.Builder
+	if err := dropdown.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	html := buf.String()
+
+	if strings.Count(html, "<button") != 1 {
+		t.Errorf("Custom trigger should not be wrapped in another button, got %s", html)
+	}
+	if !strings.Contains(html, `id="menu-toggle"`) || !strings.Contains(html, `aria-haspopup="menu"`) {
+		t.Error("Custom trigger should carry the toggle attributes")
+	}
+	if !strings.Contains(html, `aria-labelledby="menu-toggle"`) {
+		t.Error("Menu should be labelled by the custom trigger")
+	}
+}
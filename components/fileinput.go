@@ -132,6 +132,11 @@ func (f *FileInputComponent) copy() *FileInputComponent {
 	return &newFileInput
 }
 
+// multipart reports that the form needs multipart encoding, see FormComponent
+func (f *FileInputComponent) multipart() bool {
+	return true
+}
+
 // Render renders the file input component
 func (f *FileInputComponent) Render(w io.Writer) error {
 	// Build CSS classes
@@ -0,0 +1,400 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// DefaultUploadChunkSize is the size of the chunks the WASM behavior uploads
+const DefaultUploadChunkSize = 1 << 20
+
+// UploadedFile is a file shown in the file list of a FileUploadComponent,
+// typically one that was uploaded before the page was rendered
+type UploadedFile struct {
+	// ID is the upload ID submitted with the form
+	ID   string
+	Name string
+	Size int64
+	// Type is the MIME type; image types get a thumbnail
+	Type string
+	// URL is the location of the file, used as thumbnail source for images
+	URL string
+	// Progress is the upload progress in percent
+	Progress int
+}
+
+// FileUploadComponent renders a drop zone, a file list with image thumbnails,
+// per-file progress bars and remove buttons. With an upload URL the WASM
+// behavior uploads files in chunks to an UploadHandler and submits their
+// upload IDs under the component name; without one the file input keeps the
+// name and files are posted with the form.
+type FileUploadComponent struct {
+	id         string
+	name       string
+	accept     string
+	multiple   bool
+	disabled   bool
+	maxSize    int64
+	maxFiles   int
+	uploadURL  string
+	chunkSize  int64
+	csrf       CSRFTokenProvider
+	request    *http.Request
+	label      string
+	hint       string
+	files      []UploadedFile
+	color      flyon.Color
+	classes    []string
+	attributes map[string]string
+}
+
+// NewFileUpload creates a new file upload component
+func NewFileUpload() *FileUploadComponent {
+	return &FileUploadComponent{
+		chunkSize:  DefaultUploadChunkSize,
+		label:      "Drop files here or click to browse",
+		color:      flyon.Primary,
+		classes:    make([]string, 0),
+		attributes: make(map[string]string),
+	}
+}
+
+// WithID sets the ID of the component; the file input gets the ID suffixed with -input
+func (f *FileUploadComponent) WithID(id string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.id = id
+	return newUpload
+}
+
+// WithName sets the form field name
+func (f *FileUploadComponent) WithName(name string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.name = name
+	return newUpload
+}
+
+// WithAccept sets the accepted file types, using the syntax of the accept attribute
+func (f *FileUploadComponent) WithAccept(accept string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.accept = accept
+	return newUpload
+}
+
+// WithMultiple sets whether several files can be selected
+func (f *FileUploadComponent) WithMultiple(multiple bool) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.multiple = multiple
+	return newUpload
+}
+
+// WithDisabled sets the disabled state
+func (f *FileUploadComponent) WithDisabled(disabled bool) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.disabled = disabled
+	return newUpload
+}
+
+// WithMaxSize sets the maximum size of a single file in bytes
+func (f *FileUploadComponent) WithMaxSize(maxSize int64) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.maxSize = maxSize
+	return newUpload
+}
+
+// WithMaxFiles sets the maximum number of files
+func (f *FileUploadComponent) WithMaxFiles(maxFiles int) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.maxFiles = maxFiles
+	return newUpload
+}
+
+// WithUploadURL sets the endpoint of the UploadHandler files are sent to
+func (f *FileUploadComponent) WithUploadURL(url string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.uploadURL = url
+	return newUpload
+}
+
+// WithCSRF sends the token provider returns for r with every chunk, for an
+// UploadHandler behind CSRFProtection.Middleware
+func (f *FileUploadComponent) WithCSRF(provider CSRFTokenProvider, r *http.Request) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.csrf = provider
+	newUpload.request = r
+	return newUpload
+}
+
+// WithChunkSize sets the size of the uploaded chunks in bytes
+func (f *FileUploadComponent) WithChunkSize(chunkSize int64) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.chunkSize = chunkSize
+	return newUpload
+}
+
+// WithLabel sets the text of the drop zone
+func (f *FileUploadComponent) WithLabel(label string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.label = label
+	return newUpload
+}
+
+// WithHint sets the secondary text of the drop zone, e.g. the allowed types
+func (f *FileUploadComponent) WithHint(hint string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.hint = hint
+	return newUpload
+}
+
+// WithFiles adds files to the file list
+func (f *FileUploadComponent) WithFiles(files ...UploadedFile) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.files = append(newUpload.files, files...)
+	return newUpload
+}
+
+// WithClasses adds CSS classes to the component
+func (f *FileUploadComponent) WithClasses(classes ...string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.classes = append(newUpload.classes, classes...)
+	return newUpload
+}
+
+// WithAttribute sets a custom attribute
+func (f *FileUploadComponent) WithAttribute(key, value string) *FileUploadComponent {
+	newUpload := f.copy()
+	newUpload.attributes[key] = value
+	return newUpload
+}
+
+// With applies modifiers to the component. A color sets the drop zone
+// highlight and the progress bars.
+func (f *FileUploadComponent) With(modifiers ...any) flyon.Component {
+	newUpload := f.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newUpload.color = m
+		case string:
+			newUpload.classes = append(newUpload.classes, m)
+		}
+	}
+	return newUpload
+}
+
+// copy creates a deep copy of the file upload component
+func (f *FileUploadComponent) copy() *FileUploadComponent {
+	newUpload := *f
+	newUpload.classes = make([]string, len(f.classes))
+	copy(newUpload.classes, f.classes)
+	newUpload.files = make([]UploadedFile, len(f.files))
+	copy(newUpload.files, f.files)
+	newUpload.attributes = make(map[string]string)
+	for k, v := range f.attributes {
+		newUpload.attributes[k] = v
+	}
+	return &newUpload
+}
+
+// multipart reports whether the files are posted with the form rather than
+// uploaded to the upload URL, see FormComponent
+func (f *FileUploadComponent) multipart() bool {
+	return f.uploadURL == ""
+}
+
+// Render renders the file upload component
+func (f *FileUploadComponent) Render(w io.Writer) error {
+	id := f.id
+	if id == "" {
+		id = "file-upload-" + generateID()
+	}
+	inputID := id + "-input"
+
+	classes := append([]string{"file-upload"}, f.classes...)
+	attrs := []g.Node{
+		h.ID(id),
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-file-upload", ""),
+		g.Attr("data-upload-color", f.color.String()),
+	}
+	if f.name != "" {
+		attrs = append(attrs, g.Attr("data-upload-name", f.name))
+	}
+	if f.uploadURL != "" {
+		attrs = append(attrs,
+			g.Attr("data-upload-url", f.uploadURL),
+			g.Attr("data-upload-chunk-size", strconv.FormatInt(f.chunkSize, 10)),
+		)
+		if f.csrf != nil && f.request != nil {
+			if _, token := f.csrf.CSRFToken(f.request); token != "" {
+				attrs = append(attrs,
+					g.Attr("data-upload-csrf-header", csrfHeader(f.csrf)),
+					g.Attr("data-upload-csrf-token", token),
+				)
+			}
+		}
+	}
+	if f.accept != "" {
+		attrs = append(attrs, g.Attr("data-upload-accept", f.accept))
+	}
+	if f.maxSize > 0 {
+		attrs = append(attrs, g.Attr("data-upload-max-size", strconv.FormatInt(f.maxSize, 10)))
+	}
+	if f.maxFiles > 0 {
+		attrs = append(attrs, g.Attr("data-upload-max-files", strconv.Itoa(f.maxFiles)))
+	}
+	for key, value := range f.attributes {
+		attrs = append(attrs, g.Attr(key, value))
+	}
+
+	inputAttrs := []g.Node{h.Type("file"), h.ID(inputID), h.Class("sr-only"), g.Attr("data-file-upload-input", "")}
+	// Without an upload URL the files travel with the form itself
+	if f.uploadURL == "" && f.name != "" {
+		inputAttrs = append(inputAttrs, h.Name(f.name))
+	}
+	if f.accept != "" {
+		inputAttrs = append(inputAttrs, h.Accept(f.accept))
+	}
+	if f.multiple {
+		inputAttrs = append(inputAttrs, h.Multiple())
+	}
+	if f.disabled {
+		inputAttrs = append(inputAttrs, h.Disabled())
+	}
+
+	zoneClasses := "flex flex-col items-center justify-center gap-2 rounded-box border-2 border-dashed border-base-content/20 p-8 text-center"
+	if f.disabled {
+		zoneClasses += " cursor-not-allowed opacity-50"
+	} else {
+		zoneClasses += " cursor-pointer hover:border-" + f.color.String()
+	}
+
+	items := make([]g.Node, len(f.files))
+	for i, file := range f.files {
+		items[i] = f.renderItem(file)
+	}
+
+	return h.Div(append(attrs,
+		h.Label(
+			h.For(inputID),
+			h.Class(zoneClasses),
+			g.Attr("data-file-upload-dropzone", ""),
+			h.Span(h.Class("icon-[tabler--cloud-upload] size-10 text-base-content/50"), g.Attr("aria-hidden", "true")),
+			h.Span(h.Class("font-medium"), g.Text(f.label)),
+			g.If(f.hint != "", h.Span(h.Class("text-sm text-base-content/60"), g.Text(f.hint))),
+			h.Input(inputAttrs...),
+		),
+		h.Ul(h.Class("mt-4 space-y-3 empty:hidden"), g.Attr("data-file-upload-list", ""), g.Attr("aria-live", "polite"), g.Group(items)),
+		// The WASM behavior clones this item for files added in the browser
+		h.Template(g.Attr("data-file-upload-template", ""), f.renderItem(UploadedFile{})),
+	)...).Render(w)
+}
+
+// renderItem renders one entry of the file list
+func (f *FileUploadComponent) renderItem(file UploadedFile) g.Node {
+	isImage := strings.HasPrefix(file.Type, "image/") && file.URL != ""
+
+	// Items for non-image files keep a hidden img, so the template can show
+	// a thumbnail once the browser knows the file type
+	thumbnailClasses := "size-12 shrink-0 rounded-box object-cover"
+	iconClasses := "icon-[tabler--file] size-12 shrink-0 text-base-content/50"
+	if isImage {
+		iconClasses += " hidden"
+	} else {
+		thumbnailClasses += " hidden"
+	}
+	thumbnailAttrs := []g.Node{h.Class(thumbnailClasses), h.Alt(""), g.Attr("data-file-upload-thumbnail", "")}
+	if isImage {
+		thumbnailAttrs = append(thumbnailAttrs, h.Src(file.URL))
+	}
+
+	itemAttrs := []g.Node{
+		h.Class("flex items-center gap-3 rounded-box border border-base-content/10 p-3"),
+		g.Attr("data-file-upload-item", ""),
+	}
+	if file.ID != "" {
+		itemAttrs = append(itemAttrs, g.Attr("data-file-id", file.ID))
+	}
+
+	size := ""
+	if file.Name != "" {
+		size = FormatFileSize(file.Size)
+	}
+
+	return h.Li(append(itemAttrs,
+		h.Img(thumbnailAttrs...),
+		h.Span(h.Class(iconClasses), g.Attr("aria-hidden", "true"), g.Attr("data-file-upload-icon", "")),
+		h.Div(
+			h.Class("min-w-0 flex-1 space-y-1"),
+			h.Div(
+				h.Class("flex justify-between gap-2 text-sm"),
+				h.Span(h.Class("truncate font-medium"), g.Attr("data-file-upload-name", ""), g.Text(file.Name)),
+				h.Span(h.Class("shrink-0 text-base-content/60"), g.Attr("data-file-upload-size", ""), g.Text(size)),
+			),
+			NewProgress(file.Progress).With(f.color, "h-1.5", g.Attr("data-file-upload-progress", ""), g.Attr("aria-label", file.Name)),
+			h.P(h.Class("hidden text-xs text-error"), g.Attr("data-file-upload-error", "")),
+		),
+		h.Button(
+			h.Type("button"),
+			h.Class("btn btn-circle btn-text btn-sm"),
+			g.Attr("aria-label", "Remove "+file.Name),
+			g.Attr("data-file-upload-remove", ""),
+			h.Span(h.Class("icon-[tabler--x] size-4"), g.Attr("aria-hidden", "true")),
+		),
+		g.If(file.ID != "" && f.name != "", h.Input(h.Type("hidden"), h.Name(f.name), h.Value(file.ID))),
+	)...)
+}
+
+// FormatFileSize formats a byte count for display, e.g. "1.5 MB"
+func FormatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTP"[exp])
+}
+
+// AcceptsFile reports whether a file with the given name and MIME type
+// matches accept, a comma-separated list of extensions (".pdf"), MIME types
+// ("application/pdf") and wildcards ("image/*"). An empty accept allows all files.
+func AcceptsFile(accept, name, mimeType string) bool {
+	if strings.TrimSpace(accept) == "" {
+		return true
+	}
+	ext := strings.ToLower(path.Ext(name))
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	for _, pattern := range strings.Split(accept, ",") {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		switch {
+		case pattern == "":
+			continue
+		case strings.HasPrefix(pattern, "."):
+			if ext == pattern {
+				return true
+			}
+		case strings.HasSuffix(pattern, "/*"):
+			if mimeType != "" && strings.HasPrefix(mimeType, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		case pattern == mimeType:
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure FileUploadComponent implements flyon.Component
+var _ flyon.Component = (*FileUploadComponent)(nil)
@@ -0,0 +1,125 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func renderToStringFileUpload(component flyon.Component) string {
+	var sb strings.Builder
+	component.Render(&sb)
+	return sb.String()
+}
+
+func TestFileUploadComponent_Render(t *testing.T) {
+	upload := NewFileUpload().
+		WithID("docs").
+		WithName("attachments").
+		WithAccept("image/*,.pdf").
+		WithMultiple(true).
+		WithMaxSize(5 << 20).
+		WithMaxFiles(3).
+		WithUploadURL("/uploads").
+		WithHint("Images or PDF up to 5 MB")
+	html := renderToStringFileUpload(upload)
+
+	expected := []string{
+		`id="docs"`,
+		`data-file-upload=""`,
+		`data-upload-url="/uploads"`,
+		`data-upload-chunk-size="1048576"`,
+		`data-upload-max-size="5242880"`,
+		`data-upload-max-files="3"`,
+		`data-upload-name="attachments"`,
+		`<label for="docs-input"`,
+		`<input type="file" id="docs-input" class="sr-only" data-file-upload-input="" accept="image/*,.pdf" multiple>`,
+		"Images or PDF up to 5 MB",
+		`data-file-upload-list=""`,
+		`<template data-file-upload-template="">`,
+		`<progress class="progress progress-primary h-1.5" max="100" value="0" data-file-upload-progress=""`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+	// Uploaded files submit their IDs, so the file input must not be posted
+	if strings.Contains(html, ` name="attachments"`) {
+		t.Errorf("Expected file input to be unnamed with an upload URL, got %s", html)
+	}
+}
+
+func TestFileUploadComponent_NativeFallback(t *testing.T) {
+	html := renderToStringFileUpload(NewFileUpload().WithName("avatar"))
+
+	if !strings.Contains(html, ` name="avatar"`) {
+		t.Errorf("Expected file input to carry the name without an upload URL, got %s", html)
+	}
+	if strings.Contains(html, "data-upload-url") {
+		t.Errorf("Expected no upload URL, got %s", html)
+	}
+}
+
+func TestFileUploadComponent_WithFiles(t *testing.T) {
+	upload := NewFileUpload().WithName("attachments").With(flyon.Success).(*FileUploadComponent).WithFiles(
+		UploadedFile{ID: "abc", Name: "photo.jpg", Size: 1536, Type: "image/jpeg", URL: "/files/abc", Progress: 100},
+		UploadedFile{ID: "def", Name: "report.pdf", Size: 2 << 20, Type: "application/pdf"},
+	)
+	html := renderToStringFileUpload(upload)
+
+	expected := []string{
+		`data-file-id="abc"`,
+		`src="/files/abc"`,
+		`<span class="truncate font-medium" data-file-upload-name="">photo.jpg</span>`,
+		"1.5 KB",
+		"2.0 MB",
+		`progress-success`,
+		`value="100"`,
+		`aria-label="Remove report.pdf"`,
+		`<input type="hidden" name="attachments" value="abc">`,
+		`<input type="hidden" name="attachments" value="def">`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain %s, got %s", e, html)
+		}
+	}
+}
+
+func TestFormatFileSize(t *testing.T) {
+	tests := map[int64]string{
+		0:          "0 B",
+		1023:       "1023 B",
+		1024:       "1.0 KB",
+		1536:       "1.5 KB",
+		5 << 20:    "5.0 MB",
+		3 << 30:    "3.0 GB",
+		1<<40 + 10: "1.0 TB",
+	}
+	for size, expected := range tests {
+		if got := FormatFileSize(size); got != expected {
+			t.Errorf("FormatFileSize(%d) = %q, expected %q", size, got, expected)
+		}
+	}
+}
+
+func TestAcceptsFile(t *testing.T) {
+	tests := []struct {
+		accept, name, mimeType string
+		expected               bool
+	}{
+		{"", "anything.exe", "", true},
+		{"image/*", "photo.JPG", "image/jpeg", true},
+		{"image/*", "doc.pdf", "application/pdf", false},
+		{".pdf, .docx", "Report.PDF", "", true},
+		{"application/pdf", "report", "application/pdf", true},
+		{"image/png,.gif", "anim.webp", "image/webp", false},
+	}
+	for _, tt := range tests {
+		if got := AcceptsFile(tt.accept, tt.name, tt.mimeType); got != tt.expected {
+			t.Errorf("AcceptsFile(%q, %q, %q) = %t, expected %t", tt.accept, tt.name, tt.mimeType, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package components
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// DefaultFlashCookie is the cookie name used by Flash
+const DefaultFlashCookie = "flash"
+
+// ErrFlashSignature is returned by Flash.Pop when the cookie was tampered with
+var ErrFlashSignature = errors.New("invalid flash signature")
+
+// FlashMessage is a message shown once on the next page
+type FlashMessage struct {
+	Title   string      `json:"title,omitempty"`
+	Message string      `json:"message"`
+	Color   flyon.Color `json:"color"`
+}
+
+// Toast returns the message as a toast
+func (m FlashMessage) Toast() *ToastComponent {
+	toast := NewToast(m.Message).WithColor(m.Color)
+	if m.Title != "" {
+		toast = toast.WithTitle(m.Title)
+	}
+	return toast
+}
+
+// Flash stores messages in a signed cookie until they are shown, typically
+// on the page a POST handler redirects to. Cookies are limited to about
+// 4 KB, so keep messages short.
+type Flash struct {
+	secret []byte
+	cookie string
+	secure bool
+}
+
+// NewFlash creates a new flash store signing its cookie with secret
+func NewFlash(secret []byte) *Flash {
+	return &Flash{secret: secret, cookie: DefaultFlashCookie, secure: true}
+}
+
+// WithCookieName sets the name of the cookie
+func (f *Flash) WithCookieName(cookie string) *Flash {
+	newFlash := *f
+	newFlash.cookie = cookie
+	return &newFlash
+}
+
+// WithSecureCookie sets the Secure flag of the cookie, on by default;
+// disable it for development over plain HTTP
+func (f *Flash) WithSecureCookie(secure bool) *Flash {
+	newFlash := *f
+	newFlash.secure = secure
+	return &newFlash
+}
+
+// Add queues messages for the next page, keeping messages queued earlier by
+// previous requests or by other calls while handling r
+func (f *Flash) Add(w http.ResponseWriter, r *http.Request, messages ...FlashMessage) error {
+	// A cookie set earlier in this response, even one cleared by Pop,
+	// supersedes the request cookie
+	value, set := f.pending(w)
+	if cookie, err := r.Cookie(f.cookie); err == nil && !set {
+		value = cookie.Value
+	}
+	queued, _ := f.decode(value)
+	payload, err := json.Marshal(append(queued, messages...))
+	if err != nil {
+		return err
+	}
+	f.set(w, signToken(f.secret, signingFlash, payload), 0)
+	return nil
+}
+
+// Pop returns the queued messages and clears them
+func (f *Flash) Pop(w http.ResponseWriter, r *http.Request) ([]FlashMessage, error) {
+	cookie, err := r.Cookie(f.cookie)
+	if err != nil {
+		return nil, nil
+	}
+	f.set(w, "", -1)
+	return f.decode(cookie.Value)
+}
+
+// Toasts pops the queued messages as toasts, dropping invalid cookies
+func (f *Flash) Toasts(w http.ResponseWriter, r *http.Request) []*ToastComponent {
+	messages, _ := f.Pop(w, r)
+	toasts := make([]*ToastComponent, len(messages))
+	for i, message := range messages {
+		toasts[i] = message.Toast()
+	}
+	return toasts
+}
+
+// pending returns the value of the flash cookie already set on w
+func (f *Flash) pending(w http.ResponseWriter) (value string, set bool) {
+	response := http.Response{Header: w.Header()}
+	for _, cookie := range response.Cookies() {
+		if cookie.Name == f.cookie {
+			value, set = cookie.Value, true
+		}
+	}
+	return value, set
+}
+
+// decode verifies and parses a cookie value
+func (f *Flash) decode(value string) ([]FlashMessage, error) {
+	if value == "" {
+		return nil, nil
+	}
+	payload, ok := verifyToken(f.secret, signingFlash, value)
+	if !ok {
+		return nil, ErrFlashSignature
+	}
+	var messages []FlashMessage
+	if err := json.Unmarshal(payload, &messages); err != nil {
+		return nil, ErrFlashSignature
+	}
+	return messages, nil
+}
+
+// set writes the flash cookie, replacing one set earlier in the response
+func (f *Flash) set(w http.ResponseWriter, value string, maxAge int) {
+	header := w.Header()
+	cookies := header.Values("Set-Cookie")
+	header.Del("Set-Cookie")
+	for _, line := range cookies {
+		response := http.Response{Header: http.Header{"Set-Cookie": {line}}}
+		if parsed := response.Cookies(); len(parsed) == 1 && parsed[0].Name == f.cookie {
+			continue
+		}
+		header.Add("Set-Cookie", line)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     f.cookie,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   f.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
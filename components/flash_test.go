@@ -0,0 +1,68 @@
+package components
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func TestFlash_AddAndPop(t *testing.T) {
+	flash := NewFlash([]byte("secret")).WithSecureCookie(false)
+
+	// A POST handler queues two messages and redirects
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/posts", nil)
+	if err := flash.Add(rec, r, FlashMessage{Message: "Saved", Color: flyon.Success}); err != nil {
+		t.Fatal(err)
+	}
+	if err := flash.Add(rec, r, FlashMessage{Title: "Note", Message: "Draft kept"}); err != nil {
+		t.Fatal(err)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("Expected a single flash cookie, got %v", cookies)
+	}
+
+	// The next page shows them once
+	rec = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/posts", nil)
+	r.AddCookie(cookies[0])
+	toasts := flash.Toasts(rec, r)
+	if len(toasts) != 2 {
+		t.Fatalf("Expected two toasts, got %d", len(toasts))
+	}
+	html := renderToStringInput(NewToastContainer(toasts...))
+	if !strings.Contains(html, "alert-success") || !strings.Contains(html, "Draft kept") {
+		t.Errorf("Expected flash toasts, got: %s", html)
+	}
+	if cleared := rec.Result().Cookies(); len(cleared) != 1 || cleared[0].MaxAge >= 0 {
+		t.Errorf("Expected the cookie to be cleared, got %v", cleared)
+	}
+
+	// Messages added after popping do not bring back the shown ones
+	rec = httptest.NewRecorder()
+	flash.Pop(rec, r)
+	flash.Add(rec, r, FlashMessage{Message: "New"})
+	next := httptest.NewRequest(http.MethodGet, "/", nil)
+	next.AddCookie(rec.Result().Cookies()[0])
+	messages, err := flash.Pop(httptest.NewRecorder(), next)
+	if err != nil || len(messages) != 1 || messages[0].Message != "New" {
+		t.Errorf("Expected only the new message, got %v, %v", messages, err)
+	}
+}
+
+func TestFlash_Tampered(t *testing.T) {
+	flash := NewFlash([]byte("secret"))
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.AddCookie(&http.Cookie{Name: DefaultFlashCookie, Value: "W10.forged"})
+	if _, err := flash.Pop(httptest.NewRecorder(), r); !errors.Is(err, ErrFlashSignature) {
+		t.Errorf("Expected a signature error, got %v", err)
+	}
+	if messages, err := flash.Pop(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); messages != nil || err != nil {
+		t.Errorf("Expected no messages without a cookie, got %v, %v", messages, err)
+	}
+}
@@ -0,0 +1,212 @@
+package components
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// Form encodings
+const (
+	FormURLEncoded = "application/x-www-form-urlencoded"
+	FormMultipart  = "multipart/form-data"
+)
+
+// FormComponent represents a form with an optional CSRF field and
+// protection against double submission
+type FormComponent struct {
+	id          string
+	action      string
+	method      string
+	enctype     string
+	children    []g.Node
+	csrf        CSRFTokenProvider
+	request     *http.Request
+	submitGuard bool
+	loadingText string
+	noValidate  bool
+	classes     []string
+	attributes  map[string]string
+}
+
+// NewForm creates a new form posting to the current URL
+func NewForm(children ...g.Node) *FormComponent {
+	return &FormComponent{
+		method:     http.MethodPost,
+		children:   children,
+		classes:    make([]string, 0),
+		attributes: make(map[string]string),
+	}
+}
+
+// WithID sets the form ID
+func (f *FormComponent) WithID(id string) *FormComponent {
+	newForm := f.copy()
+	newForm.id = id
+	return newForm
+}
+
+// WithAction sets the URL the form submits to
+func (f *FormComponent) WithAction(action string) *FormComponent {
+	newForm := f.copy()
+	newForm.action = action
+	return newForm
+}
+
+// WithMethod sets the form method
+func (f *FormComponent) WithMethod(method string) *FormComponent {
+	newForm := f.copy()
+	newForm.method = method
+	return newForm
+}
+
+// WithEnctype sets the form encoding. Without one, POST forms are sent as
+// multipart/form-data when a FileInputComponent or a FileUploadComponent
+// posting its files is a direct child or the input of a FormGroupComponent
+// child. Set FormMultipart explicitly for file inputs nested in other
+// markup.
+func (f *FormComponent) WithEnctype(enctype string) *FormComponent {
+	newForm := f.copy()
+	newForm.enctype = enctype
+	return newForm
+}
+
+// WithChildren appends content to the form
+func (f *FormComponent) WithChildren(children ...g.Node) *FormComponent {
+	newForm := f.copy()
+	newForm.children = append(newForm.children, children...)
+	return newForm
+}
+
+// WithCSRF adds a hidden field with the token provider returns for r
+func (f *FormComponent) WithCSRF(provider CSRFTokenProvider, r *http.Request) *FormComponent {
+	newForm := f.copy()
+	newForm.csrf = provider
+	newForm.request = r
+	return newForm
+}
+
+// WithSubmitGuard disables the submit buttons while the form is submitted,
+// showing a spinner in the button that was clicked. Needs the WASM behavior.
+func (f *FormComponent) WithSubmitGuard(guard bool) *FormComponent {
+	newForm := f.copy()
+	newForm.submitGuard = guard
+	return newForm
+}
+
+// WithLoadingText replaces the label of the clicked button while submitting
+func (f *FormComponent) WithLoadingText(text string) *FormComponent {
+	newForm := f.copy()
+	newForm.loadingText = text
+	return newForm
+}
+
+// WithNoValidate disables native constraint validation
+func (f *FormComponent) WithNoValidate(noValidate bool) *FormComponent {
+	newForm := f.copy()
+	newForm.noValidate = noValidate
+	return newForm
+}
+
+// WithClasses adds additional CSS classes
+func (f *FormComponent) WithClasses(classes ...string) *FormComponent {
+	newForm := f.copy()
+	newForm.classes = append(newForm.classes, classes...)
+	return newForm
+}
+
+// WithAttribute sets a custom attribute
+func (f *FormComponent) WithAttribute(key, value string) *FormComponent {
+	newForm := f.copy()
+	newForm.attributes[key] = value
+	return newForm
+}
+
+// With applies modifiers to the form
+func (f *FormComponent) With(modifiers ...any) flyon.Component {
+	newForm := f.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case string:
+			newForm.classes = append(newForm.classes, m)
+		case g.Node:
+			newForm.children = append(newForm.children, m)
+		}
+	}
+	return newForm
+}
+
+// copy creates a deep copy of the form
+func (f *FormComponent) copy() *FormComponent {
+	newForm := *f
+	newForm.children = append([]g.Node(nil), f.children...)
+	newForm.classes = make([]string, len(f.classes))
+	copy(newForm.classes, f.classes)
+	newForm.attributes = make(map[string]string, len(f.attributes))
+	for k, v := range f.attributes {
+		newForm.attributes[k] = v
+	}
+	return &newForm
+}
+
+// multipartField is implemented by components whose file input is
+// submitted with the form, which then needs multipart encoding
+type multipartField interface {
+	multipart() bool
+}
+
+// multipart reports whether a child of the form submits files
+func (f *FormComponent) multipart() bool {
+	for _, child := range f.children {
+		if field, ok := child.(multipartField); ok && field.multipart() {
+			return true
+		}
+		if group, ok := child.(g.Group); ok && NewForm(group...).multipart() {
+			return true
+		}
+	}
+	return false
+}
+
+// Render generates the HTML for the form
+func (f *FormComponent) Render(w io.Writer) error {
+	method := strings.ToLower(f.method)
+	enctype := f.enctype
+	if enctype == "" && method == "post" && f.multipart() {
+		enctype = FormMultipart
+	}
+
+	attrs := []g.Node{
+		g.If(f.id != "", h.ID(f.id)),
+		h.Method(method),
+		g.If(f.action != "", h.Action(f.action)),
+		g.If(enctype != "", h.EncType(enctype)),
+		g.If(len(f.classes) > 0, h.Class(strings.Join(f.classes, " "))),
+		g.If(f.noValidate, g.Attr("novalidate", "")),
+		g.If(f.submitGuard, g.Attr("data-form-submit-guard", f.loadingText)),
+	}
+	for key, value := range f.attributes {
+		attrs = append(attrs, g.Attr(key, value))
+	}
+
+	if f.csrf != nil && f.request != nil {
+		if field, token := f.csrf.CSRFToken(f.request); token != "" {
+			attrs = append(attrs, h.Input(h.Type("hidden"), h.Name(field), h.Value(token)))
+		}
+	}
+	if f.submitGuard {
+		// The WASM behavior clones the spinner of a loading ButtonComponent
+		attrs = append(attrs, h.Template(g.Attr("data-form-submit-spinner", ""), buttonSpinner()))
+	}
+	attrs = append(attrs, f.children...)
+
+	return h.Form(attrs...).Render(w)
+}
+
+// Ensure FormComponent implements flyon.Component
+var _ flyon.Component = (*FormComponent)(nil)
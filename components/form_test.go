@@ -0,0 +1,69 @@
+package components
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func TestFormComponent_Render(t *testing.T) {
+	html := renderToStringInput(NewForm(h.Input(h.Name("title"))).WithID("post").WithAction("/posts").WithClasses("space-y-4"))
+	for _, want := range []string{`<form id="post" method="post" action="/posts" class="space-y-4">`, `<input name="title">`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in form, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "enctype") {
+		t.Errorf("Expected no enctype without file inputs, got: %s", html)
+	}
+}
+
+func TestFormComponent_Multipart(t *testing.T) {
+	for name, form := range map[string]*FormComponent{
+		"file input":  NewForm(NewFileInput().WithName("avatar")),
+		"form group":  NewForm(g.Group{NewFormGroup().WithInput(NewFileInput())}),
+		"file upload": NewForm(NewFileUpload().WithName("files")),
+		"explicit":    NewForm(h.Div(NewFileInput())).WithEnctype(FormMultipart),
+	} {
+		if html := renderToStringInput(form); !strings.Contains(html, `enctype="multipart/form-data"`) {
+			t.Errorf("Expected multipart encoding with %s, got: %s", name, html)
+		}
+	}
+
+	for name, form := range map[string]*FormComponent{
+		"text":          NewForm(h.P(g.Text(`Use type="file" inputs`))),
+		"upload to url": NewForm(NewFileUpload().WithUploadURL("/uploads")),
+	} {
+		if html := renderToStringInput(form); strings.Contains(html, "enctype") {
+			t.Errorf("Expected no enctype with %s, got: %s", name, html)
+		}
+	}
+
+	get := renderToStringInput(NewForm(NewFileInput()).WithMethod("GET"))
+	if strings.Contains(get, "enctype") || !strings.Contains(get, `method="get"`) {
+		t.Errorf("Expected GET forms to keep the default encoding, got: %s", get)
+	}
+
+	explicit := renderToStringInput(NewForm(NewFileInput()).WithEnctype(FormURLEncoded))
+	if !strings.Contains(explicit, `enctype="application/x-www-form-urlencoded"`) {
+		t.Errorf("Expected explicit encoding to win, got: %s", explicit)
+	}
+}
+
+func TestFormComponent_CSRFAndGuard(t *testing.T) {
+	provider := CSRFTokenProviderFunc(func(*http.Request) (string, string) { return "token", "abc" })
+	html := renderToStringInput(NewForm().WithCSRF(provider, httptest.NewRequest("GET", "/", nil)).WithSubmitGuard(true).WithLoadingText("Saving…"))
+	for _, want := range []string{
+		`<input type="hidden" name="token" value="abc">`,
+		`data-form-submit-guard="Saving…"`,
+		`<template data-form-submit-spinner=""><span class="loading loading-spinner loading-sm" data-button-loading=""></span></template>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in form, got: %s", want, html)
+		}
+	}
+}
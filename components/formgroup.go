@@ -106,6 +106,12 @@ func (fg *FormGroupComponent) With(modifiers ...any) flyon.Component {
 	return new
 }
 
+// multipart reports whether the input submits files, see FormComponent
+func (fg *FormGroupComponent) multipart() bool {
+	field, ok := fg.input.(multipartField)
+	return ok && field.multipart()
+}
+
 // copy creates a deep copy of the FormGroupComponent
 func (fg *FormGroupComponent) copy() *FormGroupComponent {
 	newClasses := make([]string, len(fg.classes))
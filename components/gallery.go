@@ -0,0 +1,291 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// GalleryImage is an image of a GalleryComponent
+type GalleryImage struct {
+	Src    string
+	Srcset string // Build it with Srcset
+	// Sizes overrides the sizes derived from the gallery columns
+	Sizes   string
+	Alt     string
+	Caption string
+	// Full is the URL shown in the lightbox, Src when empty
+	Full string
+	// Width and Height are the intrinsic size of the image, reserving its
+	// space while it lazily loads
+	Width, Height int
+}
+
+// GalleryComponent renders a responsive grid of lazily loaded images that
+// open in a full-screen lightbox built on ModalComponent. Navigation, swipe
+// and zoom in the lightbox require the wasm package.
+type GalleryComponent struct {
+	id       string
+	images   []GalleryImage
+	columns  map[string]int // Columns keyed by breakpoint, "" being the base
+	captions bool
+	lightbox bool
+	label    string
+	classes  []string
+}
+
+// NewGallery creates a gallery of images in two columns, three from the md
+// breakpoint and four from lg, opening in a lightbox when clicked
+func NewGallery(images ...GalleryImage) *GalleryComponent {
+	return &GalleryComponent{
+		images:   images,
+		columns:  map[string]int{"": 2, "md": 3, "lg": 4},
+		lightbox: true,
+		label:    "Image gallery",
+		classes:  []string{},
+	}
+}
+
+// WithID sets a custom ID for the gallery
+func (g *GalleryComponent) WithID(id string) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.id = id
+	return newGallery
+}
+
+// WithColumns sets the number of columns from the breakpoint up, e.g. "md";
+// an empty breakpoint sets the columns of the smallest screens
+func (g *GalleryComponent) WithColumns(breakpoint string, count int) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.columns[breakpoint] = count
+	return newGallery
+}
+
+// WithCaptions shows the captions below the images in the grid as well as
+// in the lightbox
+func (g *GalleryComponent) WithCaptions(captions bool) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.captions = captions
+	return newGallery
+}
+
+// WithLightbox enables or disables opening the images in the lightbox
+func (g *GalleryComponent) WithLightbox(lightbox bool) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.lightbox = lightbox
+	return newGallery
+}
+
+// WithLabel sets the accessible name of the gallery and the title of its
+// lightbox, "Image gallery" by default
+func (g *GalleryComponent) WithLabel(label string) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.label = label
+	return newGallery
+}
+
+// WithClasses adds CSS classes to the grid
+func (g *GalleryComponent) WithClasses(classes ...string) *GalleryComponent {
+	newGallery := g.copy()
+	newGallery.classes = append(newGallery.classes, classes...)
+	return newGallery
+}
+
+// With applies modifiers to the gallery and returns a new instance
+func (g *GalleryComponent) With(modifiers ...any) flyon.Component {
+	newGallery := g.copy()
+	for _, modifier := range modifiers {
+		if class, ok := modifier.(string); ok {
+			newGallery.classes = append(newGallery.classes, class)
+		}
+	}
+	return newGallery
+}
+
+// copy creates a deep copy of the gallery
+func (g *GalleryComponent) copy() *GalleryComponent {
+	newGallery := *g
+	newGallery.images = append([]GalleryImage(nil), g.images...)
+	newGallery.classes = append([]string{}, g.classes...)
+	newGallery.columns = make(map[string]int, len(g.columns))
+	for breakpoint, count := range g.columns {
+		newGallery.columns[breakpoint] = count
+	}
+	return &newGallery
+}
+
+// gridClasses returns the grid column classes, base columns first
+func (g *GalleryComponent) gridClasses() []string {
+	breakpoints := make([]string, 0, len(g.columns))
+	for breakpoint := range g.columns {
+		breakpoints = append(breakpoints, breakpoint)
+	}
+	sort.Slice(breakpoints, func(i, j int) bool { return breakpointWidths[breakpoints[i]] < breakpointWidths[breakpoints[j]] })
+
+	classes := make([]string, 0, len(breakpoints))
+	for _, breakpoint := range breakpoints {
+		class := "grid-cols-" + strconv.Itoa(g.columns[breakpoint])
+		if breakpoint != "" {
+			class = breakpoint + ":" + class
+		}
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// sizes returns the sizes of the grid images, a column of the viewport
+func (g *GalleryComponent) sizes() string {
+	slots := make(map[string]string, len(g.columns))
+	for breakpoint, count := range g.columns {
+		if count > 0 {
+			slots[breakpoint] = fmt.Sprintf("%.4gvw", 100/float64(count))
+		}
+	}
+	return Sizes(slots)
+}
+
+// Render implements the gomponents.Node interface
+func (g *GalleryComponent) Render(w io.Writer) error {
+	id := g.id
+	if id == "" {
+		id = "gallery-" + generateID()
+	}
+	lightboxID := id + "-lightbox"
+	sizes := g.sizes()
+
+	items := make([]gomponents.Node, 0, len(g.images))
+	for i, image := range g.images {
+		img := galleryImg(image, sizes, "aspect-square w-full object-cover transition-transform duration-300 hover:scale-105")
+		var item gomponents.Node = img
+		if g.lightbox {
+			label := image.Alt
+			if label == "" {
+				label = fmt.Sprintf("Image %d", i+1)
+			}
+			item = h.Button(
+				h.Type("button"),
+				h.Class("rounded-box block w-full cursor-zoom-in overflow-hidden"),
+				gomponents.Attr("data-overlay", "#"+lightboxID),
+				gomponents.Attr("data-gallery-index", strconv.Itoa(i)),
+				h.Aria("haspopup", "dialog"),
+				h.Aria("label", "Open "+label),
+				img,
+			)
+		}
+		items = append(items, h.Figure(
+			h.Class("gallery-item"),
+			item,
+			gomponents.If(g.captions && image.Caption != "", h.FigCaption(
+				h.Class("text-base-content/80 mt-2 text-sm"),
+				gomponents.Text(image.Caption),
+			)),
+		))
+	}
+
+	gridClasses := append(append([]string{"grid", "gap-4"}, g.gridClasses()...), g.classes...)
+	children := []gomponents.Node{
+		h.ID(id),
+		gomponents.Attr("data-gallery", ""),
+		h.Role("region"),
+		h.Aria("label", g.label),
+		h.Div(h.Class(strings.Join(gridClasses, " ")), gomponents.Group(items)),
+	}
+	if g.lightbox {
+		children = append(children, g.renderLightbox(lightboxID))
+	}
+	return h.Div(children...).Render(w)
+}
+
+// renderLightbox renders the full-screen modal showing one image at a time
+func (g *GalleryComponent) renderLightbox(id string) gomponents.Node {
+	slides := make([]gomponents.Node, 0, len(g.images))
+	for i, image := range g.images {
+		// The lightbox is as wide as the viewport whatever the grid sizes
+		full := image
+		full.Sizes = ""
+		if image.Full != "" {
+			full.Src, full.Srcset = image.Full, ""
+		}
+		classes := "flex max-h-full flex-col items-center"
+		if i > 0 {
+			classes += " hidden"
+		}
+		slides = append(slides, h.Figure(
+			h.Class(classes),
+			gomponents.Attr("data-gallery-slide", strconv.Itoa(i)),
+			h.Div(
+				h.Class("overflow-hidden"),
+				galleryImg(full, "100vw", "max-h-[75vh] w-auto cursor-zoom-in object-contain transition-transform duration-300"),
+			),
+			gomponents.If(image.Caption != "", h.FigCaption(
+				h.Class("text-base-content/80 mt-3 text-center text-sm"),
+				gomponents.Text(image.Caption),
+			)),
+		))
+	}
+
+	counter := ""
+	if len(g.images) > 0 {
+		counter = fmt.Sprintf("1 / %d", len(g.images))
+	}
+
+	return NewModal(g.label,
+		h.Div(
+			h.Class("relative flex h-full flex-col items-center justify-center gap-3"),
+			gomponents.Attr("data-gallery-lightbox", ""),
+			gomponents.Group(slides),
+			h.Div(
+				h.Class("flex items-center gap-3"),
+				galleryControl("data-gallery-prev", "icon-[tabler--chevron-left] rtl:rotate-180", "Previous image"),
+				h.Span(h.Class("text-base-content/80 text-sm tabular-nums"), gomponents.Attr("data-gallery-counter", ""), h.Aria("live", "polite"), gomponents.Text(counter)),
+				galleryControl("data-gallery-next", "icon-[tabler--chevron-right] rtl:rotate-180", "Next image"),
+				h.Button(
+					h.Type("button"),
+					h.Class("btn btn-text btn-circle btn-sm"),
+					gomponents.Attr("data-gallery-zoom", ""),
+					h.Aria("pressed", "false"),
+					h.Aria("label", "Zoom"),
+					h.Span(h.Class("icon-[tabler--zoom-in] size-5"), h.Aria("hidden", "true")),
+				),
+			),
+		),
+	).WithID(id).WithSize(ModalSizeFullWidth).WithPosition(ModalPositionMiddle)
+}
+
+// galleryImg renders a lazily loaded image of a gallery
+func galleryImg(image GalleryImage, sizes, classes string) gomponents.Node {
+	if image.Sizes != "" {
+		sizes = image.Sizes
+	}
+	return h.Img(
+		h.Src(image.Src),
+		gomponents.If(image.Srcset != "", gomponents.Attr("srcset", image.Srcset)),
+		gomponents.If(image.Srcset != "", gomponents.Attr("sizes", sizes)),
+		h.Alt(image.Alt),
+		gomponents.If(image.Width > 0, h.Width(strconv.Itoa(image.Width))),
+		gomponents.If(image.Height > 0, h.Height(strconv.Itoa(image.Height))),
+		h.Loading("lazy"),
+		gomponents.Attr("decoding", "async"),
+		h.Class(classes),
+	)
+}
+
+// galleryControl renders a navigation button of the lightbox
+func galleryControl(attr, icon, label string) gomponents.Node {
+	return h.Button(
+		h.Type("button"),
+		h.Class("btn btn-text btn-circle btn-sm"),
+		gomponents.Attr(attr, ""),
+		h.Aria("label", label),
+		h.Span(h.Class(icon+" size-5"), h.Aria("hidden", "true")),
+	)
+}
+
+// Ensure GalleryComponent implements flyon.Component
+var _ flyon.Component = (*GalleryComponent)(nil)
@@ -0,0 +1,88 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+var galleryImages = []GalleryImage{
+	{Src: "/a.jpg", Srcset: "/a-320.jpg 320w, /a-640.jpg 640w", Alt: "Front", Caption: "Front view", Full: "/a-full.jpg", Width: 640, Height: 480},
+	{Src: "/b.jpg", Alt: "Back"},
+}
+
+func TestGalleryComponent_Render(t *testing.T) {
+	var buf strings.Builder
+	if err := NewGallery(galleryImages...).WithID("photos").Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	expected := []string{
+		`<div id="photos" data-gallery="" role="region" aria-label="Image gallery"><div class="grid gap-4 grid-cols-2 md:grid-cols-3 lg:grid-cols-4">`,
+		`<button type="button" class="rounded-box block w-full cursor-zoom-in overflow-hidden" data-overlay="#photos-lightbox" data-gallery-index="0" aria-haspopup="dialog" aria-label="Open Front">`,
+		`<img src="/a.jpg" srcset="/a-320.jpg 320w, /a-640.jpg 640w" sizes="(min-width: 1024px) 25vw, (min-width: 768px) 33.33vw, 50vw" alt="Front" width="640" height="480" loading="lazy" decoding="async"`,
+		`<img src="/b.jpg" alt="Back" loading="lazy"`,
+		`data-gallery-index="1"`,
+		// The lightbox
+		`id="photos-lightbox" class="overlay modal`,
+		`modal-dialog modal-dialog-full`,
+		`<h3 class="modal-title">Image gallery</h3>`,
+		`<figure class="flex max-h-full flex-col items-center" data-gallery-slide="0"><div class="overflow-hidden"><img src="/a-full.jpg" alt="Front"`,
+		`<figcaption class="text-base-content/80 mt-3 text-center text-sm">Front view</figcaption>`,
+		`class="flex max-h-full flex-col items-center hidden" data-gallery-slide="1"`,
+		`data-gallery-prev="" aria-label="Previous image"`,
+		`data-gallery-next="" aria-label="Next image"`,
+		`data-gallery-counter="" aria-live="polite">1 / 2</span>`,
+		`data-gallery-zoom="" aria-pressed="false" aria-label="Zoom"`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(html, exp) {
+			t.Errorf("Expected %q in %s", exp, html)
+		}
+	}
+	if strings.Contains(html, `<figcaption class="text-base-content/80 mt-2 text-sm">`) {
+		t.Error("Grid captions should be hidden by default")
+	}
+}
+
+func TestGalleryComponent_RenderOptions(t *testing.T) {
+	gallery := NewGallery(galleryImages...).
+		WithColumns("", 1).
+		WithColumns("sm", 2).
+		WithCaptions(true).
+		WithLightbox(false)
+
+	var buf strings.Builder
+	if err := gallery.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `class="grid gap-4 grid-cols-1 sm:grid-cols-2 md:grid-cols-3 lg:grid-cols-4"`) {
+		t.Error("Missing column classes")
+	}
+	if !strings.Contains(html, `sizes="(min-width: 1024px) 25vw, (min-width: 768px) 33.33vw, (min-width: 640px) 50vw, 100vw"`) {
+		t.Error("Missing sizes derived from the columns")
+	}
+	if !strings.Contains(html, `<figcaption class="text-base-content/80 mt-2 text-sm">Front view</figcaption>`) {
+		t.Error("Missing grid caption")
+	}
+	if strings.Contains(html, "lightbox") || strings.Contains(html, "<button") {
+		t.Error("Lightbox should be disabled")
+	}
+}
+
+func TestGalleryComponent_RenderImageSizesOverride(t *testing.T) {
+	image := galleryImages[0]
+	image.Sizes = "200px"
+
+	var buf strings.Builder
+	if err := NewGallery(image).Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `sizes="200px"`) {
+		t.Error("Missing image sizes override")
+	}
+}
@@ -0,0 +1,334 @@
+package components
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// immutableComponents builds a fresh, non-trivial instance of every
+// component with With* builders, whose classes and attributes seeded adds
+// to. Components of build-tagged files are registered by the test files
+// carrying the same tags.
+var immutableComponents = map[string]func() flyon.Component{
+	"Accordion":      func() flyon.Component { return NewAccordion(NewAccordionItem("a", "A", g.Text("a"))) },
+	"Alert":          func() flyon.Component { return NewAlert(g.Text("Alert")) },
+	"Autocomplete":   func() flyon.Component { return NewAutocomplete().WithOptions("Apple", "Banana") },
+	"Avatar":         func() flyon.Component { return NewAvatar(g.Text("AV")) },
+	"Badge":          func() flyon.Component { return NewBadge(g.Text("Badge")) },
+	"Blockquote":     func() flyon.Component { return NewBlockquote(g.Text("Quote")) },
+	"Breadcrumb":     func() flyon.Component { return NewBreadcrumb(h.Li(g.Text("Home"))) },
+	"Button":         func() flyon.Component { return NewButton(g.Text("Button")) },
+	"Calendar":       func() flyon.Component { return NewCalendar().WithEvents(immutableEvent) },
+	"Card":           func() flyon.Component { return NewCard(g.Text("Card")) },
+	"Carousel":       func() flyon.Component { return NewCarousel(g.Text("One"), g.Text("Two")) },
+	"Checkbox":       func() flyon.Component { return NewCheckbox() },
+	"ChoiceGroup":    func() flyon.Component { return NewChoiceGroup("Legend", NewCheckbox()) },
+	"Collapse":       func() flyon.Component { return NewCollapse("Title", g.Text("Content")) },
+	"ColorPicker":    func() flyon.Component { return NewColorPicker().WithSwatches("#ffffff", "#000000") },
+	"Combobox":       func() flyon.Component { return NewCombobox().WithOptions(immutableOptions) },
+	"Container":      func() flyon.Component { return NewContainer() },
+	"DatePicker":     func() flyon.Component { return NewDatePicker() },
+	"Drawer":         func() flyon.Component { return NewDrawer("Menu", g.Text("Body")) },
+	"Dropdown":       func() flyon.Component { return NewDropdown(g.Text("Open"), DropdownItem(g.Text("Item"))) },
+	"DropdownItem":   func() flyon.Component { return NewDropdownLink("Item", "/item") },
+	"DropdownSub":    func() flyon.Component { return NewDropdownSubmenu("More", DropdownItem(g.Text("Item"))) },
+	"FileInput":      func() flyon.Component { return NewFileInput() },
+	"FileUpload":     func() flyon.Component { return NewFileUpload().WithFiles(immutableFile) },
+	"Flex":           func() flyon.Component { return NewFlex() },
+	"Form":           func() flyon.Component { return NewForm(g.Text("Fields")) },
+	"FormGroup":      func() flyon.Component { return NewFormGroup().WithInput(NewInput()) },
+	"FormValidation": func() flyon.Component { return NewFormValidation() },
+	"Gallery":        func() flyon.Component { return NewGallery(GalleryImage{Src: "/a.jpg", Alt: "A"}) },
+	"Grid":           func() flyon.Component { return NewGrid() },
+	"Indicator":      func() flyon.Component { return NewIndicator(g.Text("Inbox")) },
+	"Input":          func() flyon.Component { return NewInput() },
+	"InputGroup":     func() flyon.Component { return NewInputGroup(NewInput()) },
+	"Loading":        func() flyon.Component { return NewLoading() },
+	"Modal":          func() flyon.Component { return NewModal("Title", g.Text("Body")) },
+	"NumberInput":    func() flyon.Component { return NewNumberInput() },
+	"Password":       func() flyon.Component { return NewPassword().WithLevels("Weak", "Strong") },
+	"PinInput":       func() flyon.Component { return NewPinInput() },
+	"Popover":        func() flyon.Component { return NewPopover(g.Text("Open"), g.Text("Content")) },
+	"Progress":       func() flyon.Component { return NewProgress(40) },
+	"Radio":          func() flyon.Component { return NewRadio() },
+	"Range":          func() flyon.Component { return NewRange().WithTicks(0, 50, 100) },
+	"Rating":         func() flyon.Component { return NewRating(3) },
+	"Select":         func() flyon.Component { return NewSelect().WithOption("a", "A").WithOption("b", "B") },
+	"Skeleton":       func() flyon.Component { return NewSkeleton() },
+	"Spinner":        func() flyon.Component { return NewSpinner() },
+	"Stack":          func() flyon.Component { return NewStack() },
+	"Stats":          func() flyon.Component { return NewStats(g.Text("Stat")) },
+	"Stepper":        func() flyon.Component { return NewStepper(Step{Title: "One"}, Step{Title: "Two"}) },
+	"Swap":           func() flyon.Component { return NewSwap(g.Text("On"), g.Text("Off")) },
+	"Tabs":           func() flyon.Component { return NewTabs(NewTabItem("t", "Tab", g.Text("Panel"))) },
+	"TagsInput":      func() flyon.Component { return NewTagsInput().WithTags("go", "wasm").WithSuggestions("js") },
+	"Textarea":       func() flyon.Component { return NewTextarea() },
+	"Timeline":       func() flyon.Component { return NewTimeline(h.Li(g.Text("Event"))) },
+	"Toast":          func() flyon.Component { return NewToast("Saved") },
+	"ToastContainer": func() flyon.Component { return NewToastContainer(NewToast("Saved")) },
+	"Toggle":         func() flyon.Component { return NewToggle() },
+	"Tooltip":        func() flyon.Component { return NewTooltip("Tip", h.Button(g.Text("Button"))) },
+	"Wizard":         func() flyon.Component { return NewWizard("wizard", WizardStep{Title: "One"}, WizardStep{Title: "Two"}) },
+}
+
+// Values seeding the slices of the fixtures above.
+var (
+	immutableEvent   = CalendarEvent{Start: time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC), Title: "Launch"}
+	immutableOptions = []ComboboxOption{{Value: "a", Label: "A"}, {Value: "b", Label: "B"}}
+	immutableFile    = UploadedFile{ID: "a", Name: "a.txt", Size: 1}
+)
+
+// seeded adds count classes to c, one builder call each, and an attribute.
+// Depending on count the slices are left with spare capacity a copy
+// aliasing them would share. A single attribute keeps the rendering of
+// attribute maps stable.
+func seeded(c flyon.Component, count int) flyon.Component {
+	for i := 0; i < count; i++ {
+		c = c.With(fmt.Sprintf("seed-%d", i))
+	}
+	if method := reflect.ValueOf(c).MethodByName("WithAttribute"); method.IsValid() {
+		c = method.Call([]reflect.Value{reflect.ValueOf("data-seed"), reflect.ValueOf("seed")})[0].Interface().(flyon.Component)
+	}
+	return c
+}
+
+// generatedIDs matches IDs from generateID, which differ between renders
+var generatedIDs = regexp.MustCompile(`d\d{6}`)
+
+// renderStable renders c with generated IDs normalized
+func renderStable(t *testing.T, c flyon.Component) string {
+	t.Helper()
+	var buf strings.Builder
+	if err := c.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	return generatedIDs.ReplaceAllString(buf.String(), "dID")
+}
+
+// htmlTokens separates the attributes and text of rendered HTML
+var htmlTokens = regexp.MustCompile(`[\s<>]+`)
+
+// renderTokens renders c as its sorted attributes and text, for components
+// built with several map entries, which render in any order
+func renderTokens(t *testing.T, c flyon.Component) string {
+	t.Helper()
+	tokens := htmlTokens.Split(renderStable(t, c), -1)
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
+
+// modifiers are the values passed to With(...any)
+var modifiers = []any{flyon.Primary, flyon.Error, flyon.SizeLarge, flyon.SizeSmall, flyon.VariantOutline, "extra-class", g.Text("child"), h.ID("extra-id")}
+
+// randomValue generates an argument of type typ for a With* method. It
+// reports false for types it cannot generate; struct fields of such types
+// are left at their zero value.
+func randomValue(r *rand.Rand, typ reflect.Type) (reflect.Value, bool) {
+	switch {
+	case typ == reflect.TypeOf((*g.Node)(nil)).Elem():
+		return reflect.ValueOf(h.Span(g.Text(fmt.Sprintf("node-%d", r.Intn(100))))), true
+	case typ == reflect.TypeOf((*flyon.Component)(nil)).Elem():
+		return reflect.ValueOf(flyon.Component(NewInput().WithName(fmt.Sprintf("field-%d", r.Intn(100))))), true
+	case typ == reflect.TypeOf((*CSRFTokenProvider)(nil)).Elem():
+		return reflect.ValueOf(CSRFTokenProvider(NewCSRFProtection([]byte(fmt.Sprintf("secret-%d", r.Intn(100)))))), true
+	case typ == reflect.TypeOf((*http.Request)(nil)):
+		// Requests are self-referencing through their Response
+		return reflect.ValueOf(httptest.NewRequest(http.MethodGet, fmt.Sprintf("/?tab=value-%d", r.Intn(100)), nil)), true
+	case typ.Kind() == reflect.Interface && typ.NumMethod() == 0:
+		return reflect.ValueOf(modifiers[r.Intn(len(modifiers))]), true
+	}
+	v := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString(fmt.Sprintf("value-%d", r.Intn(100)))
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(r.Intn(5)))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(r.Intn(5)))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(r.Intn(100)) / 4)
+	case reflect.Slice:
+		for i := 0; i < 1+r.Intn(2); i++ {
+			elem, ok := randomValue(r, typ.Elem())
+			if !ok {
+				return v, false
+			}
+			v = reflect.Append(v, elem)
+		}
+	case reflect.Map:
+		key, ok := randomValue(r, typ.Key())
+		if !ok {
+			return v, false
+		}
+		elem, ok := randomValue(r, typ.Elem())
+		if !ok {
+			return v, false
+		}
+		v = reflect.MakeMap(typ)
+		v.SetMapIndex(key, elem)
+	case reflect.Func:
+		// Functions return the same generated results on every call
+		results := make([]reflect.Value, typ.NumOut())
+		for i := range results {
+			result, ok := randomValue(r, typ.Out(i))
+			if !ok {
+				return v, false
+			}
+			results[i] = result
+		}
+		v = reflect.MakeFunc(typ, func([]reflect.Value) []reflect.Value { return results })
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if field := typ.Field(i); field.IsExported() {
+				if value, ok := randomValue(r, field.Type); ok {
+					v.Field(i).Set(value)
+				}
+			}
+		}
+	case reflect.Pointer:
+		if typ.Elem().Kind() != reflect.Struct {
+			return v, false
+		}
+		elem, ok := randomValue(r, typ.Elem())
+		if !ok {
+			return v, false
+		}
+		ptr := reflect.New(typ.Elem())
+		ptr.Elem().Set(elem)
+		v = ptr
+	default:
+		return v, false
+	}
+	return v, true
+}
+
+// callWith calls method with random arguments and returns the component it
+// built, or an error when the arguments cannot be generated or the method
+// panics
+func callWith(r *rand.Rand, method reflect.Value) (result flyon.Component, err error) {
+	typ := method.Type()
+	args := make([]reflect.Value, typ.NumIn())
+	for i := range args {
+		arg, generated := randomValue(r, typ.In(i))
+		if !generated {
+			return nil, fmt.Errorf("cannot generate an argument of type %s", typ.In(i))
+		}
+		args[i] = arg
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panicked with %v", recovered)
+		}
+	}()
+	var results []reflect.Value
+	if typ.IsVariadic() {
+		results = method.CallSlice(args)
+	} else {
+		results = method.Call(args)
+	}
+	if len(results) > 0 {
+		result, _ = results[0].Interface().(flyon.Component)
+	}
+	return result, nil
+}
+
+// TestWithMethodsAreImmutable checks that every With* method of every
+// component leaves the rendering of the receiver unchanged, and that two
+// components built from the same receiver do not change each other
+func TestWithMethodsAreImmutable(t *testing.T) {
+	for name, build := range immutableComponents {
+		typ := reflect.TypeOf(seeded(build(), 1))
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := typ.Method(i)
+			if !strings.HasPrefix(method.Name, "With") {
+				continue
+			}
+			t.Run(name+"."+method.Name, func(t *testing.T) {
+				property := func(seed int64) bool {
+					r := rand.New(rand.NewSource(seed))
+					original := seeded(build(), 1+r.Intn(6))
+					before := renderStable(t, original)
+					first, err := callWith(r, reflect.ValueOf(original).MethodByName(method.Name))
+					if err != nil {
+						t.Fatalf("%s.%s: %v", name, method.Name, err)
+					}
+					firstBefore := renderTokens(t, first)
+					if _, err := callWith(r, reflect.ValueOf(original).MethodByName(method.Name)); err != nil {
+						t.Fatalf("%s.%s: %v", name, method.Name, err)
+					}
+					return renderStable(t, original) == before && renderTokens(t, first) == firstBefore
+				}
+				if err := quick.Check(property, &quick.Config{MaxCount: 20}); err != nil {
+					t.Errorf("%s.%s changed the receiver: %v", name, method.Name, err)
+				}
+			})
+		}
+	}
+}
+
+// TestImmutableComponentsRegistered checks that every exported type with a
+// Render and a With* method, in the files of the current build, is
+// covered by TestWithMethodsAreImmutable
+func TestImmutableComponentsRegistered(t *testing.T) {
+	registered := make(map[string]bool, len(immutableComponents))
+	for _, newComponent := range immutableComponents {
+		registered[reflect.TypeOf(newComponent()).Elem().Name()] = true
+	}
+
+	rendered, withs := make(map[string]bool), make(map[string]bool)
+	files := token.NewFileSet()
+	pkg, err := build.ImportDir(".", 0)
+	if err != nil {
+		t.Fatalf("Reading the package failed: %v", err)
+	}
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(files, name, nil, 0)
+		if err != nil {
+			t.Fatalf("Parsing %s failed: %v", name, err)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			ident, ok := recv.(*ast.Ident)
+			if !ok || !ident.IsExported() {
+				continue
+			}
+			switch {
+			case fn.Name.Name == "Render":
+				rendered[ident.Name] = true
+			case strings.HasPrefix(fn.Name.Name, "With"):
+				withs[ident.Name] = true
+			}
+		}
+	}
+	for typ := range withs {
+		if rendered[typ] && !registered[typ] {
+			t.Errorf("%s has With* methods but is missing from immutableComponents", typ)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build js && wasm
+
+package components
+
+import (
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	g "maragu.dev/gomponents"
+)
+
+// Register the components of files built for js/wasm only
+func init() {
+	immutableComponents["Divider"] = func() flyon.Component { return NewDivider(g.Text("OR")) }
+	immutableComponents["Typography"] = func() flyon.Component { return NewTypography("p", g.Text("Text")) }
+}
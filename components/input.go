@@ -4,9 +4,9 @@ import (
 	"io"
 	"strings"
 
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
-	"github.com/ozanturksever/gomponents-flyonui/flyon"
 )
 
 // InputType represents the type of input
@@ -152,6 +152,17 @@ func (i *InputComponent) copy() *InputComponent {
 
 // Render generates the HTML for the input
 func (i *InputComponent) Render(w io.Writer) error {
+	attrs := append([]g.Node{
+		h.Type(string(i.inputType)),
+		h.Class(strings.Join(i.classNames(), " ")),
+	}, i.controlAttrs()...)
+
+	return h.Input(attrs...).Render(w)
+}
+
+// classNames returns the FlyonUI classes of the input. InputGroup moves them
+// to its wrapper, which takes over the look of the field.
+func (i *InputComponent) classNames() []string {
 	classes := []string{"input", "input-bordered"}
 	
 	// Add color class
@@ -165,14 +176,13 @@ func (i *InputComponent) Render(w io.Writer) error {
 	}
 	
 	// Add custom classes
-	classes = append(classes, i.classes...)
-	
-	// Build attributes
-	attrs := []g.Node{
-		h.Type(string(i.inputType)),
-		h.Class(strings.Join(classes, " ")),
-	}
-	
+	return append(classes, i.classes...)
+}
+
+// controlAttrs returns the attributes of the input element besides its type and class
+func (i *InputComponent) controlAttrs() []g.Node {
+	var attrs []g.Node
+
 	if i.id != "" {
 		attrs = append(attrs, h.ID(i.id))
 	}
@@ -200,6 +210,6 @@ func (i *InputComponent) Render(w io.Writer) error {
 	if i.required {
 		attrs = append(attrs, h.Required())
 	}
-	
-	return h.Input(attrs...).Render(w)
-}
\ No newline at end of file
+
+	return attrs
+}
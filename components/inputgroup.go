@@ -0,0 +1,263 @@
+package components
+
+import (
+	"io"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// InputGroupComponent wraps an InputComponent with addons: prefix and suffix
+// text or icons inside the field, buttons joined to its sides, a floating
+// label, a clear button, a password visibility toggle and an input mask.
+// The wrapper takes over the color and size classes of the input.
+type InputGroupComponent struct {
+	input          *InputComponent
+	prefix         []g.Node
+	suffix         []g.Node
+	leading        []g.Node
+	trailing       []g.Node
+	floatingLabel  string
+	clearable      bool
+	passwordToggle bool
+	mask           *InputMask
+	classes        []string
+	attributes     map[string]string
+}
+
+// NewInputGroup creates a new input group around input
+func NewInputGroup(input *InputComponent) *InputGroupComponent {
+	return &InputGroupComponent{
+		input:      input,
+		classes:    make([]string, 0),
+		attributes: make(map[string]string),
+	}
+}
+
+// InputAddonText renders a text addon such as a currency symbol or unit
+func InputAddonText(text string) g.Node {
+	return h.Span(h.Class("text-base-content/80 my-auto shrink-0"), g.Text(text))
+}
+
+// InputAddonIcon renders an icon addon from an icon class, e.g. "icon-[tabler--mail]"
+func InputAddonIcon(icon string) g.Node {
+	return h.Span(h.Class(icon+" text-base-content/80 my-auto size-5 shrink-0"), g.Attr("aria-hidden", "true"))
+}
+
+// WithPrefix adds addons before the input, inside the field
+func (ig *InputGroupComponent) WithPrefix(nodes ...g.Node) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.prefix = append(newGroup.prefix, nodes...)
+	return newGroup
+}
+
+// WithSuffix adds addons after the input, inside the field
+func (ig *InputGroupComponent) WithSuffix(nodes ...g.Node) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.suffix = append(newGroup.suffix, nodes...)
+	return newGroup
+}
+
+// WithLeadingButton joins buttons to the start of the field.
+// Components get the join-item class applied.
+func (ig *InputGroupComponent) WithLeadingButton(buttons ...g.Node) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.leading = append(newGroup.leading, buttons...)
+	return newGroup
+}
+
+// WithTrailingButton joins buttons to the end of the field.
+// Components get the join-item class applied.
+func (ig *InputGroupComponent) WithTrailingButton(buttons ...g.Node) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.trailing = append(newGroup.trailing, buttons...)
+	return newGroup
+}
+
+// WithFloatingLabel adds a label that floats above the value once the input is filled
+func (ig *InputGroupComponent) WithFloatingLabel(label string) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.floatingLabel = label
+	return newGroup
+}
+
+// WithClearButton adds a button that empties the input; it is hidden while the input is empty
+func (ig *InputGroupComponent) WithClearButton() *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.clearable = true
+	return newGroup
+}
+
+// WithPasswordToggle turns the input into a password field with a visibility toggle
+func (ig *InputGroupComponent) WithPasswordToggle() *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.passwordToggle = true
+	return newGroup
+}
+
+// WithMask formats the input with mask while the user types
+func (ig *InputGroupComponent) WithMask(mask InputMask) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.mask = &mask
+	return newGroup
+}
+
+// WithClasses adds CSS classes to the field wrapper
+func (ig *InputGroupComponent) WithClasses(classes ...string) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.classes = append(newGroup.classes, classes...)
+	return newGroup
+}
+
+// WithAttribute sets a custom attribute on the field wrapper
+func (ig *InputGroupComponent) WithAttribute(key, value string) *InputGroupComponent {
+	newGroup := ig.copy()
+	newGroup.attributes[key] = value
+	return newGroup
+}
+
+// With applies modifiers to the input group. Colors and sizes apply to the input.
+func (ig *InputGroupComponent) With(modifiers ...any) flyon.Component {
+	newGroup := ig.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newGroup.input = newGroup.input.WithColor(m)
+		case flyon.Size:
+			newGroup.input = newGroup.input.WithSize(m)
+		case string:
+			newGroup.classes = append(newGroup.classes, m)
+		}
+	}
+	return newGroup
+}
+
+// copy creates a deep copy of the input group
+func (ig *InputGroupComponent) copy() *InputGroupComponent {
+	newGroup := *ig
+	newGroup.prefix = append([]g.Node(nil), ig.prefix...)
+	newGroup.suffix = append([]g.Node(nil), ig.suffix...)
+	newGroup.leading = append([]g.Node(nil), ig.leading...)
+	newGroup.trailing = append([]g.Node(nil), ig.trailing...)
+	newGroup.classes = make([]string, len(ig.classes))
+	copy(newGroup.classes, ig.classes)
+	newGroup.attributes = make(map[string]string)
+	for k, v := range ig.attributes {
+		newGroup.attributes[k] = v
+	}
+	return &newGroup
+}
+
+// Render renders the input group
+func (ig *InputGroupComponent) Render(w io.Writer) error {
+	input := ig.input
+	if input == nil {
+		input = NewInput()
+	}
+	if input.id == "" {
+		input = input.WithID("input-" + generateID())
+	}
+	if ig.passwordToggle {
+		input = input.WithType(InputTypePassword)
+	}
+	if ig.mask != nil && input.value != "" {
+		input = input.WithValue(ig.mask.Apply(input.value))
+	}
+	if ig.floatingLabel != "" && input.placeholder == "" {
+		// The floating label relies on :placeholder-shown to detect an empty input
+		input = input.WithPlaceholder(ig.floatingLabel)
+	}
+
+	joined := len(ig.leading) > 0 || len(ig.trailing) > 0
+
+	classes := append(input.classNames(), ig.classes...)
+	if joined {
+		classes = append(classes, "join-item")
+	}
+	attrs := []g.Node{
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-input-group", ""),
+	}
+	for key, value := range ig.attributes {
+		attrs = append(attrs, g.Attr(key, value))
+	}
+
+	controlClasses := "grow"
+	if len(ig.prefix) > 0 {
+		controlClasses += " ps-3"
+	}
+	if len(ig.suffix) > 0 || ig.clearable || ig.passwordToggle {
+		controlClasses += " pe-3"
+	}
+	controlAttrs := append([]g.Node{h.Type(string(input.inputType)), h.Class(controlClasses)}, input.controlAttrs()...)
+	if ig.mask != nil {
+		controlAttrs = append(controlAttrs, ig.mask.attributes()...)
+	}
+
+	var control g.Node = h.Input(controlAttrs...)
+	if ig.floatingLabel != "" {
+		control = h.Div(
+			h.Class("input-floating grow"),
+			control,
+			h.Label(h.Class("input-floating-label"), h.For(input.id), g.Text(ig.floatingLabel)),
+		)
+	}
+
+	field := h.Div(append(attrs,
+		g.Group(ig.prefix),
+		control,
+		g.If(ig.clearable, h.Button(
+			h.Type("button"),
+			h.Class(clearButtonClasses(input.value)),
+			g.Attr("aria-label", "Clear"),
+			g.Attr("data-input-clear", ""),
+			h.Span(h.Class("icon-[tabler--x] size-4 shrink-0"), g.Attr("aria-hidden", "true")),
+		)),
+		g.If(ig.passwordToggle, h.Button(
+			h.Type("button"),
+			h.Class("block cursor-pointer my-auto"),
+			g.Attr("aria-label", "Toggle password visibility"),
+			g.Attr("data-toggle-password", `{"target":"#`+input.id+`"}`),
+			h.Span(h.Class("icon-[tabler--eye] password-active:block hidden size-5 shrink-0"), g.Attr("aria-hidden", "true")),
+			h.Span(h.Class("icon-[tabler--eye-off] password-active:hidden block size-5 shrink-0"), g.Attr("aria-hidden", "true")),
+		)),
+		g.Group(ig.suffix),
+	)...)
+
+	if !joined {
+		return field.Render(w)
+	}
+	return h.Div(
+		h.Class("join"),
+		g.Group(joinItems(ig.leading)),
+		field,
+		g.Group(joinItems(ig.trailing)),
+	).Render(w)
+}
+
+// clearButtonClasses hides the clear button while there is nothing to clear
+func clearButtonClasses(value string) string {
+	classes := "btn btn-text btn-circle btn-xs my-auto"
+	if value == "" {
+		classes += " hidden"
+	}
+	return classes
+}
+
+// joinItems adds the join-item class to components
+func joinItems(nodes []g.Node) []g.Node {
+	items := make([]g.Node, len(nodes))
+	for i, node := range nodes {
+		if c, ok := node.(flyon.Component); ok {
+			node = c.With("join-item")
+		}
+		items[i] = node
+	}
+	return items
+}
+
+// Ensure InputGroupComponent implements flyon.Component
+var _ flyon.Component = (*InputGroupComponent)(nil)
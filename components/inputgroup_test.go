@@ -0,0 +1,113 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	g "maragu.dev/gomponents"
+)
+
+func TestInputGroupComponent_Addons(t *testing.T) {
+	group := NewInputGroup(NewInput().WithID("price").WithName("price").WithColor(flyon.Success)).
+		WithPrefix(InputAddonText("$")).
+		WithSuffix(InputAddonIcon("icon-[tabler--currency-dollar]"))
+	html := renderToStringInput(group)
+
+	if !strings.Contains(html, `<div class="input input-bordered input-success" data-input-group="">`) {
+		t.Errorf("Expected the wrapper to carry the input classes, got: %s", html)
+	}
+	if !strings.Contains(html, `<input type="text" class="grow ps-3 pe-3" id="price" name="price">`) {
+		t.Errorf("Expected an unstyled inner input, got: %s", html)
+	}
+	if strings.Index(html, ">$</span>") > strings.Index(html, "<input") {
+		t.Errorf("Expected the prefix before the input, got: %s", html)
+	}
+	if !strings.Contains(html, `icon-[tabler--currency-dollar]`) {
+		t.Errorf("Expected the suffix icon, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_JoinedButtons(t *testing.T) {
+	group := NewInputGroup(NewInput().WithID("q")).
+		WithTrailingButton(NewButton(g.Text("Search")))
+	html := renderToStringInput(group)
+
+	if !strings.HasPrefix(html, `<div class="join">`) {
+		t.Errorf("Expected a join wrapper, got: %s", html)
+	}
+	if !strings.Contains(html, `input input-bordered join-item`) {
+		t.Errorf("Expected the field to be a join item, got: %s", html)
+	}
+	if !strings.Contains(html, `<button class="btn join-item">Search</button>`) {
+		t.Errorf("Expected the button to be a join item, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_FloatingLabel(t *testing.T) {
+	html := renderToStringInput(NewInputGroup(NewInput().WithID("name")).WithFloatingLabel("Full name"))
+
+	if !strings.Contains(html, `<div class="input-floating grow">`) {
+		t.Errorf("Expected a floating label wrapper, got: %s", html)
+	}
+	if !strings.Contains(html, `<label class="input-floating-label" for="name">Full name</label>`) {
+		t.Errorf("Expected a floating label, got: %s", html)
+	}
+	if !strings.Contains(html, `placeholder="Full name"`) {
+		t.Errorf("Expected the label to be used as placeholder, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_ClearButton(t *testing.T) {
+	html := renderToStringInput(NewInputGroup(NewInput()).WithClearButton())
+	if !strings.Contains(html, `data-input-clear`) || !strings.Contains(html, `btn-xs my-auto hidden`) {
+		t.Errorf("Expected a hidden clear button for an empty input, got: %s", html)
+	}
+	if !strings.Contains(html, `id="input-`) {
+		t.Errorf("Expected a generated ID, got: %s", html)
+	}
+
+	html = renderToStringInput(NewInputGroup(NewInput().WithValue("query")).WithClearButton())
+	if strings.Contains(html, `btn-xs my-auto hidden`) {
+		t.Errorf("Expected a visible clear button for a filled input, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_PasswordToggle(t *testing.T) {
+	html := renderToStringInput(NewInputGroup(NewInput().WithID("pw")).WithPasswordToggle())
+
+	if !strings.Contains(html, `type="password"`) {
+		t.Errorf("Expected a password input, got: %s", html)
+	}
+	if !strings.Contains(html, `data-toggle-password="{&#34;target&#34;:&#34;#pw&#34;}"`) {
+		t.Errorf("Expected a toggle targeting the input, got: %s", html)
+	}
+	if !strings.Contains(html, `password-active:block hidden`) || !strings.Contains(html, `password-active:hidden block`) {
+		t.Errorf("Expected eye icons switching on password-active, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_Mask(t *testing.T) {
+	html := renderToStringInput(NewInputGroup(NewInput().WithValue("5551234567")).WithMask(PhoneMask("")))
+
+	if !strings.Contains(html, `value="(555) 123-4567"`) {
+		t.Errorf("Expected the value to be formatted, got: %s", html)
+	}
+	if !strings.Contains(html, `data-mask="{&#34;kind&#34;:&#34;pattern&#34;`) {
+		t.Errorf("Expected the mask configuration, got: %s", html)
+	}
+	if !strings.Contains(html, `inputmode="numeric"`) || !strings.Contains(html, `maxlength="14"`) {
+		t.Errorf("Expected numeric inputmode and maxlength, got: %s", html)
+	}
+}
+
+func TestInputGroupComponent_Immutability(t *testing.T) {
+	original := NewInputGroup(NewInput())
+	modified := original.WithPrefix(InputAddonText("@")).WithClearButton()
+	if len(original.prefix) != 0 || original.clearable {
+		t.Error("Expected the original input group to be unchanged")
+	}
+	if len(modified.prefix) != 1 || !modified.clearable {
+		t.Error("Expected the modified input group to have the changes")
+	}
+}
@@ -0,0 +1,350 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// maskKind selects the formatting algorithm of an InputMask
+type maskKind string
+
+const (
+	maskPattern    maskKind = "pattern"
+	maskCreditCard maskKind = "credit-card"
+	maskCurrency   maskKind = "currency"
+)
+
+// Pattern tokens understood by PatternMask; any other character is a literal
+const (
+	MaskDigit        = '9'
+	MaskLetter       = 'a'
+	MaskAlphanumeric = '*'
+)
+
+// Credit card layouts: American Express uses 4-6-5 groups, other cards 4-4-4-4
+const (
+	creditCardPattern = "9999 9999 9999 9999"
+	amexPattern       = "9999 999999 99999"
+)
+
+// InputMask formats an input while the user types and strips the formatting
+// again on the server. The same Go code runs in the WASM behavior and in
+// server-side handlers, so both always agree on the format.
+type InputMask struct {
+	kind      maskKind
+	pattern   string
+	uppercase bool
+	// layout is the Go time layout of date masks
+	layout string
+	// currency settings
+	symbol    string
+	decimals  int
+	thousands string
+	decimal   string
+}
+
+// maskData is the JSON representation of an InputMask
+type maskData struct {
+	Kind      maskKind `json:"kind"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Uppercase bool     `json:"uppercase,omitempty"`
+	Layout    string   `json:"layout,omitempty"`
+	Symbol    string   `json:"symbol,omitempty"`
+	Decimals  int      `json:"decimals,omitempty"`
+	Thousands string   `json:"thousands,omitempty"`
+	Decimal   string   `json:"decimal,omitempty"`
+}
+
+// PatternMask creates a mask from a pattern where 9 stands for a digit,
+// a for a letter and * for a letter or digit, e.g. "999-aaa"
+func PatternMask(pattern string) InputMask {
+	return InputMask{kind: maskPattern, pattern: pattern}
+}
+
+// PhoneMask creates a phone number mask; an empty pattern uses "(999) 999-9999"
+func PhoneMask(pattern string) InputMask {
+	if pattern == "" {
+		pattern = "(999) 999-9999"
+	}
+	return PatternMask(pattern)
+}
+
+// CreditCardMask creates a card number mask that groups digits by four, or
+// 4-6-5 for American Express numbers
+func CreditCardMask() InputMask {
+	return InputMask{kind: maskCreditCard, pattern: creditCardPattern}
+}
+
+// IBANMask creates an IBAN mask: a country code, check digits and up to 30
+// uppercase letters or digits in groups of four
+func IBANMask() InputMask {
+	return InputMask{kind: maskPattern, pattern: "aa99 **** **** **** **** **** **** **", uppercase: true}
+}
+
+// DateMask creates a mask for dates typed in a numeric Go time layout such as
+// "01/02/2006" or "02.01.2006"
+func DateMask(layout string) InputMask {
+	replacer := strings.NewReplacer("2006", "9999", "01", "99", "02", "99", "15", "99", "04", "99", "05", "99")
+	return InputMask{kind: maskPattern, pattern: replacer.Replace(layout), layout: layout}
+}
+
+// CurrencyMask creates an amount mask with a currency symbol prefix, the
+// given number of decimals, "," as thousands and "." as decimal separator
+func CurrencyMask(symbol string, decimals int) InputMask {
+	return InputMask{kind: maskCurrency, symbol: symbol, decimals: decimals, thousands: ",", decimal: "."}
+}
+
+// WithSeparators returns a copy of a currency mask using other separators,
+// e.g. "." and "," for many European locales
+func (m InputMask) WithSeparators(thousands, decimal string) InputMask {
+	m.thousands = thousands
+	m.decimal = decimal
+	return m
+}
+
+// Layout returns the Go time layout of a date mask
+func (m InputMask) Layout() string {
+	return m.layout
+}
+
+// Apply formats value according to the mask. Characters that do not fit are
+// dropped and literals are only added up to the last typed character.
+func (m InputMask) Apply(value string) string {
+	switch m.kind {
+	case maskCurrency:
+		return m.applyCurrency(value)
+	case maskCreditCard:
+		pattern := creditCardPattern
+		if digits := m.Strip(value); strings.HasPrefix(digits, "34") || strings.HasPrefix(digits, "37") {
+			pattern = amexPattern
+		}
+		return applyPattern(pattern, value, false)
+	default:
+		return applyPattern(m.pattern, value, m.uppercase)
+	}
+}
+
+// Strip removes the mask characters from a submitted value. Pattern masks
+// keep the characters matched by tokens; currency masks return a plain
+// decimal number such as "-1234.50".
+func (m InputMask) Strip(value string) string {
+	if m.kind == maskCurrency {
+		return m.stripCurrency(value)
+	}
+	pattern := m.pattern
+	if m.kind == maskCreditCard {
+		// Card numbers have no literal letters or digits to skip
+		pattern = strings.Repeat(string(MaskDigit), 19)
+	}
+	return walkPattern(pattern, value, m.uppercase, false)
+}
+
+// ParseTime parses a value typed into a date mask
+func (m InputMask) ParseTime(value string, loc *time.Location) (time.Time, error) {
+	if m.layout == "" {
+		return time.Time{}, fmt.Errorf("mask has no date layout")
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.ParseInLocation(m.layout, value, loc)
+}
+
+// attributes returns the data-mask attribute read by the WASM behavior and
+// an inputmode hinting the matching on-screen keyboard
+func (m InputMask) attributes() []g.Node {
+	data, _ := json.Marshal(maskData{
+		Kind:      m.kind,
+		Pattern:   m.pattern,
+		Uppercase: m.uppercase,
+		Layout:    m.layout,
+		Symbol:    m.symbol,
+		Decimals:  m.decimals,
+		Thousands: m.thousands,
+		Decimal:   m.decimal,
+	})
+	attrs := []g.Node{g.Attr("data-mask", string(data))}
+
+	switch {
+	case m.kind == maskCurrency && m.decimals > 0:
+		attrs = append(attrs, g.Attr("inputmode", "decimal"))
+	case m.kind == maskCurrency || m.kind == maskCreditCard || !strings.ContainsAny(m.pattern, "a*"):
+		attrs = append(attrs, g.Attr("inputmode", "numeric"))
+	}
+	if m.kind == maskPattern && m.pattern != "" {
+		attrs = append(attrs, h.MaxLength(strconv.Itoa(len([]rune(m.pattern)))))
+	}
+	return attrs
+}
+
+// ParseInputMask decodes the data-mask attribute of a masked input
+func ParseInputMask(attr string) (InputMask, error) {
+	var data maskData
+	if err := json.Unmarshal([]byte(attr), &data); err != nil {
+		return InputMask{}, err
+	}
+	return InputMask{
+		kind:      data.Kind,
+		pattern:   data.Pattern,
+		uppercase: data.Uppercase,
+		layout:    data.Layout,
+		symbol:    data.Symbol,
+		decimals:  data.Decimals,
+		thousands: data.Thousands,
+		decimal:   data.Decimal,
+	}, nil
+}
+
+// FormValueUnmasked returns the form value name of r with the mask characters stripped
+func FormValueUnmasked(r *http.Request, name string, mask InputMask) string {
+	return mask.Strip(r.FormValue(name))
+}
+
+// maskTokenMatches reports whether r may fill the pattern token
+func maskTokenMatches(token, r rune) bool {
+	switch token {
+	case MaskDigit:
+		return unicode.IsDigit(r)
+	case MaskLetter:
+		return unicode.IsLetter(r)
+	case MaskAlphanumeric:
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+	return false
+}
+
+// isMaskToken reports whether r is a pattern token
+func isMaskToken(r rune) bool {
+	return r == MaskDigit || r == MaskLetter || r == MaskAlphanumeric
+}
+
+// applyPattern fills the tokens of pattern with the matching characters of value
+func applyPattern(pattern, value string, uppercase bool) string {
+	return walkPattern(pattern, value, uppercase, true)
+}
+
+// walkPattern matches value against pattern, returning the formatted value
+// when withLiterals is set and only the characters filling tokens otherwise.
+// Literals typed by the user are consumed; other input characters that do not
+// fit a token are skipped. Literals are only written while input remains, so
+// a separator appears once the character after it is typed.
+func walkPattern(pattern, value string, uppercase, withLiterals bool) string {
+	input := []rune(value)
+	var sb strings.Builder
+	pending := ""
+	pos := 0
+	for _, p := range pattern {
+		if pos >= len(input) {
+			break
+		}
+		if !isMaskToken(p) {
+			pending += string(p)
+			if input[pos] == p {
+				pos++
+			}
+			continue
+		}
+		for pos < len(input) && !maskTokenMatches(p, input[pos]) {
+			pos++
+		}
+		if pos >= len(input) {
+			break
+		}
+		if withLiterals {
+			sb.WriteString(pending)
+		}
+		pending = ""
+		r := input[pos]
+		if uppercase {
+			r = unicode.ToUpper(r)
+		}
+		sb.WriteRune(r)
+		pos++
+	}
+	return sb.String()
+}
+
+// applyCurrency formats value as an amount
+func (m InputMask) applyCurrency(value string) string {
+	raw := m.stripCurrency(value)
+	if raw == "" || raw == "-" {
+		return raw
+	}
+	negative := strings.HasPrefix(raw, "-")
+	raw = strings.TrimPrefix(raw, "-")
+	whole, fraction, hasFraction := strings.Cut(raw, ".")
+
+	// Group the integer digits in threes from the right
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+	var sb strings.Builder
+	if negative {
+		sb.WriteString("-")
+	}
+	sb.WriteString(m.symbol)
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteString(m.thousands)
+		}
+		sb.WriteRune(r)
+	}
+	if hasFraction && m.decimals > 0 {
+		sb.WriteString(m.decimal)
+		sb.WriteString(fraction)
+	}
+	return sb.String()
+}
+
+// stripCurrency normalizes an amount to digits, an optional leading minus
+// and "." followed by at most m.decimals digits
+func (m InputMask) stripCurrency(value string) string {
+	// A minus anywhere before the first digit, e.g. "-$5" or "$-5", negates the amount
+	prefix := value
+	if i := strings.IndexFunc(value, unicode.IsDigit); i >= 0 {
+		prefix = value[:i]
+	}
+	negative := strings.Contains(prefix, "-")
+
+	var whole, fraction strings.Builder
+	inFraction := false
+	for _, r := range value {
+		switch {
+		case unicode.IsDigit(r) && inFraction:
+			if fraction.Len() < m.decimals {
+				fraction.WriteRune(r)
+			}
+		case unicode.IsDigit(r):
+			whole.WriteRune(r)
+		case m.decimals > 0 && !inFraction && string(r) == m.decimal:
+			inFraction = true
+		}
+	}
+
+	if whole.Len() == 0 && !inFraction {
+		if negative {
+			return "-"
+		}
+		return ""
+	}
+	result := whole.String()
+	if result == "" {
+		result = "0"
+	}
+	if inFraction {
+		result += "." + fraction.String()
+	}
+	if negative {
+		result = "-" + result
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInputMask_Apply(t *testing.T) {
+	tests := []struct {
+		name  string
+		mask  InputMask
+		value string
+		want  string
+	}{
+		{"phone partial", PhoneMask(""), "555", "(555"},
+		{"phone full", PhoneMask(""), "5551234567", "(555) 123-4567"},
+		{"phone ignores letters", PhoneMask(""), "55a51234", "(555) 123-4"},
+		{"phone custom", PhoneMask("+99 999 999 99 99"), "905551234567", "+90 555 123 45 67"},
+		{"card", CreditCardMask(), "4111111111111111", "4111 1111 1111 1111"},
+		{"amex", CreditCardMask(), "378282246310005", "3782 822463 10005"},
+		{"iban", IBANMask(), "de89370400440532013000", "DE89 3704 0044 0532 0130 00"},
+		{"date", DateMask("01/02/2006"), "12252024", "12/25/2024"},
+		{"currency", CurrencyMask("$", 2), "1234567.891", "$1,234,567.89"},
+		{"currency negative", CurrencyMask("$", 2), "-$1234", "-$1,234"},
+		{"currency separators", CurrencyMask("€", 2).WithSeparators(".", ","), "1234,5", "€1.234,5"},
+		{"currency no decimals", CurrencyMask("¥", 0), "1234.56", "¥123,456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mask.Apply(tt.value); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInputMask_Strip(t *testing.T) {
+	tests := []struct {
+		name  string
+		mask  InputMask
+		value string
+		want  string
+	}{
+		{"phone", PhoneMask(""), "(555) 123-4567", "5551234567"},
+		{"card", CreditCardMask(), "3782 822463 10005", "378282246310005"},
+		{"iban", IBANMask(), "DE89 3704 0044 0532 0130 00", "DE89370400440532013000"},
+		{"date", DateMask("01/02/2006"), "12/25/2024", "12252024"},
+		{"currency", CurrencyMask("$", 2), "$1,234.50", "1234.50"},
+		{"currency negative", CurrencyMask("$", 2), "-$1,234", "-1234"},
+		{"currency separators", CurrencyMask("€", 2).WithSeparators(".", ","), "€1.234,56", "1234.56"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mask.Strip(tt.value); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInputMask_ParseTime(t *testing.T) {
+	got, err := DateMask("02.01.2006").ParseTime("25.12.2024", time.UTC)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if _, err := PhoneMask("").ParseTime("(555) 123-4567", nil); err == nil {
+		t.Error("Expected an error for a mask without a date layout")
+	}
+}
+
+func TestInputMask_ParseInputMask(t *testing.T) {
+	mask := CurrencyMask("€", 2).WithSeparators(".", ",")
+	html := renderToStringInput(NewInputGroup(NewInput()).WithMask(mask))
+	start := strings.Index(html, `data-mask="`) + len(`data-mask="`)
+	attr := strings.ReplaceAll(html[start:start+strings.Index(html[start:], `"`)], "&#34;", `"`)
+
+	parsed, err := ParseInputMask(attr)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := parsed.Apply("1234,5"); got != "€1.234,5" {
+		t.Errorf("Expected the parsed mask to format like the original, got %q", got)
+	}
+}
+
+func TestFormValueUnmasked(t *testing.T) {
+	form := url.Values{"phone": {"(555) 123-4567"}}
+	r := httptest.NewRequest("POST", "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if got := FormValueUnmasked(r, "phone", PhoneMask("")); got != "5551234567" {
+		t.Errorf("Expected 5551234567, got %q", got)
+	}
+}
@@ -105,14 +105,11 @@ func (m *ModalComponent) WithSize(size ModalSize) *ModalComponent {
 	return newModal
 }
 
-// WithClosable controls whether the modal can be closed by clicking outside or pressing escape
+// WithClosable controls whether the modal can be closed by clicking outside or pressing escape.
+// A modal that is not closable keeps its backdrop but ignores clicks on it.
 func (m *ModalComponent) WithClosable(closable bool) *ModalComponent {
 	newModal := m.copy()
 	newModal.closable = closable
-	// When not closable, also disable keyboard
-	if !closable {
-		newModal.keyboard = false
-	}
 	return newModal
 }
 
@@ -137,7 +134,7 @@ func (m *ModalComponent) WithPosition(position ModalPosition) *ModalComponent {
 	return newModal
 }
 
-// WithKeyboard controls whether the modal can be closed with the Escape key
+// WithKeyboard controls whether a closable modal can be closed with the Escape key
 func (m *ModalComponent) WithKeyboard(keyboard bool) *ModalComponent {
 	newModal := m.copy()
 	newModal.keyboard = keyboard
@@ -213,6 +210,11 @@ func (m *ModalComponent) Render(w io.Writer) error {
 	classes = append(classes, "overlay", "modal", "overlay-open:opacity-100", "overlay-open:duration-300")
 	classes = append(classes, m.classes...)
 
+	options := newOverlayOptions(m.backdrop, m.closable, m.keyboard)
+	if class := options.class(); class != "" {
+		classes = append(classes, class)
+	}
+
 	// Add position class if specified
 	if positionClass := m.position.String(); positionClass != "" {
 		classes = append(classes, positionClass)
@@ -237,10 +239,8 @@ func (m *ModalComponent) Render(w io.Writer) error {
 		gomponents.Attr("tabindex", "-1"),
 	}
 
-	// Keyboard behavior
-	if !m.keyboard {
-		modalAttrs = append(modalAttrs, gomponents.Attr("data-overlay-keyboard", "false"))
-	}
+	// Backdrop and keyboard behavior
+	modalAttrs = append(modalAttrs, options.attributes()...)
 
 	// Add custom attributes (e.g., overlay-open:* utilities)
 	modalAttrs = append(modalAttrs, m.attributes...)
@@ -308,10 +308,15 @@ func ModalAction(text string, variant flyon.Color, attrs ...gomponents.Node) gom
 	return h.Button(buttonAttrs...)
 }
 
-// ModalCloseAction creates a modal close action button
+// ModalCloseAction creates a modal close action button. It closes the modal
+// it is rendered in through the WASM behavior, see wasm.HydrateModal.
 func ModalCloseAction(text string, variant flyon.Color) gomponents.Node {
-	// 'modal-close' class is kept for internal/demo JS hooks; no HSOverlay data attribute per new docs
-	return ModalAction(text, variant, h.Class("modal-close"))
+	return h.Button(
+		h.Type("button"),
+		h.Class("btn btn-"+variant.String()+" modal-close"),
+		gomponents.Attr("data-modal-close", ""),
+		gomponents.Text(text),
+	)
 }
 
 // Ensure ModalComponent implements the required interfaces
@@ -493,4 +493,59 @@ func TestModalComponent_Immutability(t *testing.T) {
 	if modified6Modal.size != ModalSizeLarge {
 		t.Error("Modified6 should have large size")
 	}
-}
\ No newline at end of file
+}
+
+func TestModalCloseActionSingleClassAttribute(t *testing.T) {
+	var buf strings.Builder
+	if err := ModalCloseAction("Close", flyon.Error).Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	html := buf.String()
+
+	if strings.Count(html, "class=") != 1 {
+		t.Errorf("Close action should render a single class attribute, got %s", html)
+	}
+	if !strings.Contains(html, `class="btn btn-error modal-close"`) {
+		t.Errorf("Close action should combine button and modal-close classes, got %s", html)
+	}
+	if !strings.Contains(html, "data-modal-close") {
+		t.Error("Close action should be marked for the modal behavior")
+	}
+}
+
+func TestModalComponent_RenderOverlayOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		modal    *ModalComponent
+		options  string
+		class    string
+		keyboard bool
+	}{
+		{"default", NewModal("Test"), `{&#34;backdrop&#34;:&#34;true&#34;,&#34;keyboard&#34;:true}`, "", true},
+		{"no backdrop", NewModal("Test").WithBackdrop(false), `{&#34;backdrop&#34;:&#34;false&#34;,&#34;keyboard&#34;:true}`, "[--overlay-backdrop:false]", true},
+		{"not closable", NewModal("Test").WithClosable(false), `{&#34;backdrop&#34;:&#34;static&#34;,&#34;keyboard&#34;:false}`, "[--overlay-backdrop:static]", false},
+		{"no keyboard", NewModal("Test").WithKeyboard(false), `{&#34;backdrop&#34;:&#34;true&#34;,&#34;keyboard&#34;:false}`, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			if err := tt.modal.Render(&buf); err != nil {
+				t.Fatalf("Render failed: %v", err)
+			}
+			html := buf.String()
+
+			if !strings.Contains(html, `data-overlay-options="`+tt.options+`"`) {
+				t.Errorf("expected overlay options %s, got %s", tt.options, html)
+			}
+			if tt.class != "" && !strings.Contains(html, tt.class) {
+				t.Errorf("expected backdrop class %s, got %s", tt.class, html)
+			}
+			if tt.class == "" && strings.Contains(html, "--overlay-backdrop") {
+				t.Errorf("default backdrop should not render a backdrop class, got %s", html)
+			}
+			if strings.Contains(html, `data-overlay-keyboard="false"`) == tt.keyboard {
+				t.Errorf("expected keyboard %v, got %s", tt.keyboard, html)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package components
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// NumberInputConfig holds the bounds and step of a NumberInputComponent. It is
+// rendered as the data-input-number option of FlyonUI's HSInputNumber plugin
+// and read back by the WASM fallback.
+type NumberInputConfig struct {
+	Min  *float64 `json:"min,omitempty"`
+	Max  *float64 `json:"max,omitempty"`
+	Step float64  `json:"step,omitempty"`
+}
+
+// Clamp limits value to the configured bounds
+func (c NumberInputConfig) Clamp(value float64) float64 {
+	if c.Min != nil && value < *c.Min {
+		value = *c.Min
+	}
+	if c.Max != nil && value > *c.Max {
+		value = *c.Max
+	}
+	return value
+}
+
+// Increment moves value by n steps (negative n decrements). The result is
+// snapped to the step grid starting at Min and clamped to the bounds.
+func (c NumberInputConfig) Increment(value float64, n int) float64 {
+	step := c.Step
+	if step <= 0 {
+		step = 1
+	}
+	base := 0.0
+	if c.Min != nil {
+		base = *c.Min
+	}
+
+	// An off-grid value moves to the nearest grid point in the step direction
+	const epsilon = 1e-9
+	position := (value - base) / step
+	if n > 0 {
+		position = math.Floor(position + epsilon)
+	} else {
+		position = math.Ceil(position - epsilon)
+	}
+	result := base + (position+float64(n))*step
+
+	// Avoid floating point noise such as 0.30000000000000004
+	scale := math.Pow(10, float64(max(decimalPlaces(step), decimalPlaces(base))))
+	return c.Clamp(math.Round(result*scale) / scale)
+}
+
+// CanIncrement reports whether moving value by n steps changes it
+func (c NumberInputConfig) CanIncrement(value float64, n int) bool {
+	return c.Increment(value, n) != value
+}
+
+// decimalPlaces returns the number of decimals in the shortest representation of f
+func decimalPlaces(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// NumberInputComponent represents a number input with increment and decrement buttons
+type NumberInputComponent struct {
+	id       string
+	name     string
+	value    float64
+	config   NumberInputConfig
+	label    string
+	disabled bool
+	color    flyon.Color
+	size     flyon.Size
+	classes  []string
+}
+
+// NewNumberInput creates a new number input component
+func NewNumberInput() *NumberInputComponent {
+	return &NumberInputComponent{
+		config:  NumberInputConfig{Step: 1},
+		label:   "Number",
+		color:   flyon.Primary,
+		size:    flyon.SizeMedium,
+		classes: make([]string, 0),
+	}
+}
+
+// WithID sets the number input ID
+func (n *NumberInputComponent) WithID(id string) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.id = id
+	return newInput
+}
+
+// WithName sets the number input name attribute
+func (n *NumberInputComponent) WithName(name string) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.name = name
+	return newInput
+}
+
+// WithValue sets the number input value
+func (n *NumberInputComponent) WithValue(value float64) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.value = value
+	return newInput
+}
+
+// WithMin sets the minimum value
+func (n *NumberInputComponent) WithMin(min float64) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.config.Min = &min
+	return newInput
+}
+
+// WithMax sets the maximum value
+func (n *NumberInputComponent) WithMax(max float64) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.config.Max = &max
+	return newInput
+}
+
+// WithStep sets the amount added or removed by the buttons
+func (n *NumberInputComponent) WithStep(step float64) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.config.Step = step
+	return newInput
+}
+
+// WithLabel sets the accessible label of the input
+func (n *NumberInputComponent) WithLabel(label string) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.label = label
+	return newInput
+}
+
+// WithDisabled sets the number input disabled state
+func (n *NumberInputComponent) WithDisabled(disabled bool) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.disabled = disabled
+	return newInput
+}
+
+// WithColor sets the number input color
+func (n *NumberInputComponent) WithColor(color flyon.Color) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.color = color
+	return newInput
+}
+
+// WithSize sets the number input size
+func (n *NumberInputComponent) WithSize(size flyon.Size) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.size = size
+	return newInput
+}
+
+// WithClasses adds additional CSS classes
+func (n *NumberInputComponent) WithClasses(classes ...string) *NumberInputComponent {
+	newInput := n.copy()
+	newInput.classes = append(newInput.classes, classes...)
+	return newInput
+}
+
+// With applies modifiers to the number input
+func (n *NumberInputComponent) With(modifiers ...any) flyon.Component {
+	newInput := n.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newInput.color = m
+		case flyon.Size:
+			newInput.size = m
+		case string:
+			newInput.classes = append(newInput.classes, m)
+		}
+	}
+	return newInput
+}
+
+// copy creates a deep copy of the number input component
+func (n *NumberInputComponent) copy() *NumberInputComponent {
+	newInput := *n
+	newInput.classes = make([]string, len(n.classes))
+	copy(newInput.classes, n.classes)
+	return &newInput
+}
+
+// Render generates the HTML for the number input
+func (n *NumberInputComponent) Render(w io.Writer) error {
+	wrapper := NewInput().WithColor(n.color).WithSize(n.size).WithClasses(n.classes...)
+	config, _ := json.Marshal(n.config)
+	value := n.config.Clamp(n.value)
+
+	attrs := []g.Node{
+		h.Type("number"),
+		h.Class("grow"),
+		h.Value(formatNumber(value)),
+		g.Attr("aria-label", n.label),
+		g.Attr("data-input-number-input", ""),
+	}
+	if n.id != "" {
+		attrs = append(attrs, h.ID(n.id))
+	}
+	if n.name != "" {
+		attrs = append(attrs, h.Name(n.name))
+	}
+	if n.config.Min != nil {
+		attrs = append(attrs, h.Min(formatNumber(*n.config.Min)))
+	}
+	if n.config.Max != nil {
+		attrs = append(attrs, h.Max(formatNumber(*n.config.Max)))
+	}
+	if n.config.Step > 0 {
+		attrs = append(attrs, h.Step(formatNumber(n.config.Step)))
+	}
+	if n.disabled {
+		attrs = append(attrs, h.Disabled())
+	}
+
+	return h.Div(
+		h.Class(strings.Join(wrapper.classNames(), " ")),
+		g.Attr("data-input-number", string(config)),
+		h.Input(attrs...),
+		h.Span(
+			h.Class("my-auto flex gap-3"),
+			n.stepButton("Decrement", "icon-[tabler--minus]", "data-input-number-decrement", value, -1),
+			n.stepButton("Increment", "icon-[tabler--plus]", "data-input-number-increment", value, 1),
+		),
+	).Render(w)
+}
+
+// stepButton renders a button that moves the value by direction steps,
+// disabled when the value cannot move any further
+func (n *NumberInputComponent) stepButton(label, icon, hook string, value float64, direction int) g.Node {
+	return h.Button(
+		h.Type("button"),
+		h.Class("btn btn-"+n.color.String()+" btn-soft size-5.5 min-h-0 rounded-sm p-0"),
+		g.Attr("aria-label", label),
+		g.Attr(hook, ""),
+		g.If(n.disabled || !n.config.CanIncrement(value, direction), h.Disabled()),
+		h.Span(h.Class(icon+" size-3.5 shrink-0"), g.Attr("aria-hidden", "true")),
+	)
+}
+
+// formatNumber formats f in its shortest decimal representation
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Ensure NumberInputComponent implements flyon.Component
+var _ flyon.Component = (*NumberInputComponent)(nil)
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func TestNumberInputComponent_Render(t *testing.T) {
+	input := NewNumberInput().WithID("qty").WithName("qty").WithMin(0).WithMax(10).WithStep(2).WithValue(4)
+	html := renderToStringInput(input)
+
+	if !strings.Contains(html, `data-input-number="{&#34;min&#34;:0,&#34;max&#34;:10,&#34;step&#34;:2}"`) {
+		t.Errorf("Expected the HSInputNumber configuration, got: %s", html)
+	}
+	for _, want := range []string{`type="number"`, `value="4"`, `min="0"`, `max="10"`, `step="2"`, `id="qty"`, `name="qty"`, `data-input-number-input`, `data-input-number-decrement`, `data-input-number-increment`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in number input, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "disabled") {
+		t.Errorf("Expected both buttons to be enabled, got: %s", html)
+	}
+}
+
+func TestNumberInputComponent_BoundsDisableButtons(t *testing.T) {
+	html := renderToStringInput(NewNumberInput().WithMin(0).WithMax(5).WithValue(5))
+	hook := strings.Index(html, "data-input-number-increment")
+	increment := html[strings.LastIndex(html[:hook], "<button"):]
+	if !strings.Contains(increment, "disabled") {
+		t.Errorf("Expected the increment button to be disabled at the maximum, got: %s", html)
+	}
+	if strings.Count(html, "disabled") != 1 {
+		t.Errorf("Expected only the increment button to be disabled, got: %s", html)
+	}
+
+	html = renderToStringInput(NewNumberInput().WithMin(0).WithMax(5).WithValue(9))
+	if !strings.Contains(html, `value="5"`) {
+		t.Errorf("Expected the value to be clamped, got: %s", html)
+	}
+}
+
+func TestNumberInputComponent_Color(t *testing.T) {
+	html := renderToStringInput(NewNumberInput().With(flyon.Secondary))
+	if !strings.Contains(html, "input-secondary") || !strings.Contains(html, "btn-secondary") {
+		t.Errorf("Expected the color on the field and buttons, got: %s", html)
+	}
+}
+
+func TestNumberInputConfig_Increment(t *testing.T) {
+	min, max := 1.0, 2.0
+	tests := []struct {
+		name   string
+		config NumberInputConfig
+		value  float64
+		n      int
+		want   float64
+	}{
+		{"step up", NumberInputConfig{Step: 1}, 3, 1, 4},
+		{"step down", NumberInputConfig{Step: 1}, 3, -1, 2},
+		{"decimal step", NumberInputConfig{Step: 0.1}, 0.2, 1, 0.3},
+		{"off grid up", NumberInputConfig{Step: 5}, 7, 1, 10},
+		{"off grid down", NumberInputConfig{Step: 5}, 7, -1, 5},
+		{"grid from min", NumberInputConfig{Min: &min, Step: 2}, 1, 1, 3},
+		{"clamped", NumberInputConfig{Min: &min, Max: &max, Step: 0.25}, 2, 1, 2},
+		{"default step", NumberInputConfig{}, 0, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.Increment(tt.value, tt.n); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	config := NumberInputConfig{Min: &min, Max: &max, Step: 1}
+	if config.CanIncrement(2, 1) || !config.CanIncrement(2, -1) || config.CanIncrement(1, -1) {
+		t.Error("Expected CanIncrement to respect the bounds")
+	}
+}
@@ -0,0 +1,61 @@
+package components
+
+import (
+	"encoding/json"
+
+	"maragu.dev/gomponents"
+)
+
+// OverlayBackdrop describes how the backdrop of an overlay behaves
+type OverlayBackdrop string
+
+const (
+	// OverlayBackdropDefault renders a backdrop that closes the overlay on click
+	OverlayBackdropDefault OverlayBackdrop = "true"
+	// OverlayBackdropStatic renders a backdrop that ignores clicks
+	OverlayBackdropStatic OverlayBackdrop = "static"
+	// OverlayBackdropNone renders no backdrop
+	OverlayBackdropNone OverlayBackdrop = "false"
+)
+
+// overlayOptions is the configuration of an overlay shared by modals and
+// drawers. It is rendered as the data-overlay-options JSON read by the WASM
+// overlay manager, and as the CSS properties and attributes FlyonUI's
+// HSOverlay reads.
+type overlayOptions struct {
+	Backdrop OverlayBackdrop `json:"backdrop"`
+	Keyboard bool            `json:"keyboard"`
+}
+
+// newOverlayOptions derives the options from the independent backdrop,
+// closable and keyboard flags of a component, whatever order they were set
+// in. An overlay that is not closable ignores clicks on its backdrop and the
+// Escape key.
+func newOverlayOptions(backdrop, closable, keyboard bool) overlayOptions {
+	options := overlayOptions{Backdrop: OverlayBackdropDefault, Keyboard: keyboard && closable}
+	switch {
+	case !backdrop:
+		options.Backdrop = OverlayBackdropNone
+	case !closable:
+		options.Backdrop = OverlayBackdropStatic
+	}
+	return options
+}
+
+// class returns the HSOverlay backdrop property class, empty by default
+func (o overlayOptions) class() string {
+	if o.Backdrop == OverlayBackdropDefault {
+		return ""
+	}
+	return "[--overlay-backdrop:" + string(o.Backdrop) + "]"
+}
+
+// attributes returns the data-overlay-options and keyboard attributes
+func (o overlayOptions) attributes() []gomponents.Node {
+	data, _ := json.Marshal(o)
+	attrs := []gomponents.Node{gomponents.Attr("data-overlay-options", string(data))}
+	if !o.Keyboard {
+		attrs = append(attrs, gomponents.Attr("data-overlay-keyboard", "false"))
+	}
+	return attrs
+}
@@ -0,0 +1,351 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// PasswordRule is a check of the password strength meter, named after the
+// checks of FlyonUI's HSStrongPassword plugin
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength         PasswordRule = "min-length"
+	PasswordRuleLowercase         PasswordRule = "lowercase"
+	PasswordRuleUppercase         PasswordRule = "uppercase"
+	PasswordRuleNumbers           PasswordRule = "numbers"
+	PasswordRuleSpecialCharacters PasswordRule = "special-characters"
+)
+
+// DefaultPasswordRules are the checks used when no rules are configured
+var DefaultPasswordRules = []PasswordRule{
+	PasswordRuleMinLength,
+	PasswordRuleLowercase,
+	PasswordRuleUppercase,
+	PasswordRuleNumbers,
+	PasswordRuleSpecialCharacters,
+}
+
+// DefaultPasswordLevels label the strength levels from no passed check up to all five
+var DefaultPasswordLevels = []string{"Empty", "Weak", "Medium", "Strong", "Very Strong", "Super Strong"}
+
+// DefaultPasswordMinLength is the minimum password length of PasswordComponent
+const DefaultPasswordMinLength = 8
+
+// PasswordCheck is the result of CheckPassword
+type PasswordCheck struct {
+	Passed []PasswordRule
+	Failed []PasswordRule
+}
+
+// Score returns the number of passed rules, which is the strength level
+func (c PasswordCheck) Score() int {
+	return len(c.Passed)
+}
+
+// OK reports whether every rule passed
+func (c PasswordCheck) OK() bool {
+	return len(c.Failed) == 0
+}
+
+// CheckPassword evaluates password against rules. Handlers use it to enforce
+// the same rules the strength meter shows.
+func CheckPassword(password string, minLength int, rules []PasswordRule) PasswordCheck {
+	var check PasswordCheck
+	for _, rule := range rules {
+		if passwordRulePasses(rule, password, minLength) {
+			check.Passed = append(check.Passed, rule)
+		} else {
+			check.Failed = append(check.Failed, rule)
+		}
+	}
+	return check
+}
+
+// passwordRulePasses reports whether password satisfies rule
+func passwordRulePasses(rule PasswordRule, password string, minLength int) bool {
+	var test func(rune) bool
+	switch rule {
+	case PasswordRuleMinLength:
+		return utf8.RuneCountInString(password) >= minLength
+	case PasswordRuleLowercase:
+		test = unicode.IsLower
+	case PasswordRuleUppercase:
+		test = unicode.IsUpper
+	case PasswordRuleNumbers:
+		test = unicode.IsDigit
+	case PasswordRuleSpecialCharacters:
+		test = func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+		}
+	default:
+		return false
+	}
+	return strings.IndexFunc(password, test) >= 0
+}
+
+// strongPasswordData is the data-strong-password option of HSStrongPassword
+type strongPasswordData struct {
+	Target        string         `json:"target"`
+	Hints         string         `json:"hints"`
+	StripClasses  string         `json:"stripClasses"`
+	MinLength     int            `json:"minLength"`
+	ChecksExclude []PasswordRule `json:"checksExclude,omitempty"`
+}
+
+// PasswordComponent represents a password input with a strength meter and a
+// checklist of the rules the password has to satisfy
+type PasswordComponent struct {
+	id          string
+	name        string
+	placeholder string
+	minLength   int
+	rules       []PasswordRule
+	ruleLabels  map[PasswordRule]string
+	levels      []string
+	toggle      bool
+	required    bool
+	color       flyon.Color
+	size        flyon.Size
+	classes     []string
+}
+
+// NewPassword creates a new password component checking DefaultPasswordRules
+func NewPassword() *PasswordComponent {
+	return &PasswordComponent{
+		minLength:  DefaultPasswordMinLength,
+		rules:      DefaultPasswordRules,
+		ruleLabels: make(map[PasswordRule]string),
+		levels:     DefaultPasswordLevels,
+		color:      flyon.Primary,
+		size:       flyon.SizeMedium,
+		classes:    make([]string, 0),
+	}
+}
+
+// WithID sets the password input ID
+func (p *PasswordComponent) WithID(id string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.id = id
+	return newPassword
+}
+
+// WithName sets the password input name attribute
+func (p *PasswordComponent) WithName(name string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.name = name
+	return newPassword
+}
+
+// WithPlaceholder sets the password input placeholder
+func (p *PasswordComponent) WithPlaceholder(placeholder string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.placeholder = placeholder
+	return newPassword
+}
+
+// WithMinLength sets the length required by the min-length rule
+func (p *PasswordComponent) WithMinLength(length int) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.minLength = length
+	return newPassword
+}
+
+// WithRules sets the checked rules
+func (p *PasswordComponent) WithRules(rules ...PasswordRule) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.rules = append([]PasswordRule(nil), rules...)
+	return newPassword
+}
+
+// WithRuleLabel replaces the checklist text of rule
+func (p *PasswordComponent) WithRuleLabel(rule PasswordRule, label string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.ruleLabels[rule] = label
+	return newPassword
+}
+
+// WithLevels sets the strength level labels, indexed by the number of passed rules
+func (p *PasswordComponent) WithLevels(levels ...string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.levels = append([]string(nil), levels...)
+	return newPassword
+}
+
+// WithToggle adds a button showing and hiding the password
+func (p *PasswordComponent) WithToggle(toggle bool) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.toggle = toggle
+	return newPassword
+}
+
+// WithRequired sets the password input required state
+func (p *PasswordComponent) WithRequired(required bool) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.required = required
+	return newPassword
+}
+
+// WithColor sets the color of the input and the strength meter
+func (p *PasswordComponent) WithColor(color flyon.Color) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.color = color
+	return newPassword
+}
+
+// WithSize sets the password input size
+func (p *PasswordComponent) WithSize(size flyon.Size) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.size = size
+	return newPassword
+}
+
+// WithClasses adds additional CSS classes to the wrapper
+func (p *PasswordComponent) WithClasses(classes ...string) *PasswordComponent {
+	newPassword := p.copy()
+	newPassword.classes = append(newPassword.classes, classes...)
+	return newPassword
+}
+
+// With applies modifiers to the password component
+func (p *PasswordComponent) With(modifiers ...any) flyon.Component {
+	newPassword := p.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newPassword.color = m
+		case flyon.Size:
+			newPassword.size = m
+		case string:
+			newPassword.classes = append(newPassword.classes, m)
+		}
+	}
+	return newPassword
+}
+
+// copy creates a deep copy of the password component
+func (p *PasswordComponent) copy() *PasswordComponent {
+	newPassword := *p
+	newPassword.rules = append([]PasswordRule(nil), p.rules...)
+	newPassword.levels = append([]string(nil), p.levels...)
+	newPassword.ruleLabels = make(map[PasswordRule]string, len(p.ruleLabels))
+	for k, v := range p.ruleLabels {
+		newPassword.ruleLabels[k] = v
+	}
+	newPassword.classes = make([]string, len(p.classes))
+	copy(newPassword.classes, p.classes)
+	return &newPassword
+}
+
+// Check evaluates password against the rules of the component
+func (p *PasswordComponent) Check(password string) PasswordCheck {
+	return CheckPassword(password, p.minLength, p.rules)
+}
+
+// Render generates the HTML for the password component
+func (p *PasswordComponent) Render(w io.Writer) error {
+	id := p.id
+	if id == "" {
+		id = "password-" + generateID()
+	}
+	hintsID := id + "-hints"
+
+	input := NewInput().WithID(id).WithType(InputTypePassword).WithName(p.name).
+		WithPlaceholder(p.placeholder).WithRequired(p.required).WithColor(p.color).WithSize(p.size)
+	var field g.Node = input
+	if p.toggle {
+		field = NewInputGroup(input).WithPasswordToggle()
+	}
+
+	var excluded []PasswordRule
+	for _, rule := range DefaultPasswordRules {
+		if !containsPasswordRule(p.rules, rule) {
+			excluded = append(excluded, rule)
+		}
+	}
+	config, _ := json.Marshal(strongPasswordData{
+		Target:        "#" + id,
+		Hints:         "#" + hintsID,
+		StripClasses:  "strong-password:bg-" + p.color.String() + " strong-password-accepted:bg-success h-1.5 flex-auto bg-neutral/20",
+		MinLength:     p.minLength,
+		ChecksExclude: excluded,
+	})
+	levels, _ := json.Marshal(p.levels)
+
+	rules := make([]g.Node, len(p.rules))
+	for i, rule := range p.rules {
+		rules[i] = h.Li(
+			h.Class("strong-password-active:text-success flex items-center gap-x-2"),
+			g.Attr("data-pw-strength-rule", string(rule)),
+			h.Span(h.Class("icon-[tabler--circle-check] hidden size-5 shrink-0"), g.Attr("aria-hidden", "true"), g.Attr("data-check", "")),
+			h.Span(h.Class("icon-[tabler--circle-x] size-5 shrink-0"), g.Attr("aria-hidden", "true"), g.Attr("data-uncheck", "")),
+			g.Text(p.ruleLabel(rule)),
+		)
+	}
+
+	classes := append([]string{"w-full"}, p.classes...)
+	return h.Div(
+		h.Class(strings.Join(classes, " ")),
+		field,
+		h.Div(
+			h.Class("mt-2 flex gap-0.5 overflow-hidden rounded-full"),
+			g.Attr("data-strong-password", string(config)),
+		),
+		h.Div(
+			h.ID(hintsID),
+			h.Class("mt-3"),
+			h.Div(
+				h.Span(h.Class("text-base-content text-sm"), g.Text("Level: ")),
+				h.Span(
+					h.Class("text-base-content text-sm font-semibold"),
+					g.Attr("data-pw-strength-hint", string(levels)),
+					g.Attr("aria-live", "polite"),
+				),
+			),
+			h.H6(h.Class("text-base-content my-2 text-base font-semibold"), g.Text("Your password must contain:")),
+			h.Ul(h.Class("text-base-content/80 space-y-1 text-sm"), g.Group(rules)),
+		),
+	).Render(w)
+}
+
+// ruleLabel returns the checklist text of rule
+func (p *PasswordComponent) ruleLabel(rule PasswordRule) string {
+	if label, ok := p.ruleLabels[rule]; ok {
+		return label
+	}
+	switch rule {
+	case PasswordRuleMinLength:
+		return fmt.Sprintf("Minimum number of characters is %d.", p.minLength)
+	case PasswordRuleLowercase:
+		return "Should contain lowercase."
+	case PasswordRuleUppercase:
+		return "Should contain uppercase."
+	case PasswordRuleNumbers:
+		return "Should contain numbers."
+	case PasswordRuleSpecialCharacters:
+		return "Should contain special characters."
+	}
+	return string(rule)
+}
+
+// containsPasswordRule reports whether rules contains rule
+func containsPasswordRule(rules []PasswordRule, rule PasswordRule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure PasswordComponent implements flyon.Component
+var _ flyon.Component = (*PasswordComponent)(nil)
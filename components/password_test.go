@@ -0,0 +1,63 @@
+package components
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPasswordComponent_Render(t *testing.T) {
+	html := renderToStringInput(NewPassword().WithID("pw").WithName("password").WithMinLength(10))
+
+	for _, want := range []string{
+		`type="password"`,
+		`id="pw"`,
+		`name="password"`,
+		`data-strong-password="{&#34;target&#34;:&#34;#pw&#34;,&#34;hints&#34;:&#34;#pw-hints&#34;`,
+		`strong-password:bg-primary strong-password-accepted:bg-success`,
+		`&#34;minLength&#34;:10`,
+		`id="pw-hints"`,
+		`data-pw-strength-hint="[&#34;Empty&#34;,&#34;Weak&#34;`,
+		`data-pw-strength-rule="special-characters"`,
+		`Minimum number of characters is 10.`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in password, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "checksExclude") {
+		t.Errorf("Expected no excluded checks with the default rules, got: %s", html)
+	}
+}
+
+func TestPasswordComponent_RulesAndToggle(t *testing.T) {
+	password := NewPassword().WithID("pw").WithRules(PasswordRuleMinLength, PasswordRuleNumbers).
+		WithRuleLabel(PasswordRuleNumbers, "At least one digit").WithToggle(true)
+	html := renderToStringInput(password)
+
+	if !strings.Contains(html, `&#34;checksExclude&#34;:[&#34;lowercase&#34;,&#34;uppercase&#34;,&#34;special-characters&#34;]`) {
+		t.Errorf("Expected the unused checks to be excluded, got: %s", html)
+	}
+	if strings.Count(html, "data-pw-strength-rule") != 2 || !strings.Contains(html, "At least one digit") {
+		t.Errorf("Expected two rules with the custom label, got: %s", html)
+	}
+	if !strings.Contains(html, `data-toggle-password="{&#34;target&#34;:&#34;#pw&#34;}"`) {
+		t.Errorf("Expected a password toggle, got: %s", html)
+	}
+}
+
+func TestCheckPassword(t *testing.T) {
+	check := CheckPassword("abcDEF12", 8, DefaultPasswordRules)
+	if check.Score() != 4 || check.OK() {
+		t.Errorf("Expected 4 passed rules, got %+v", check)
+	}
+	if !reflect.DeepEqual(check.Failed, []PasswordRule{PasswordRuleSpecialCharacters}) {
+		t.Errorf("Expected only the special characters rule to fail, got %v", check.Failed)
+	}
+	if !NewPassword().Check("abcDEF12!").OK() {
+		t.Error("Expected a password passing every rule to be OK")
+	}
+	if CheckPassword("", 8, DefaultPasswordRules).Score() != 0 {
+		t.Error("Expected an empty password to pass no rule")
+	}
+}
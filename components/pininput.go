@@ -0,0 +1,235 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// DefaultPinLength is the number of boxes of a PinInputComponent
+const DefaultPinLength = 4
+
+// PinInputComponent represents a one-time code or PIN entered in single
+// character boxes that advance automatically. The code is submitted through a
+// hidden input carrying the name.
+type PinInputComponent struct {
+	id           string
+	name         string
+	value        string
+	length       int
+	alphanumeric bool
+	masked       bool
+	placeholder  string
+	disabled     bool
+	color        flyon.Color
+	size         flyon.Size
+	classes      []string
+}
+
+// NewPinInput creates a new numeric pin input component
+func NewPinInput() *PinInputComponent {
+	return &PinInputComponent{
+		length:      DefaultPinLength,
+		placeholder: "○",
+		color:       flyon.Primary,
+		size:        flyon.SizeMedium,
+		classes:     make([]string, 0),
+	}
+}
+
+// WithID sets the pin input ID
+func (p *PinInputComponent) WithID(id string) *PinInputComponent {
+	newPin := p.copy()
+	newPin.id = id
+	return newPin
+}
+
+// WithName sets the name of the hidden input carrying the code
+func (p *PinInputComponent) WithName(name string) *PinInputComponent {
+	newPin := p.copy()
+	newPin.name = name
+	return newPin
+}
+
+// WithValue prefills the boxes
+func (p *PinInputComponent) WithValue(value string) *PinInputComponent {
+	newPin := p.copy()
+	newPin.value = value
+	return newPin
+}
+
+// WithLength sets the number of boxes
+func (p *PinInputComponent) WithLength(length int) *PinInputComponent {
+	newPin := p.copy()
+	newPin.length = length
+	return newPin
+}
+
+// WithAlphanumeric accepts letters as well as digits
+func (p *PinInputComponent) WithAlphanumeric(alphanumeric bool) *PinInputComponent {
+	newPin := p.copy()
+	newPin.alphanumeric = alphanumeric
+	return newPin
+}
+
+// WithMasked hides the typed characters like a password
+func (p *PinInputComponent) WithMasked(masked bool) *PinInputComponent {
+	newPin := p.copy()
+	newPin.masked = masked
+	return newPin
+}
+
+// WithPlaceholder sets the placeholder shown in empty boxes
+func (p *PinInputComponent) WithPlaceholder(placeholder string) *PinInputComponent {
+	newPin := p.copy()
+	newPin.placeholder = placeholder
+	return newPin
+}
+
+// WithDisabled sets the pin input disabled state
+func (p *PinInputComponent) WithDisabled(disabled bool) *PinInputComponent {
+	newPin := p.copy()
+	newPin.disabled = disabled
+	return newPin
+}
+
+// WithColor sets the pin input color
+func (p *PinInputComponent) WithColor(color flyon.Color) *PinInputComponent {
+	newPin := p.copy()
+	newPin.color = color
+	return newPin
+}
+
+// WithSize sets the pin input size
+func (p *PinInputComponent) WithSize(size flyon.Size) *PinInputComponent {
+	newPin := p.copy()
+	newPin.size = size
+	return newPin
+}
+
+// WithClasses adds additional CSS classes to the wrapper
+func (p *PinInputComponent) WithClasses(classes ...string) *PinInputComponent {
+	newPin := p.copy()
+	newPin.classes = append(newPin.classes, classes...)
+	return newPin
+}
+
+// With applies modifiers to the pin input
+func (p *PinInputComponent) With(modifiers ...any) flyon.Component {
+	newPin := p.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newPin.color = m
+		case flyon.Size:
+			newPin.size = m
+		case string:
+			newPin.classes = append(newPin.classes, m)
+		}
+	}
+	return newPin
+}
+
+// copy creates a deep copy of the pin input component
+func (p *PinInputComponent) copy() *PinInputComponent {
+	newPin := *p
+	newPin.classes = make([]string, len(p.classes))
+	copy(newPin.classes, p.classes)
+	return &newPin
+}
+
+// Render generates the HTML for the pin input
+func (p *PinInputComponent) Render(w io.Writer) error {
+	length := p.length
+	if length <= 0 {
+		length = DefaultPinLength
+	}
+	chars := PinChars(p.value, p.alphanumeric, length)
+
+	classes := append([]string{"flex", "gap-3"}, p.classes...)
+	pattern := "^[0-9]+$"
+	if p.alphanumeric {
+		pattern = "^[a-zA-Z0-9]+$"
+	}
+	attrs := []g.Node{
+		h.Class(strings.Join(classes, " ")),
+		g.Attr("data-pin-input", fmt.Sprintf(`{"availableCharsRE":%q}`, pattern)),
+		g.Attr("data-pin-input-length", strconv.Itoa(length)),
+	}
+	if p.id != "" {
+		attrs = append(attrs, h.ID(p.id))
+	}
+
+	itemClasses := []string{"pin-input"}
+	if p.color != flyon.Primary {
+		itemClasses = append(itemClasses, "pin-input-"+p.color.String())
+	}
+	if p.size != flyon.SizeMedium {
+		itemClasses = append(itemClasses, "pin-input-"+p.size.String())
+	}
+	inputType := "text"
+	if p.masked {
+		inputType = "password"
+	}
+	inputMode := "numeric"
+	if p.alphanumeric {
+		inputMode = "text"
+	}
+
+	for i := 0; i < length; i++ {
+		value := ""
+		if i < len(chars) {
+			value = chars[i]
+		}
+		attrs = append(attrs, h.Input(
+			h.Type(inputType),
+			h.Class(strings.Join(itemClasses, " ")),
+			h.Placeholder(p.placeholder),
+			h.MaxLength("1"),
+			g.Attr("inputmode", inputMode),
+			g.If(i == 0, h.AutoComplete("one-time-code")),
+			g.If(i > 0, h.AutoComplete("off")),
+			g.Attr("aria-label", fmt.Sprintf("Character %d of %d", i+1, length)),
+			g.If(value != "", h.Value(value)),
+			g.If(p.disabled, h.Disabled()),
+			g.Attr("data-pin-input-item", ""),
+		))
+	}
+
+	attrs = append(attrs, h.Input(
+		h.Type("hidden"),
+		g.If(p.name != "", h.Name(p.name)),
+		h.Value(strings.Join(chars, "")),
+		g.Attr("data-pin-input-value", ""),
+	))
+
+	return h.Div(attrs...).Render(w)
+}
+
+// PinChars splits text into at most length characters accepted by a pin
+// input, skipping separators such as the spaces or dashes of a pasted code
+func PinChars(text string, alphanumeric bool, length int) []string {
+	chars := make([]string, 0, length)
+	for _, r := range text {
+		if len(chars) == length {
+			break
+		}
+		if r > unicode.MaxASCII {
+			continue
+		}
+		if unicode.IsDigit(r) || (alphanumeric && unicode.IsLetter(r)) {
+			chars = append(chars, string(r))
+		}
+	}
+	return chars
+}
+
+// Ensure PinInputComponent implements flyon.Component
+var _ flyon.Component = (*PinInputComponent)(nil)
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func TestPinInputComponent_Render(t *testing.T) {
+	html := renderToStringInput(NewPinInput().WithID("otp").WithName("code").WithLength(6))
+
+	if got := strings.Count(html, "data-pin-input-item"); got != 6 {
+		t.Errorf("Expected 6 boxes, got %d: %s", got, html)
+	}
+	for _, want := range []string{
+		`id="otp"`,
+		`data-pin-input="{&#34;availableCharsRE&#34;:&#34;^[0-9]+$&#34;}"`,
+		`class="pin-input"`,
+		`inputmode="numeric"`,
+		`autocomplete="one-time-code"`,
+		`aria-label="Character 1 of 6"`,
+		`<input type="hidden" name="code" value="" data-pin-input-value="">`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in pin input, got: %s", want, html)
+		}
+	}
+}
+
+func TestPinInputComponent_ValueAndOptions(t *testing.T) {
+	pin := NewPinInput().WithName("code").WithValue("1a2-3").WithAlphanumeric(true).WithMasked(true).
+		With(flyon.Success, flyon.SizeSmall)
+	html := renderToStringInput(pin)
+
+	if !strings.Contains(html, `type="password"`) || !strings.Contains(html, `inputmode="text"`) {
+		t.Errorf("Expected masked alphanumeric boxes, got: %s", html)
+	}
+	if !strings.Contains(html, `class="pin-input pin-input-success pin-input-sm"`) {
+		t.Errorf("Expected color and size classes, got: %s", html)
+	}
+	if !strings.Contains(html, `name="code" value="1a23"`) {
+		t.Errorf("Expected the hidden value to hold the accepted characters, got: %s", html)
+	}
+}
+
+func TestPinChars(t *testing.T) {
+	if got := PinChars("12 34-56", false, 4); !reflect.DeepEqual(got, []string{"1", "2", "3", "4"}) {
+		t.Errorf("Expected separators skipped and length capped, got %v", got)
+	}
+	if got := PinChars("a1b2", false, 4); !reflect.DeepEqual(got, []string{"1", "2"}) {
+		t.Errorf("Expected letters dropped from numeric codes, got %v", got)
+	}
+	if got := PinChars("a1b2", true, 4); !reflect.DeepEqual(got, []string{"a", "1", "b", "2"}) {
+		t.Errorf("Expected letters kept in alphanumeric codes, got %v", got)
+	}
+}
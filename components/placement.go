@@ -0,0 +1,45 @@
+package components
+
+// Placement is the floating position of a menu or popup relative to its
+// trigger, using Floating UI's placement names read by FlyonUI from the
+// --placement CSS property
+type Placement string
+
+const (
+	PlacementTop         Placement = "top"
+	PlacementTopStart    Placement = "top-start"
+	PlacementTopEnd      Placement = "top-end"
+	PlacementBottom      Placement = "bottom"
+	PlacementBottomStart Placement = "bottom-start"
+	PlacementBottomEnd   Placement = "bottom-end"
+	PlacementLeft        Placement = "left"
+	PlacementLeftStart   Placement = "left-start"
+	PlacementLeftEnd     Placement = "left-end"
+	PlacementRight       Placement = "right"
+	PlacementRightStart  Placement = "right-start"
+	PlacementRightEnd    Placement = "right-end"
+)
+
+// PositionStrategy is the CSS position used for a floating element, read by
+// FlyonUI from the --strategy CSS property
+type PositionStrategy string
+
+const (
+	// StrategyAbsolute positions relative to the closest positioned ancestor
+	StrategyAbsolute PositionStrategy = "absolute"
+	// StrategyFixed positions relative to the viewport, escaping containers
+	// with overflow hidden
+	StrategyFixed PositionStrategy = "fixed"
+)
+
+// OpenTrigger selects the interaction opening a dropdown or popover, read by
+// FlyonUI from the --trigger CSS property
+type OpenTrigger string
+
+const (
+	TriggerClick OpenTrigger = "click"
+	TriggerHover OpenTrigger = "hover"
+	// TriggerFocus opens a popover while its trigger has focus; dropdowns
+	// do not support it
+	TriggerFocus OpenTrigger = "focus"
+)
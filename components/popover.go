@@ -0,0 +1,188 @@
+package components
+
+import (
+	"io"
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// PopoverComponent shows rich content such as titles, buttons or forms next
+// to a trigger, built on FlyonUI's HSTooltip markup
+type PopoverComponent struct {
+	id          string
+	trigger     gomponents.Node
+	custom      bool // Render the trigger as is instead of wrapping it in a button
+	title       string
+	content     []gomponents.Node
+	openOn      OpenTrigger
+	placement   Placement
+	strategy    PositionStrategy
+	interactive bool
+	classes     []string
+}
+
+// NewPopover creates a popover opened by clicking trigger, which is wrapped
+// in a button
+func NewPopover(trigger gomponents.Node, content ...gomponents.Node) *PopoverComponent {
+	return &PopoverComponent{
+		trigger:   trigger,
+		content:   content,
+		openOn:    TriggerClick,
+		placement: PlacementTop,
+		classes:   []string{},
+	}
+}
+
+// WithID sets a custom ID for the popover
+func (p *PopoverComponent) WithID(id string) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.id = id
+	return newPopover
+}
+
+// WithTitle sets a heading shown above the content
+func (p *PopoverComponent) WithTitle(title string) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.title = title
+	return newPopover
+}
+
+// WithTrigger sets whether the popover opens on click, hover or focus
+func (p *PopoverComponent) WithTrigger(trigger OpenTrigger) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.openOn = trigger
+	return newPopover
+}
+
+// WithPlacement sets the floating placement of the popover
+func (p *PopoverComponent) WithPlacement(placement Placement) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.placement = placement
+	return newPopover
+}
+
+// WithStrategy sets the CSS position strategy of the popover
+func (p *PopoverComponent) WithStrategy(strategy PositionStrategy) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.strategy = strategy
+	return newPopover
+}
+
+// WithInteractive keeps a hover or focus popover open while the pointer is
+// over its content, so links and buttons inside it can be used. Click
+// popovers are always interactive.
+func (p *PopoverComponent) WithInteractive(interactive bool) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.interactive = interactive
+	return newPopover
+}
+
+// WithCustomTrigger renders trigger as is instead of wrapping it in a
+// button, e.g. for a ButtonComponent. Give it PopoverTriggerAttributes so
+// assistive technology announces the popover.
+func (p *PopoverComponent) WithCustomTrigger(trigger gomponents.Node) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.trigger = trigger
+	newPopover.custom = true
+	return newPopover
+}
+
+// WithClasses adds CSS classes to the popover content
+func (p *PopoverComponent) WithClasses(classes ...string) *PopoverComponent {
+	newPopover := p.copy()
+	newPopover.classes = append(newPopover.classes, classes...)
+	return newPopover
+}
+
+// With applies modifiers to the popover and returns a new instance
+func (p *PopoverComponent) With(modifiers ...any) flyon.Component {
+	newPopover := p.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case Placement:
+			newPopover.placement = m
+		case OpenTrigger:
+			newPopover.openOn = m
+		case string:
+			newPopover.classes = append(newPopover.classes, m)
+		}
+	}
+	return newPopover
+}
+
+// copy creates a deep copy of the popover
+func (p *PopoverComponent) copy() *PopoverComponent {
+	newPopover := *p
+	newPopover.content = append([]gomponents.Node(nil), p.content...)
+	newPopover.classes = append([]string{}, p.classes...)
+	return &newPopover
+}
+
+// PopoverTriggerAttributes returns the ARIA attributes of the trigger of the
+// popover with id, for triggers passed to WithCustomTrigger
+func PopoverTriggerAttributes(id string) gomponents.Node {
+	return gomponents.Group([]gomponents.Node{
+		h.Aria("describedby", id+"-content"),
+		h.Aria("controls", id+"-content"),
+		h.Aria("haspopup", "dialog"),
+		h.Aria("expanded", "false"),
+	})
+}
+
+// Render implements the gomponents.Node interface
+func (p *PopoverComponent) Render(w io.Writer) error {
+	id := p.id
+	if id == "" {
+		id = "popover-" + generateID()
+	}
+
+	classes := append([]string{"tooltip"}, floatingClasses(p.placement, nil, p.strategy, p.openOn)...)
+
+	trigger := p.trigger
+	if !p.custom {
+		trigger = h.Button(
+			h.Type("button"),
+			h.Class("btn"),
+			PopoverTriggerAttributes(id),
+			p.trigger,
+		)
+	}
+
+	bodyClasses := append([]string{"tooltip-body", "bg-base-100", "text-base-content/80", "max-w-xs", "rounded-lg", "p-4", "text-start", "shadow-md"}, p.classes...)
+	content := h.Div(
+		h.ID(id+"-content"),
+		h.Class("tooltip-content tooltip-shown:opacity-100 tooltip-shown:visible"),
+		h.Role("dialog"),
+		gomponents.If(p.title != "", h.Aria("labelledby", id+"-title")),
+		h.Div(
+			h.Class(strings.Join(bodyClasses, " ")),
+			gomponents.If(p.title != "", h.H4(
+				h.ID(id+"-title"),
+				h.Class("text-base-content mb-1 text-base font-medium"),
+				gomponents.Text(p.title),
+			)),
+			gomponents.Group(p.content),
+		),
+	)
+
+	// HSTooltip treats the pointer leaving, or clicks outside, the toggle as
+	// leaving the popover, so usable content is nested inside the toggle
+	var inner []gomponents.Node
+	if p.interactive || p.openOn == TriggerClick {
+		inner = []gomponents.Node{h.Div(h.Class("tooltip-toggle"), trigger, content)}
+	} else {
+		inner = []gomponents.Node{h.Div(h.Class("tooltip-toggle inline-flex"), trigger), content}
+	}
+
+	return h.Div(append([]gomponents.Node{
+		h.ID(id),
+		h.Class(strings.Join(classes, " ")),
+		gomponents.Attr("data-popover", ""),
+	}, inner...)...).Render(w)
+}
+
+// Ensure PopoverComponent implements flyon.Component
+var _ flyon.Component = (*PopoverComponent)(nil)
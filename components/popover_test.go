@@ -0,0 +1,80 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func renderPopover(t *testing.T, popover *PopoverComponent) string {
+	t.Helper()
+	var buf strings.Builder
+	if err := popover.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	return buf.String()
+}
+
+func TestPopoverComponent_Render(t *testing.T) {
+	popover := NewPopover(gomponents.Text("Details"), h.P(gomponents.Text("Rich content")), h.Button(gomponents.Text("Action"))).
+		WithID("info").
+		WithTitle("Info")
+	html := renderPopover(t, popover)
+
+	if !strings.Contains(html, `<div id="info" class="tooltip [--placement:top] [--trigger:click]" data-popover="">`) {
+		t.Errorf("Expected popover container, got %s", html)
+	}
+	if !strings.Contains(html, `aria-describedby="info-content"`) || !strings.Contains(html, `aria-expanded="false"`) {
+		t.Error("Expected trigger described by the popover content")
+	}
+	if !strings.Contains(html, `<div id="info-content" class="tooltip-content tooltip-shown:opacity-100 tooltip-shown:visible" role="dialog" aria-labelledby="info-title">`) {
+		t.Errorf("Expected popover content, got %s", html)
+	}
+	if !strings.Contains(html, `<h4 id="info-title"`) || !strings.Contains(html, "Rich content") || !strings.Contains(html, "<button>Action</button>") {
+		t.Error("Expected title and rich content")
+	}
+	// Click popovers keep their content inside the toggle so it stays usable
+	if !strings.Contains(html, `<div class="tooltip-toggle"><button`) || strings.Contains(html, `</div><div id="info-content"`) {
+		t.Errorf("Expected content nested in the toggle, got %s", html)
+	}
+}
+
+func TestPopoverComponent_RenderHover(t *testing.T) {
+	popover := NewPopover(gomponents.Text("Hover")).WithID("tip").WithTrigger(TriggerHover).WithPlacement(PlacementRightStart)
+	html := renderPopover(t, popover)
+
+	if !strings.Contains(html, "[--placement:right-start] [--trigger:hover]") {
+		t.Errorf("Expected hover trigger and placement, got %s", html)
+	}
+	if !strings.Contains(html, `</button></div><div id="tip-content"`) {
+		t.Errorf("Expected content next to the toggle, got %s", html)
+	}
+
+	interactive := renderPopover(t, popover.WithInteractive(true))
+	if strings.Contains(interactive, `</button></div><div id="tip-content"`) {
+		t.Error("Expected interactive content nested in the toggle")
+	}
+}
+
+func TestPopoverComponent_RenderCustomTrigger(t *testing.T) {
+	trigger := NewButton(PopoverTriggerAttributes("menu"), gomponents.Text("Open"))
+	html := renderPopover(t, NewPopover(nil).WithID("menu").WithCustomTrigger(trigger))
+
+	if strings.Count(html, "<button") != 1 {
+		t.Errorf("Custom trigger should not be wrapped, got %s", html)
+	}
+	if !strings.Contains(html, `aria-describedby="menu-content"`) {
+		t.Error("Expected ARIA attributes on the custom trigger")
+	}
+}
+
+func TestPopoverComponent_Immutability(t *testing.T) {
+	original := NewPopover(gomponents.Text("Open"))
+	_ = original.WithTitle("Title").WithTrigger(TriggerFocus).WithPlacement(PlacementBottom).WithInteractive(true).WithClasses("w-80")
+
+	if original.title != "" || original.openOn != TriggerClick || original.placement != PlacementTop || original.interactive || len(original.classes) != 0 {
+		t.Error("Original popover should not change")
+	}
+}
@@ -19,6 +19,7 @@ type RadioComponent struct {
 	color    flyon.Color
 	size     flyon.Size
 	classes  []string
+	label    choiceLabel
 }
 
 // NewRadio creates a new radio component
@@ -64,6 +65,27 @@ func (r *RadioComponent) WithDisabled(disabled bool) *RadioComponent {
 	return newRadio
 }
 
+// WithLabel wraps the radio in a label with text
+func (r *RadioComponent) WithLabel(text string) *RadioComponent {
+	newRadio := r.copy()
+	newRadio.label.text = text
+	return newRadio
+}
+
+// WithDescription adds helper text below the label
+func (r *RadioComponent) WithDescription(description string) *RadioComponent {
+	newRadio := r.copy()
+	newRadio.label.description = description
+	return newRadio
+}
+
+// WithLabelPlacement places the label before or after the radio
+func (r *RadioComponent) WithLabelPlacement(placement LabelPlacement) *RadioComponent {
+	newRadio := r.copy()
+	newRadio.label.placement = placement
+	return newRadio
+}
+
 // WithColor sets the radio color
 func (r *RadioComponent) WithColor(color flyon.Color) *RadioComponent {
 	newRadio := r.copy()
@@ -152,5 +174,14 @@ func (r *RadioComponent) Render(w io.Writer) error {
 		attrs = append(attrs, h.Disabled())
 	}
 	
-	return h.Input(attrs...).Render(w)
+	id := r.id
+	if r.label.description != "" && id == "" {
+		id = "radio-" + generateID()
+		attrs = append(attrs, h.ID(id))
+	}
+	if describedBy := r.label.descriptionID(id); describedBy != "" {
+		attrs = append(attrs, g.Attr("aria-describedby", describedBy))
+	}
+	
+	return r.label.wrap(h.Input(attrs...), id).Render(w)
 }
\ No newline at end of file
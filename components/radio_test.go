@@ -217,4 +217,17 @@ func TestRadioComponent_Immutability(t *testing.T) {
 	if !strings.Contains(modifiedHTML, `checked`) {
 		t.Error("Modified radio should be checked")
 	}
-}
\ No newline at end of file
+}
+
+func TestRadioComponent_Label(t *testing.T) {
+	html := renderToStringRadio(NewRadio().WithName("plan").WithLabel("Pro").WithDescription("For teams"))
+	if !strings.Contains(html, `<label class="flex cursor-pointer gap-3 items-start">`) || !strings.Contains(html, "For teams") {
+		t.Errorf("Expected labelled radio, got: %s", html)
+	}
+	if !strings.Contains(html, `aria-describedby="radio-`) {
+		t.Errorf("Expected a generated ID for the description, got: %s", html)
+	}
+	if bare := renderToStringRadio(NewRadio()); strings.Contains(bare, "<label") {
+		t.Errorf("Expected no label by default, got: %s", bare)
+	}
+}
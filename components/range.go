@@ -5,9 +5,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
-	"github.com/ozanturksever/gomponents-flyonui/flyon"
 )
 
 // RangeComponent represents a range slider input with FlyonUI styling
@@ -22,6 +22,14 @@ type RangeComponent struct {
 	color    flyon.Color
 	size     flyon.Size
 	classes  []string
+	// slider mode settings, see rangeslider.go
+	dual     bool
+	high     float64
+	ticks    []float64
+	pips     int // Evenly spaced ticks, computed from the bounds at render
+	tooltips bool
+	format   string
+	vertical bool
 }
 
 // NewRange creates a new range component
@@ -78,6 +86,54 @@ func (r *RangeComponent) WithStep(step float64) *RangeComponent {
 	return newRange
 }
 
+// WithValues switches the range to a slider with two handles selecting the
+// span from low to high. The values are submitted as <name>_min and <name>_max.
+func (r *RangeComponent) WithValues(low, high float64) *RangeComponent {
+	newRange := r.copy()
+	newRange.dual = true
+	newRange.value = low
+	newRange.high = high
+	return newRange
+}
+
+// WithTicks adds labelled ticks below the slider at the given values
+func (r *RangeComponent) WithTicks(values ...float64) *RangeComponent {
+	newRange := r.copy()
+	newRange.ticks = append([]float64(nil), values...)
+	newRange.pips = 0
+	return newRange
+}
+
+// WithPips adds count+1 evenly spaced labelled ticks from min to max
+func (r *RangeComponent) WithPips(count int) *RangeComponent {
+	newRange := r.copy()
+	newRange.ticks = nil
+	newRange.pips = max(count, 0)
+	return newRange
+}
+
+// WithTooltips shows the value of each handle above it
+func (r *RangeComponent) WithTooltips(tooltips bool) *RangeComponent {
+	newRange := r.copy()
+	newRange.tooltips = tooltips
+	return newRange
+}
+
+// WithFormat formats tooltips, ticks and announced values with the function
+// registered under name with RegisterRangeFormat
+func (r *RangeComponent) WithFormat(name string) *RangeComponent {
+	newRange := r.copy()
+	newRange.format = name
+	return newRange
+}
+
+// WithVertical renders the slider bottom to top
+func (r *RangeComponent) WithVertical(vertical bool) *RangeComponent {
+	newRange := r.copy()
+	newRange.vertical = vertical
+	return newRange
+}
+
 // WithDisabled sets the range disabled state
 func (r *RangeComponent) WithDisabled(disabled bool) *RangeComponent {
 	newRange := r.copy()
@@ -127,36 +183,22 @@ func (r *RangeComponent) copy() *RangeComponent {
 	newRange := *r
 	newRange.classes = make([]string, len(r.classes))
 	copy(newRange.classes, r.classes)
+	newRange.ticks = append([]float64(nil), r.ticks...)
 	return &newRange
 }
 
 // Render generates the HTML for the range
 func (r *RangeComponent) Render(w io.Writer) error {
-	classes := []string{"range"}
-	
-	// Add color class
-	if r.color != flyon.Primary {
-		classes = append(classes, "range-"+r.color.String())
+	if r.sliderMode() {
+		return r.renderSlider(w)
 	}
-	
-	// Add size class
-	if r.size != flyon.SizeMedium {
-		classes = append(classes, "range-"+r.size.String())
-	}
-	
-	// Add custom classes
-	classes = append(classes, r.classes...)
-	
+
 	// Build attributes
-	attrs := []g.Node{
+	attrs := append([]g.Node{
 		h.Type("range"),
-		h.Class(strings.Join(classes, " ")),
-		g.Attr("min", strconv.FormatFloat(r.min, 'f', -1, 64)),
-		g.Attr("max", strconv.FormatFloat(r.max, 'f', -1, 64)),
-		g.Attr("step", strconv.FormatFloat(r.step, 'f', -1, 64)),
-		h.Value(strconv.FormatFloat(r.value, 'f', -1, 64)),
-	}
-	
+		h.Class(strings.Join(append(r.rangeClasses(), r.classes...), " ")),
+	}, r.inputAttrs(r.value)...)
+
 	if r.id != "" {
 		attrs = append(attrs, h.ID(r.id))
 	}
@@ -170,4 +212,34 @@ func (r *RangeComponent) Render(w io.Writer) error {
 	}
 	
 	return h.Input(attrs...).Render(w)
-}
\ No newline at end of file
+}
+
+// rangeClasses returns the FlyonUI classes of the range input
+func (r *RangeComponent) rangeClasses() []string {
+	classes := []string{"range"}
+
+	// Add color class
+	if r.color != flyon.Primary {
+		classes = append(classes, "range-"+r.color.String())
+	}
+
+	// Add size class
+	if r.size != flyon.SizeMedium {
+		classes = append(classes, "range-"+r.size.String())
+	}
+
+	return classes
+}
+
+// inputAttrs returns the bounds of a range input showing value
+func (r *RangeComponent) inputAttrs(value float64) []g.Node {
+	return []g.Node{
+		g.Attr("min", strconv.FormatFloat(r.min, 'f', -1, 64)),
+		g.Attr("max", strconv.FormatFloat(r.max, 'f', -1, 64)),
+		g.Attr("step", strconv.FormatFloat(r.step, 'f', -1, 64)),
+		h.Value(strconv.FormatFloat(value, 'f', -1, 64)),
+	}
+}
+
+// Ensure RangeComponent implements flyon.Component
+var _ flyon.Component = (*RangeComponent)(nil)
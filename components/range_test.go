@@ -1,6 +1,8 @@
 package components
 
 import (
+	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -263,4 +265,79 @@ func TestRangeComponent_Immutability(t *testing.T) {
 	if !strings.Contains(modifiedHTML, "range-success") {
 		t.Error("Modified range should have new color")
 	}
-}
\ No newline at end of file
+}
+
+func TestRangeComponent_DualHandles(t *testing.T) {
+	html := renderToStringRange(NewRange().WithID("price").WithName("price").WithMin(0).WithMax(1000).WithValues(750, 250))
+
+	if got := strings.Count(html, `type="range"`); got != 2 {
+		t.Errorf("Expected two range inputs, got %d: %s", got, html)
+	}
+	for _, want := range []string{
+		`id="price"`,
+		`id="price-max"`,
+		`aria-label="Minimum"`,
+		`aria-label="Maximum"`,
+		`<input type="hidden" name="price_min" value="250" data-range-value="0">`,
+		`<input type="hidden" name="price_max" value="250" data-range-value="1">`,
+		`style="left: 25%; right: 75%"`,
+		`[&amp;::-webkit-slider-thumb]:pointer-events-auto`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in dual range, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, `name="price"`) {
+		t.Errorf("Expected the handles to be unnamed, got: %s", html)
+	}
+}
+
+func TestRangeComponent_TooltipsTicksAndFormat(t *testing.T) {
+	RegisterRangeFormat("test-usd", func(v float64) string { return "$" + strconv.FormatFloat(v, 'f', 0, 64) })
+	html := renderToStringRange(NewRange().WithMax(200).WithValues(50, 150).WithTooltips(true).WithPips(2).WithFormat("test-usd"))
+
+	for _, want := range []string{
+		`data-range-slider="{&#34;format&#34;:&#34;test-usd&#34;}"`,
+		`style="left: 25%" data-range-tooltip="0">$50</output>`,
+		`style="left: 75%" data-range-tooltip="1">$150</output>`,
+		`aria-valuetext="$150"`,
+		`style="left: 0%">$0</span>`,
+		`style="left: 50%">$100</span>`,
+		`style="left: 100%">$200</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in range slider, got: %s", want, html)
+		}
+	}
+}
+
+func TestRangeComponent_PipsFollowBounds(t *testing.T) {
+	html := renderToStringRange(NewRange().WithPips(4).WithMax(200))
+
+	for _, want := range []string{`style="left: 25%">50</span>`, `style="left: 100%">200</span>`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in range slider, got: %s", want, html)
+		}
+	}
+}
+
+func TestRangeComponent_Vertical(t *testing.T) {
+	html := renderToStringRange(NewRange().WithName("volume").WithValue(30).WithVertical(true).WithTicks(0, 100))
+
+	for _, want := range []string{`aria-orientation="vertical"`, `[writing-mode:vertical-lr]`, `name="volume"`, `style="bottom: 0%; top: 70%"`, `style="bottom: 100%">100</span>`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in vertical range, got: %s", want, html)
+		}
+	}
+}
+
+func TestFormValueRange(t *testing.T) {
+	r := httptest.NewRequest("GET", "/?price_min=900&price_max=100", nil)
+	low, high, err := FormValueRange(r, "price")
+	if err != nil || low != 100 || high != 900 {
+		t.Errorf("Expected 100 and 900, got %v, %v, %v", low, high, err)
+	}
+	if _, _, err := FormValueRange(httptest.NewRequest("GET", "/", nil), "price"); err == nil {
+		t.Error("Expected an error for missing values")
+	}
+}
@@ -0,0 +1,238 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// RangeFormatFunc formats a range value for display, e.g. as a price
+type RangeFormatFunc func(float64) string
+
+// rangeFormats holds the formats registered with RegisterRangeFormat
+var rangeFormats = struct {
+	sync.RWMutex
+	funcs map[string]RangeFormatFunc
+}{funcs: make(map[string]RangeFormatFunc)}
+
+// RegisterRangeFormat makes fn available to RangeComponent.WithFormat under
+// name. Register formats in code shared by the server and the WASM client so
+// rendered and live-updated values look the same.
+func RegisterRangeFormat(name string, fn RangeFormatFunc) {
+	rangeFormats.Lock()
+	defer rangeFormats.Unlock()
+	rangeFormats.funcs[name] = fn
+}
+
+// RangeFormat returns the format registered under name, falling back to the
+// shortest decimal representation of the value
+func RangeFormat(name string) RangeFormatFunc {
+	rangeFormats.RLock()
+	defer rangeFormats.RUnlock()
+	if fn, ok := rangeFormats.funcs[name]; ok {
+		return fn
+	}
+	return formatNumber
+}
+
+// rangeSliderData is the data-range-slider configuration read by the WASM behavior
+type rangeSliderData struct {
+	Format   string `json:"format,omitempty"`
+	Vertical bool   `json:"vertical,omitempty"`
+}
+
+// sliderMode reports whether the range needs the slider markup instead of a
+// single native input
+func (r *RangeComponent) sliderMode() bool {
+	return r.dual || r.tooltips || r.vertical || len(r.ticks) > 0 || r.pips > 0
+}
+
+// tickValues returns the values of the ticks, spreading the pips over the
+// bounds the range has when rendered
+func (r *RangeComponent) tickValues() []float64 {
+	if r.pips == 0 {
+		return r.ticks
+	}
+	ticks := make([]float64, r.pips+1)
+	for i := range ticks {
+		ticks[i] = r.min + (r.max-r.min)*float64(i)/float64(r.pips)
+	}
+	return ticks
+}
+
+// clamp limits v to the bounds of the range
+func (r *RangeComponent) clamp(v float64) float64 {
+	return min(max(v, r.min), r.max)
+}
+
+// percent returns the position of v along the track
+func (r *RangeComponent) percent(v float64) float64 {
+	if r.max <= r.min {
+		return 0
+	}
+	return (r.clamp(v) - r.min) / (r.max - r.min) * 100
+}
+
+// RangeOffset returns the inline style placing an element at percent along a
+// horizontal or vertical track
+func RangeOffset(percent float64, vertical bool) string {
+	side := "left"
+	if vertical {
+		side = "bottom"
+	}
+	return fmt.Sprintf("%s: %s%%", side, strconv.FormatFloat(percent, 'f', -1, 64))
+}
+
+// RangeFill returns the inline style of the track highlight from one percent to another
+func RangeFill(from, to float64, vertical bool) string {
+	start, end := "left", "right"
+	if vertical {
+		start, end = "bottom", "top"
+	}
+	return fmt.Sprintf("%s: %s%%; %s: %s%%", start, strconv.FormatFloat(from, 'f', -1, 64), end, strconv.FormatFloat(100-to, 'f', -1, 64))
+}
+
+// renderSlider renders the range as a slider with one or two handles,
+// tooltips and ticks. Two handles are native range inputs stacked on one
+// track; only their thumbs receive pointer events.
+func (r *RangeComponent) renderSlider(w io.Writer) error {
+	format := RangeFormat(r.format)
+	values := []float64{r.clamp(r.value)}
+	if r.dual {
+		high := r.clamp(r.high)
+		values = []float64{min(values[0], high), high}
+	}
+
+	config, _ := json.Marshal(rangeSliderData{Format: r.format, Vertical: r.vertical})
+
+	fillFrom, fillTo := 0.0, r.percent(values[0])
+	if r.dual {
+		fillFrom, fillTo = r.percent(values[0]), r.percent(values[1])
+	}
+
+	trackClasses := "relative h-6 w-full"
+	fillClasses := "absolute h-full rounded-full bg-" + r.color.String()
+	barClasses := "bg-base-content/10 absolute inset-x-0 top-1/2 h-1.5 -translate-y-1/2 rounded-full"
+	inputClasses := []string{"absolute", "inset-0", "h-full", "w-full", "bg-transparent"}
+	tooltipClasses := "bg-base-content text-base-100 pointer-events-none absolute bottom-full mb-1 -translate-x-1/2 rounded px-1.5 py-0.5 text-xs whitespace-nowrap"
+	if r.vertical {
+		trackClasses = "relative h-48 w-6"
+		fillClasses = "absolute w-full rounded-full bg-" + r.color.String()
+		barClasses = "bg-base-content/10 absolute inset-y-0 left-1/2 w-1.5 -translate-x-1/2 rounded-full"
+		inputClasses = append(inputClasses, "[writing-mode:vertical-lr]", "[direction:rtl]")
+		tooltipClasses = "bg-base-content text-base-100 pointer-events-none absolute left-full ms-1 translate-y-1/2 rounded px-1.5 py-0.5 text-xs whitespace-nowrap"
+	}
+	if r.dual {
+		inputClasses = append(inputClasses, "pointer-events-none", "[&::-webkit-slider-thumb]:pointer-events-auto", "[&::-moz-range-thumb]:pointer-events-auto")
+	}
+
+	labels := []string{"Value"}
+	if r.dual {
+		labels = []string{"Minimum", "Maximum"}
+	}
+
+	track := []g.Node{
+		h.Class(trackClasses),
+		h.Div(h.Class(barClasses), h.Div(
+			h.Class(fillClasses),
+			h.Style(RangeFill(fillFrom, fillTo, r.vertical)),
+			g.Attr("data-range-fill", ""),
+		)),
+	}
+	for i, value := range values {
+		classes := append(r.rangeClasses(), inputClasses...)
+		// The lower handle lies on top when both sit at the maximum, otherwise it could not be moved
+		if i == 0 && r.dual && value >= r.max {
+			classes = append(classes, "z-10")
+		}
+		attrs := append([]g.Node{h.Type("range"), h.Class(strings.Join(classes, " "))}, r.inputAttrs(value)...)
+		if r.id != "" {
+			id := r.id
+			if i == 1 {
+				id += "-max"
+			}
+			attrs = append(attrs, h.ID(id))
+		}
+		if r.name != "" && !r.dual {
+			attrs = append(attrs, h.Name(r.name))
+		}
+		if r.vertical {
+			attrs = append(attrs, g.Attr("aria-orientation", "vertical"))
+		}
+		attrs = append(attrs,
+			g.Attr("aria-label", labels[i]),
+			g.Attr("aria-valuetext", format(value)),
+			g.If(r.disabled, h.Disabled()),
+			g.Attr("data-range-handle", strconv.Itoa(i)),
+		)
+		track = append(track, h.Input(attrs...))
+
+		if r.tooltips {
+			track = append(track, g.El("output",
+				h.Class(tooltipClasses),
+				h.Style(RangeOffset(r.percent(value), r.vertical)),
+				g.Attr("data-range-tooltip", strconv.Itoa(i)),
+				g.Text(format(value)),
+			))
+		}
+	}
+
+	wrapperClasses := []string{"relative", "w-full"}
+	if r.vertical {
+		wrapperClasses = []string{"relative", "inline-flex", "gap-3"}
+	} else if r.tooltips {
+		wrapperClasses = append(wrapperClasses, "pt-7")
+	}
+	nodes := []g.Node{
+		h.Class(strings.Join(append(wrapperClasses, r.classes...), " ")),
+		g.Attr("data-range-slider", string(config)),
+		h.Div(track...),
+	}
+
+	if tickValues := r.tickValues(); len(tickValues) > 0 {
+		tickClasses, labelClasses := "text-base-content/70 relative mt-1 h-4 text-xs", "absolute -translate-x-1/2 whitespace-nowrap"
+		if r.vertical {
+			tickClasses, labelClasses = "text-base-content/70 relative h-48 text-xs", "absolute translate-y-1/2 whitespace-nowrap"
+		}
+		ticks := make([]g.Node, len(tickValues))
+		for i, tick := range tickValues {
+			ticks[i] = h.Span(h.Class(labelClasses), h.Style(RangeOffset(r.percent(tick), r.vertical)), g.Text(format(tick)))
+		}
+		nodes = append(nodes, h.Div(h.Class(tickClasses), g.Attr("aria-hidden", "true"), g.Attr("data-range-ticks", ""), g.Group(ticks)))
+	}
+
+	if r.dual && r.name != "" {
+		for i, suffix := range []string{"_min", "_max"} {
+			nodes = append(nodes, h.Input(
+				h.Type("hidden"),
+				h.Name(r.name+suffix),
+				h.Value(formatNumber(values[i])),
+				g.Attr("data-range-value", strconv.Itoa(i)),
+			))
+		}
+	}
+
+	return h.Div(nodes...).Render(w)
+}
+
+// FormValueRange parses the <name>_min and <name>_max values submitted by a
+// range with two handles
+func FormValueRange(r *http.Request, name string) (low, high float64, err error) {
+	if low, err = strconv.ParseFloat(r.FormValue(name+"_min"), 64); err != nil {
+		return 0, 0, fmt.Errorf("%s_min: %w", name, err)
+	}
+	if high, err = strconv.ParseFloat(r.FormValue(name+"_max"), 64); err != nil {
+		return 0, 0, fmt.Errorf("%s_max: %w", name, err)
+	}
+	if low > high {
+		low, high = high, low
+	}
+	return low, high, nil
+}
@@ -1,13 +1,15 @@
 package components
 
 import (
+	"encoding/json"
+	"html"
 	"io"
 	"strconv"
 	"strings"
 
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
 	g "maragu.dev/gomponents"
 	h "maragu.dev/gomponents/html"
-	"github.com/ozanturksever/gomponents-flyonui/flyon"
 )
 
 // SelectOption represents an option in a select dropdown
@@ -16,21 +18,114 @@ type SelectOption struct {
 	Label    string
 	Selected bool
 	Disabled bool
+	// Group is the optgroup label the option belongs to (empty for none)
+	Group string
+	// Description is secondary text shown by the advanced select option template
+	Description string
+	// Icon is an icon class (e.g. "icon-[tabler--user]") shown by the advanced select
+	Icon string
+	// Avatar is an image URL shown by the advanced select
+	Avatar string
 }
 
+// SelectMode represents the interaction mode of an advanced select
+type SelectMode int
+
+const (
+	SelectModeDefault SelectMode = iota
+	SelectModeTags
+)
+
+// String returns the FlyonUI mode value for the advanced select
+func (m SelectMode) String() string {
+	switch m {
+	case SelectModeTags:
+		return "tags"
+	default:
+		return "default"
+	}
+}
+
+// AdvancedSelectConfig configures FlyonUI's advance select (HSSelect).
+// It is rendered as the data-select JSON configuration of the select element.
+type AdvancedSelectConfig struct {
+	// Searchable adds a search input to the dropdown
+	Searchable bool
+	// SearchPlaceholder is the placeholder of the search input
+	SearchPlaceholder string
+	// Mode selects between the default and the tags mode (tags implies multiple)
+	Mode SelectMode
+	// ToggleClasses overrides the classes of the toggle button
+	ToggleClasses string
+	// DropdownClasses overrides the classes of the dropdown menu
+	DropdownClasses string
+	// OptionClasses overrides the classes of each dropdown option
+	OptionClasses string
+	// OptionTemplate overrides the option markup; the default template shows
+	// icons, avatars and descriptions when any option defines them
+	OptionTemplate string
+	// APIURL loads options from a remote endpoint
+	APIURL string
+	// APIQuery is an additional query string appended to remote requests
+	APIQuery string
+	// APISearchQueryKey is the query parameter carrying the search term
+	APISearchQueryKey string
+	// APIFieldsMap maps option fields (id, val, title, icon, description) to response keys
+	APIFieldsMap map[string]string
+}
+
+// advancedSelectData is the JSON shape expected by FlyonUI's data-select attribute
+type advancedSelectData struct {
+	Placeholder       string            `json:"placeholder,omitempty"`
+	Mode              string            `json:"mode,omitempty"`
+	ToggleTag         string            `json:"toggleTag,omitempty"`
+	ToggleClasses     string            `json:"toggleClasses,omitempty"`
+	HasSearch         bool              `json:"hasSearch,omitempty"`
+	SearchPlaceholder string            `json:"searchPlaceholder,omitempty"`
+	WrapperClasses    string            `json:"wrapperClasses,omitempty"`
+	TagsItemTemplate  string            `json:"tagsItemTemplate,omitempty"`
+	TagsInputClasses  string            `json:"tagsInputClasses,omitempty"`
+	DropdownClasses   string            `json:"dropdownClasses,omitempty"`
+	OptionClasses     string            `json:"optionClasses,omitempty"`
+	OptionTemplate    string            `json:"optionTemplate,omitempty"`
+	ExtraMarkup       string            `json:"extraMarkup,omitempty"`
+	APIURL            string            `json:"apiUrl,omitempty"`
+	APIQuery          string            `json:"apiQuery,omitempty"`
+	APISearchQueryKey string            `json:"apiSearchQueryKey,omitempty"`
+	APIFieldsMap      map[string]string `json:"apiFieldsMap,omitempty"`
+}
+
+// advancedSelectOptionData is the JSON shape of the data-select-option attribute
+type advancedSelectOptionData struct {
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+}
+
+const (
+	advancedSelectToggleClasses   = "advance-select-toggle select-disabled:pointer-events-none select-disabled:opacity-40"
+	advancedSelectDropdownClasses = "advance-select-menu max-h-52 pt-0 overflow-y-auto"
+	advancedSelectOptionClasses   = "advance-select-option selected:select-active"
+	advancedSelectOptionTemplate  = `<div class="flex justify-between items-center w-full"><span data-title></span><span class="icon-[tabler--check] shrink-0 size-4 text-primary hidden selected:block"></span></div>`
+	advancedSelectRichTemplate    = `<div class="flex items-center gap-2 w-full"><div data-icon></div><div class="flex flex-col"><span class="text-sm font-medium" data-title></span><span class="text-base-content/60 text-xs" data-description></span></div><span class="icon-[tabler--check] shrink-0 size-4 text-primary hidden selected:block ms-auto"></span></div>`
+	advancedSelectExtraMarkup     = `<span class="icon-[tabler--caret-up-down] shrink-0 size-4 text-base-content absolute top-1/2 end-3 -translate-y-1/2"></span>`
+	advancedSelectTagsItem        = `<div class="advance-select-tag-item"><div class="size-6 me-1" data-icon></div><div class="whitespace-nowrap" data-title></div><div class="advance-select-tag-item-remove" data-remove><span class="icon-[tabler--x] size-3"></span></div></div>`
+)
+
 // SelectComponent represents a select dropdown with FlyonUI styling
 type SelectComponent struct {
-	id       string
-	name     string
-	value    string
-	disabled bool
-	required bool
-	multiple bool
-	size     int
-	color    flyon.Color
-	compSize flyon.Size
-	options  []SelectOption
-	classes  []string
+	id          string
+	name        string
+	value       string
+	disabled    bool
+	required    bool
+	multiple    bool
+	size        int
+	color       flyon.Color
+	compSize    flyon.Size
+	placeholder string
+	options     []SelectOption
+	classes     []string
+	advanced    *AdvancedSelectConfig
 }
 
 // NewSelect creates a new select component
@@ -137,6 +232,37 @@ func (s *SelectComponent) WithDisabledOption(value, label string) *SelectCompone
 	return newSelect
 }
 
+// WithOptionGroup adds options rendered inside an optgroup with the given label
+func (s *SelectComponent) WithOptionGroup(label string, options ...SelectOption) *SelectComponent {
+	newSelect := s.copy()
+	for _, option := range options {
+		option.Group = label
+		newSelect.options = append(newSelect.options, option)
+	}
+	return newSelect
+}
+
+// WithPlaceholder sets the placeholder shown when no option is selected
+func (s *SelectComponent) WithPlaceholder(placeholder string) *SelectComponent {
+	newSelect := s.copy()
+	newSelect.placeholder = placeholder
+	return newSelect
+}
+
+// WithAdvanced switches the select to FlyonUI's advance select mode
+func (s *SelectComponent) WithAdvanced(config AdvancedSelectConfig) *SelectComponent {
+	newSelect := s.copy()
+	if config.APIFieldsMap != nil {
+		fields := make(map[string]string, len(config.APIFieldsMap))
+		for k, v := range config.APIFieldsMap {
+			fields[k] = v
+		}
+		config.APIFieldsMap = fields
+	}
+	newSelect.advanced = &config
+	return newSelect
+}
+
 // WithOptions sets multiple options at once
 func (s *SelectComponent) WithOptions(options []SelectOption) *SelectComponent {
 	newSelect := s.copy()
@@ -181,14 +307,18 @@ func (s *SelectComponent) copy() *SelectComponent {
 // Render generates the HTML for the select
 func (s *SelectComponent) Render(w io.Writer) error {
 	classes := []string{"select", "select-bordered"}
-	
+	if s.advanced != nil {
+		// FlyonUI hides the native select and renders its own toggle
+		classes = []string{"hidden"}
+	}
+
 	// Add color class
-	if s.color != flyon.Primary {
+	if s.advanced == nil && s.color != flyon.Primary {
 		classes = append(classes, "select-"+s.color.String())
 	}
 	
 	// Add size class
-	if s.compSize != flyon.SizeMedium {
+	if s.advanced == nil && s.compSize != flyon.SizeMedium {
 		classes = append(classes, "select-"+s.compSize.String())
 	}
 	
@@ -219,34 +349,164 @@ func (s *SelectComponent) Render(w io.Writer) error {
 	if s.required {
 		attrs = append(attrs, h.Required())
 	}
-	
-	if s.multiple {
+
+	if s.multiple || (s.advanced != nil && s.advanced.Mode == SelectModeTags) {
 		attrs = append(attrs, h.Multiple())
 	}
 	
 	if s.size > 0 {
 		attrs = append(attrs, g.Attr("size", strconv.Itoa(s.size)))
 	}
-	
-	// Build options
-	optionNodes := make([]g.Node, 0, len(s.options))
-	for _, option := range s.options {
-		optionAttrs := []g.Node{
-			h.Value(option.Value),
+
+	if s.advanced != nil {
+		config, err := s.advancedConfigJSON()
+		if err != nil {
+			return err
 		}
-		
-		if option.Selected {
-			optionAttrs = append(optionAttrs, h.Selected())
+		attrs = append(attrs, g.Attr("data-select", config))
+	}
+
+	// Placeholder option
+	if s.placeholder != "" {
+		if s.advanced != nil {
+			// The advanced select shows the placeholder from its config; an empty option keeps the value unset
+			attrs = append(attrs, h.Option(h.Value(""), g.Text(s.placeholder)))
+		} else {
+			placeholderAttrs := []g.Node{h.Value(""), h.Disabled()}
+			if !s.hasSelectedOption() {
+				placeholderAttrs = append(placeholderAttrs, h.Selected())
+			}
+			attrs = append(attrs, h.Option(append(placeholderAttrs, g.Text(s.placeholder))...))
+		}
+	}
+
+	// Build options, grouping consecutive options that share a group label
+	optionNodes := make([]g.Node, 0, len(s.options))
+	for i := 0; i < len(s.options); i++ {
+		option := s.options[i]
+		if option.Group == "" {
+			optionNodes = append(optionNodes, s.renderOption(option))
+			continue
 		}
-		
-		if option.Disabled {
-			optionAttrs = append(optionAttrs, h.Disabled())
+
+		groupNodes := []g.Node{g.Attr("label", option.Group)}
+		for ; i < len(s.options) && s.options[i].Group == option.Group; i++ {
+			groupNodes = append(groupNodes, s.renderOption(s.options[i]))
 		}
-		
-		optionNodes = append(optionNodes, h.Option(append(optionAttrs, g.Text(option.Label))...))
+		i--
+		optionNodes = append(optionNodes, g.El("optgroup", groupNodes...))
 	}
 	
 	attrs = append(attrs, optionNodes...)
 	
 	return h.Select(attrs...).Render(w)
-}
\ No newline at end of file
+}
+
+// renderOption renders a single option element
+func (s *SelectComponent) renderOption(option SelectOption) g.Node {
+	optionAttrs := []g.Node{
+		h.Value(option.Value),
+	}
+
+	if option.Selected {
+		optionAttrs = append(optionAttrs, h.Selected())
+	}
+
+	if option.Disabled {
+		optionAttrs = append(optionAttrs, h.Disabled())
+	}
+
+	if s.advanced != nil && option.hasTemplateData() {
+		data := advancedSelectOptionData{Description: option.Description}
+		switch {
+		case option.Avatar != "":
+			data.Icon = `<img class="shrink-0 size-5 rounded-full" src="` + html.EscapeString(option.Avatar) + `" alt="" />`
+		case option.Icon != "":
+			data.Icon = `<span class="` + html.EscapeString(option.Icon) + ` shrink-0 size-5"></span>`
+		}
+		// Marshalling a struct of strings cannot fail
+		config, _ := marshalConfig(data)
+		optionAttrs = append(optionAttrs, g.Attr("data-select-option", config))
+	}
+
+	return h.Option(append(optionAttrs, g.Text(option.Label))...)
+}
+
+// hasTemplateData reports whether the option carries data for the advanced option template
+func (o SelectOption) hasTemplateData() bool {
+	return o.Description != "" || o.Icon != "" || o.Avatar != ""
+}
+
+// hasSelectedOption reports whether any option is marked as selected
+func (s *SelectComponent) hasSelectedOption() bool {
+	for _, option := range s.options {
+		if option.Selected {
+			return true
+		}
+	}
+	return false
+}
+
+// advancedConfigJSON builds the data-select configuration for the advanced mode
+func (s *SelectComponent) advancedConfigJSON() (string, error) {
+	cfg := s.advanced
+	data := advancedSelectData{
+		Placeholder:       s.placeholder,
+		ToggleTag:         `<button type="button" aria-expanded="false"></button>`,
+		ToggleClasses:     cfg.ToggleClasses,
+		HasSearch:         cfg.Searchable,
+		SearchPlaceholder: cfg.SearchPlaceholder,
+		DropdownClasses:   cfg.DropdownClasses,
+		OptionClasses:     cfg.OptionClasses,
+		OptionTemplate:    cfg.OptionTemplate,
+		ExtraMarkup:       advancedSelectExtraMarkup,
+		APIURL:            cfg.APIURL,
+		APIQuery:          cfg.APIQuery,
+		APISearchQueryKey: cfg.APISearchQueryKey,
+		APIFieldsMap:      cfg.APIFieldsMap,
+	}
+
+	if data.ToggleClasses == "" {
+		data.ToggleClasses = advancedSelectToggleClasses
+	}
+	if data.DropdownClasses == "" {
+		data.DropdownClasses = advancedSelectDropdownClasses
+	}
+	if data.OptionClasses == "" {
+		data.OptionClasses = advancedSelectOptionClasses
+	}
+	if data.OptionTemplate == "" {
+		data.OptionTemplate = advancedSelectOptionTemplate
+		for _, option := range s.options {
+			if option.hasTemplateData() {
+				data.OptionTemplate = advancedSelectRichTemplate
+				break
+			}
+		}
+	}
+
+	if cfg.Mode == SelectModeTags {
+		// Tags mode renders selected options as chips inside a wrapper instead of a toggle
+		data.Mode = cfg.Mode.String()
+		data.ToggleTag = ""
+		data.ToggleClasses = ""
+		data.ExtraMarkup = ""
+		data.WrapperClasses = "advance-select-tag flex-wrap"
+		data.TagsItemTemplate = advancedSelectTagsItem
+		data.TagsInputClasses = "advance-select-tag-input"
+	}
+
+	return marshalConfig(data)
+}
+
+// marshalConfig encodes a FlyonUI JSON configuration without escaping the
+// HTML templates it carries; attribute escaping is left to gomponents.
+func marshalConfig(v any) (string, error) {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
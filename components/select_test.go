@@ -305,4 +305,144 @@ func TestSelectComponent_Immutability(t *testing.T) {
 	if !strings.Contains(modifiedHTML, "value2") {
 		t.Errorf("Modified component should contain 'value2'")
 	}
-}
\ No newline at end of file
+}
+
+func TestSelectComponent_WithPlaceholder(t *testing.T) {
+	select1 := NewSelect().WithPlaceholder("Pick one").WithOption("a", "A")
+	html := renderToStringSelect(select1)
+
+	if !strings.Contains(html, `<option value="" disabled selected>Pick one</option>`) {
+		t.Errorf("Expected HTML to contain selected placeholder option, got: %s", html)
+	}
+
+	// A selected option takes precedence over the placeholder
+	select2 := NewSelect().WithPlaceholder("Pick one").WithSelectedOption("a", "A")
+	html = renderToStringSelect(select2)
+
+	if !strings.Contains(html, `<option value="" disabled>Pick one</option>`) {
+		t.Errorf("Expected placeholder option not to be selected, got: %s", html)
+	}
+}
+
+func TestSelectComponent_WithOptionGroup(t *testing.T) {
+	select1 := NewSelect().
+		WithOption("none", "None").
+		WithOptionGroup("Fruits", SelectOption{Value: "apple", Label: "Apple"}, SelectOption{Value: "pear", Label: "Pear"}).
+		WithOptionGroup("Vegetables", SelectOption{Value: "leek", Label: "Leek"})
+	html := renderToStringSelect(select1)
+
+	expected := []string{
+		`<option value="none">None</option>`,
+		`<optgroup label="Fruits"><option value="apple">Apple</option><option value="pear">Pear</option></optgroup>`,
+		`<optgroup label="Vegetables"><option value="leek">Leek</option></optgroup>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain '%s', got: %s", e, html)
+		}
+	}
+}
+
+func TestSelectComponent_WithAdvanced(t *testing.T) {
+	select1 := NewSelect().
+		WithPlaceholder("Select option...").
+		WithAdvanced(AdvancedSelectConfig{Searchable: true, SearchPlaceholder: "Search..."}).
+		WithOption("a", "A")
+	html := renderToStringSelect(select1)
+
+	expected := []string{
+		`class="hidden"`,
+		`data-select="{`,
+		`&#34;placeholder&#34;:&#34;Select option...&#34;`,
+		`&#34;hasSearch&#34;:true`,
+		`&#34;searchPlaceholder&#34;:&#34;Search...&#34;`,
+		`advance-select-toggle`,
+		`advance-select-menu`,
+		`<option value="">Select option...</option>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain '%s', got: %s", e, html)
+		}
+	}
+
+	if strings.Contains(html, "select-bordered") {
+		t.Errorf("Expected advanced select not to render native select classes, got: %s", html)
+	}
+}
+
+func TestSelectComponent_WithAdvancedTags(t *testing.T) {
+	select1 := NewSelect().WithAdvanced(AdvancedSelectConfig{Mode: SelectModeTags})
+	html := renderToStringSelect(select1)
+
+	if !strings.Contains(html, "multiple") {
+		t.Errorf("Expected tags mode to render a multiple select, got: %s", html)
+	}
+	if !strings.Contains(html, `&#34;mode&#34;:&#34;tags&#34;`) {
+		t.Errorf("Expected tags mode in config, got: %s", html)
+	}
+	if !strings.Contains(html, "advance-select-tag-item") {
+		t.Errorf("Expected tags item template in config, got: %s", html)
+	}
+}
+
+func TestSelectComponent_WithAdvancedOptionTemplate(t *testing.T) {
+	select1 := NewSelect().
+		WithAdvanced(AdvancedSelectConfig{}).
+		WithOptions([]SelectOption{
+			{Value: "jd", Label: "John Doe", Description: "Admin", Avatar: "/john.png"},
+			{Value: "ai", Label: "AI", Icon: "icon-[tabler--robot]"},
+			{Value: "x", Label: "Plain"},
+		})
+	html := renderToStringSelect(select1)
+
+	expected := []string{
+		`data-description`,
+		`data-select-option="{&#34;description&#34;:&#34;Admin&#34;,&#34;icon&#34;:`,
+		`src=\&#34;/john.png\&#34;`,
+		`icon-[tabler--robot] shrink-0 size-5`,
+		`<option value="x">Plain</option>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain '%s', got: %s", e, html)
+		}
+	}
+}
+
+func TestSelectComponent_WithAdvancedRemote(t *testing.T) {
+	select1 := NewSelect().WithAdvanced(AdvancedSelectConfig{
+		Searchable:        true,
+		APIURL:            "/api/users",
+		APIQuery:          "limit=20",
+		APISearchQueryKey: "q",
+		APIFieldsMap:      map[string]string{"id": "id", "title": "name"},
+	})
+	html := renderToStringSelect(select1)
+
+	expected := []string{
+		`&#34;apiUrl&#34;:&#34;/api/users&#34;`,
+		`&#34;apiQuery&#34;:&#34;limit=20&#34;`,
+		`&#34;apiSearchQueryKey&#34;:&#34;q&#34;`,
+		`&#34;apiFieldsMap&#34;:{&#34;id&#34;:&#34;id&#34;,&#34;title&#34;:&#34;name&#34;}`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected HTML to contain '%s', got: %s", e, html)
+		}
+	}
+}
+
+func TestSelectComponent_WithAdvancedImmutability(t *testing.T) {
+	fields := map[string]string{"title": "name"}
+	original := NewSelect()
+	modified := original.WithAdvanced(AdvancedSelectConfig{APIURL: "/api", APIFieldsMap: fields})
+	fields["title"] = "changed"
+
+	if strings.Contains(renderToStringSelect(original), "data-select") {
+		t.Errorf("Original component should not be modified")
+	}
+	if strings.Contains(renderToStringSelect(modified), "changed") {
+		t.Errorf("Advanced config should not share the caller's fields map")
+	}
+}
@@ -0,0 +1,44 @@
+package components
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Purposes separate the signatures of the different kinds of tokens, so a
+// token signed for one use is never accepted by another sharing its secret
+const (
+	signingFlash  = "flash"
+	signingWizard = "wizard"
+	signingCSRF   = "csrf"
+)
+
+// signToken encodes payload as a URL-safe token signed with secret for
+// purpose
+func signToken(secret []byte, purpose string, payload []byte) string {
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + tokenSignature(secret, purpose, encoded)
+}
+
+// verifyToken returns the payload of a token created by signToken for
+// purpose and whether its signature is valid
+func verifyToken(secret []byte, purpose, token string) ([]byte, bool) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(tokenSignature(secret, purpose, encoded))) {
+		return nil, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	return payload, err == nil
+}
+
+// tokenSignature returns the HMAC-SHA256 of data prefixed with purpose
+func tokenSignature(secret []byte, purpose, data string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose))
+	// Purposes never contain a NUL, so the prefix cannot run into data
+	mac.Write([]byte{0})
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
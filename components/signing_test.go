@@ -0,0 +1,15 @@
+package components
+
+import "testing"
+
+func TestSignToken_Purpose(t *testing.T) {
+	secret := []byte("secret")
+	token := signToken(secret, signingFlash, []byte("payload"))
+
+	if payload, ok := verifyToken(secret, signingFlash, token); !ok || string(payload) != "payload" {
+		t.Errorf("Expected the token to verify, got %q, %v", payload, ok)
+	}
+	if _, ok := verifyToken(secret, signingWizard, token); ok {
+		t.Error("Expected a flash token to be rejected as a wizard token")
+	}
+}
@@ -54,42 +54,55 @@ func NewSkeleton(children ...g.Node) *SkeletonComponent {
 
 // WithShape sets the shape of the skeleton.
 func (s *SkeletonComponent) WithShape(shape SkeletonShape) *SkeletonComponent {
-	s.shape = &shape
-	return s
+	newSkeleton := s.copy()
+	newSkeleton.shape = &shape
+	return newSkeleton
 }
 
 // WithPulse adds pulse animation to the skeleton.
 func (s *SkeletonComponent) WithPulse() *SkeletonComponent {
-	s.pulse = true
-	return s
+	newSkeleton := s.copy()
+	newSkeleton.pulse = true
+	return newSkeleton
 }
 
 // WithWave adds wave animation to the skeleton.
 func (s *SkeletonComponent) WithWave() *SkeletonComponent {
-	s.wave = true
-	return s
+	newSkeleton := s.copy()
+	newSkeleton.wave = true
+	return newSkeleton
 }
 
 // With applies modifiers to the skeleton component.
 func (s *SkeletonComponent) With(children ...any) flyon.Component {
+	newSkeleton := s.copy()
 	for _, child := range children {
 		switch c := child.(type) {
 		case flyon.Color:
-			s.classes = append(s.classes, "skeleton-"+c.String())
+			newSkeleton.classes = append(newSkeleton.classes, "skeleton-"+c.String())
 		case flyon.Size:
-			s.classes = append(s.classes, "skeleton-"+c.String())
+			newSkeleton.classes = append(newSkeleton.classes, "skeleton-"+c.String())
 		case string:
-			s.classes = append(s.classes, c)
+			newSkeleton.classes = append(newSkeleton.classes, c)
 		case g.Node:
 			// Check if it's an attribute or content
 			if isAttribute(c) {
-				s.attributes = append(s.attributes, c)
+				newSkeleton.attributes = append(newSkeleton.attributes, c)
 			} else {
-				s.children = append(s.children, c)
+				newSkeleton.children = append(newSkeleton.children, c)
 			}
 		}
 	}
-	return s
+	return newSkeleton
+}
+
+// copy creates a deep copy of the skeleton component
+func (s *SkeletonComponent) copy() *SkeletonComponent {
+	newSkeleton := *s
+	newSkeleton.classes = append([]string(nil), s.classes...)
+	newSkeleton.attributes = append([]g.Node(nil), s.attributes...)
+	newSkeleton.children = append([]g.Node(nil), s.children...)
+	return &newSkeleton
 }
 
 // isAttribute checks if a node is an HTML attribute
@@ -72,30 +72,41 @@ func NewSpinner(children ...g.Node) *SpinnerComponent {
 
 // WithType sets the spinner type
 func (s *SpinnerComponent) WithType(spinnerType SpinnerType) *SpinnerComponent {
-	s.spinnerType = &spinnerType
-	return s
+	newSpinner := s.copy()
+	newSpinner.spinnerType = &spinnerType
+	return newSpinner
 }
 
 // With applies modifiers to the spinner component.
 func (s *SpinnerComponent) With(children ...any) flyon.Component {
+	newSpinner := s.copy()
 	for _, child := range children {
 		switch c := child.(type) {
 		case flyon.Color:
-			s.classes = append(s.classes, "loading-"+c.String())
+			newSpinner.classes = append(newSpinner.classes, "loading-"+c.String())
 		case flyon.Size:
-			s.classes = append(s.classes, "loading-"+c.String())
+			newSpinner.classes = append(newSpinner.classes, "loading-"+c.String())
 		case string:
-			s.classes = append(s.classes, c)
+			newSpinner.classes = append(newSpinner.classes, c)
 		case g.Node:
 			// Check if it's an attribute or content
 			if isAttribute(c) {
-				s.attributes = append(s.attributes, c)
+				newSpinner.attributes = append(newSpinner.attributes, c)
 			} else {
-				s.children = append(s.children, c)
+				newSpinner.children = append(newSpinner.children, c)
 			}
 		}
 	}
-	return s
+	return newSpinner
+}
+
+// copy creates a deep copy of the spinner component
+func (s *SpinnerComponent) copy() *SpinnerComponent {
+	newSpinner := *s
+	newSpinner.classes = append([]string(nil), s.classes...)
+	newSpinner.attributes = append([]g.Node(nil), s.attributes...)
+	newSpinner.children = append([]g.Node(nil), s.children...)
+	return &newSpinner
 }
 
 
@@ -0,0 +1,53 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// breakpointWidths are the minimum viewport widths of FlyonUI's breakpoints
+var breakpointWidths = map[string]int{"sm": 640, "md": 768, "lg": 1024, "xl": 1280, "2xl": 1536}
+
+// Srcset builds a srcset value offering an image at each of widths, urlFor
+// returning the URL of the image resized to a width, e.g.
+//
+//	Srcset(func(w int) string { return fmt.Sprintf("/img/cat.jpg?w=%d", w) }, 320, 640, 1280)
+func Srcset(urlFor func(width int) string, widths ...int) string {
+	widths = append([]int(nil), widths...)
+	sort.Ints(widths)
+	candidates := make([]string, 0, len(widths))
+	for _, width := range widths {
+		candidates = append(candidates, fmt.Sprintf("%s %dw", urlFor(width), width))
+	}
+	return strings.Join(candidates, ", ")
+}
+
+// Sizes builds a sizes value from the slot width of an image keyed by the
+// breakpoint from which it applies, e.g. {"": "100vw", "md": "50vw"}. The
+// empty breakpoint sets the width on the smallest screens, "100vw" if unset.
+// Unknown breakpoints are ignored.
+func Sizes(slots map[string]string) string {
+	type slot struct {
+		minWidth int
+		width    string
+	}
+	var conditions []slot
+	for breakpoint, width := range slots {
+		if minWidth, ok := breakpointWidths[breakpoint]; ok {
+			conditions = append(conditions, slot{minWidth, width})
+		}
+	}
+	// The browser uses the first matching condition, so widest first
+	sort.Slice(conditions, func(i, j int) bool { return conditions[i].minWidth > conditions[j].minWidth })
+
+	sizes := make([]string, 0, len(conditions)+1)
+	for _, condition := range conditions {
+		sizes = append(sizes, fmt.Sprintf("(min-width: %dpx) %s", condition.minWidth, condition.width))
+	}
+	fallback := slots[""]
+	if fallback == "" {
+		fallback = "100vw"
+	}
+	return strings.Join(append(sizes, fallback), ", ")
+}
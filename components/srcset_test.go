@@ -0,0 +1,43 @@
+package components
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSrcset(t *testing.T) {
+	urlFor := func(width int) string { return fmt.Sprintf("/img/cat.jpg?w=%d", width) }
+
+	got := Srcset(urlFor, 1280, 320, 640)
+	want := "/img/cat.jpg?w=320 320w, /img/cat.jpg?w=640 640w, /img/cat.jpg?w=1280 1280w"
+	if got != want {
+		t.Errorf("Srcset() = %q, want %q", got, want)
+	}
+	if got := Srcset(urlFor); got != "" {
+		t.Errorf("Srcset() without widths = %q, want empty", got)
+	}
+}
+
+func TestSizes(t *testing.T) {
+	tests := []struct {
+		name  string
+		slots map[string]string
+		want  string
+	}{
+		{"fallback only", map[string]string{"": "50vw"}, "50vw"},
+		{"default fallback", map[string]string{"md": "50vw"}, "(min-width: 768px) 50vw, 100vw"},
+		{
+			"widest first",
+			map[string]string{"": "100vw", "md": "50vw", "xl": "320px", "lg": "33vw"},
+			"(min-width: 1280px) 320px, (min-width: 1024px) 33vw, (min-width: 768px) 50vw, 100vw",
+		},
+		{"unknown breakpoint", map[string]string{"huge": "10vw"}, "100vw"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sizes(tt.slots); got != tt.want {
+				t.Errorf("Sizes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,29 +36,40 @@ func NewStats(children ...g.Node) *StatsComponent {
 
 // WithOrientation sets the orientation of the stats
 func (s *StatsComponent) WithOrientation(orientation StatsOrientation) *StatsComponent {
-	s.orientation = &orientation
-	return s
+	newStats := s.copy()
+	newStats.orientation = &orientation
+	return newStats
 }
 
 // With applies modifiers to the stats component
 func (s *StatsComponent) With(items ...any) flyon.Component {
+	newStats := s.copy()
 	for _, item := range items {
 		switch v := item.(type) {
 		case flyon.Color:
-			s.classes = append(s.classes, "stats-"+v.String())
+			newStats.classes = append(newStats.classes, "stats-"+v.String())
 		case flyon.Size:
-			s.classes = append(s.classes, "stats-"+v.String())
+			newStats.classes = append(newStats.classes, "stats-"+v.String())
 		case string:
-			s.classes = append(s.classes, v)
+			newStats.classes = append(newStats.classes, v)
 		case g.Node:
 			if isAttribute(v) {
-				s.attributes = append(s.attributes, v)
+				newStats.attributes = append(newStats.attributes, v)
 			} else {
-				s.children = append(s.children, v)
+				newStats.children = append(newStats.children, v)
 			}
 		}
 	}
-	return s
+	return newStats
+}
+
+// copy creates a deep copy of the stats component
+func (s *StatsComponent) copy() *StatsComponent {
+	newStats := *s
+	newStats.children = append([]g.Node(nil), s.children...)
+	newStats.classes = append([]string(nil), s.classes...)
+	newStats.attributes = append([]g.Node(nil), s.attributes...)
+	return &newStats
 }
 
 // Render renders the stats component
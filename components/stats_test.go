@@ -234,4 +234,33 @@ func TestStats_ComponentInterface(t *testing.T) {
 			t.Error("With method should return flyon.Component")
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestStats_CopyOnWrite(t *testing.T) {
+	parent := NewStats(h.Div(g.Text("Stat")))
+	// Spare capacity lets an aliasing copy write into the parent's arrays
+	parent.classes = append(make([]string, 0, 8), parent.classes...)
+	parent.attributes = append(make([]g.Node, 0, 8), parent.attributes...)
+	parent.children = append(make([]g.Node, 0, 8), parent.children...)
+	before := renderToHTML(parent)
+
+	first := parent.With("first-class", h.Title("first"), g.Text("first"))
+	second := parent.With("second-class", h.Title("second"), g.Text("second"))
+	_ = parent.WithOrientation(StatsVertical)
+
+	if html := renderToHTML(parent); html != before {
+		t.Errorf("Expected the parent to be unchanged, got %s", html)
+	}
+	firstHTML := renderToHTML(first)
+	for _, want := range []string{"first-class", `title="first"`, ">first<"} {
+		if !strings.Contains(firstHTML, want) {
+			t.Errorf("Expected %s in the first copy, got %s", want, firstHTML)
+		}
+	}
+	if strings.Contains(firstHTML, "second") {
+		t.Errorf("Expected the second copy not to leak into the first, got %s", firstHTML)
+	}
+	if html := renderToHTML(second); strings.Contains(html, "first") {
+		t.Errorf("Expected the first copy not to leak into the second, got %s", html)
+	}
+}
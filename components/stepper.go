@@ -0,0 +1,215 @@
+package components
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// StepState is the state of a stepper step
+type StepState string
+
+const (
+	StepPending   StepState = "pending"
+	StepActive    StepState = "active"
+	StepCompleted StepState = "completed"
+	StepError     StepState = "error"
+)
+
+// stepClass maps step states to the classes behind FlyonUI's stepper-* variants
+var stepClass = map[StepState]string{
+	StepActive:    "active",
+	StepCompleted: "success",
+	StepError:     "error",
+}
+
+// Step is a step of a StepperComponent. An empty State is derived from the
+// active step: steps before it are completed, steps after it pending.
+type Step struct {
+	Title       string
+	Description string
+	// Icon is an icon class shown instead of the step number, e.g. "icon-[tabler--user]"
+	Icon  string
+	State StepState
+}
+
+// StepperComponent represents a FlyonUI stepper showing progress through a
+// sequence of steps
+type StepperComponent struct {
+	id       string
+	steps    []Step
+	active   int
+	vertical bool
+	color    flyon.Color
+	classes  []string
+}
+
+// NewStepper creates a new stepper with the first step active
+func NewStepper(steps ...Step) *StepperComponent {
+	return &StepperComponent{
+		steps:   append([]Step(nil), steps...),
+		color:   flyon.Primary,
+		classes: make([]string, 0),
+	}
+}
+
+// WithID sets the stepper ID
+func (s *StepperComponent) WithID(id string) *StepperComponent {
+	newStepper := s.copy()
+	newStepper.id = id
+	return newStepper
+}
+
+// WithActive sets the zero-based index of the active step
+func (s *StepperComponent) WithActive(index int) *StepperComponent {
+	newStepper := s.copy()
+	newStepper.active = index
+	return newStepper
+}
+
+// WithStepState overrides the state of the step at index, e.g. to flag an error
+func (s *StepperComponent) WithStepState(index int, state StepState) *StepperComponent {
+	newStepper := s.copy()
+	if index >= 0 && index < len(newStepper.steps) {
+		newStepper.steps[index].State = state
+	}
+	return newStepper
+}
+
+// WithVertical stacks the steps vertically
+func (s *StepperComponent) WithVertical(vertical bool) *StepperComponent {
+	newStepper := s.copy()
+	newStepper.vertical = vertical
+	return newStepper
+}
+
+// WithColor sets the color of active and completed steps
+func (s *StepperComponent) WithColor(color flyon.Color) *StepperComponent {
+	newStepper := s.copy()
+	newStepper.color = color
+	return newStepper
+}
+
+// WithClasses adds additional CSS classes
+func (s *StepperComponent) WithClasses(classes ...string) *StepperComponent {
+	newStepper := s.copy()
+	newStepper.classes = append(newStepper.classes, classes...)
+	return newStepper
+}
+
+// With applies modifiers to the stepper
+func (s *StepperComponent) With(modifiers ...any) flyon.Component {
+	newStepper := s.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newStepper.color = m
+		case string:
+			newStepper.classes = append(newStepper.classes, m)
+		}
+	}
+	return newStepper
+}
+
+// copy creates a deep copy of the stepper
+func (s *StepperComponent) copy() *StepperComponent {
+	newStepper := *s
+	newStepper.steps = append([]Step(nil), s.steps...)
+	newStepper.classes = make([]string, len(s.classes))
+	copy(newStepper.classes, s.classes)
+	return &newStepper
+}
+
+// stateOf returns the state of the step at index
+func (s *StepperComponent) stateOf(index int) StepState {
+	if state := s.steps[index].State; state != "" {
+		return state
+	}
+	switch {
+	case index < s.active:
+		return StepCompleted
+	case index == s.active:
+		return StepActive
+	}
+	return StepPending
+}
+
+// Render generates the HTML for the stepper
+func (s *StepperComponent) Render(w io.Writer) error {
+	listClasses := []string{"relative", "flex", "flex-row", "gap-x-2"}
+	if s.vertical {
+		listClasses = []string{"relative", "flex", "flex-col"}
+	}
+
+	items := make([]g.Node, len(s.steps))
+	for i := range s.steps {
+		items[i] = s.item(i)
+	}
+
+	return h.Ul(
+		g.If(s.id != "", h.ID(s.id)),
+		h.Class(strings.Join(append(listClasses, s.classes...), " ")),
+		g.Attr("aria-label", "Progress"),
+		g.Group(items),
+	).Render(w)
+}
+
+// item renders the step at index
+func (s *StepperComponent) item(index int) g.Node {
+	step := s.steps[index]
+	state := s.stateOf(index)
+	color := s.color.String()
+
+	itemClasses := "group flex flex-1 shrink basis-0 items-center gap-x-2"
+	if s.vertical {
+		itemClasses = "group flex gap-x-3"
+	}
+	if class := stepClass[state]; class != "" {
+		itemClasses += " " + class
+	}
+
+	label := g.Text(strconv.Itoa(index + 1))
+	if step.Icon != "" {
+		label = h.Span(h.Class(step.Icon+" size-4 shrink-0"), g.Attr("aria-hidden", "true"))
+	}
+	indicator := h.Span(
+		h.Class("stepper-active:text-bg-"+color+" stepper-success:text-bg-"+color+" stepper-error:text-bg-error text-bg-soft-neutral flex size-7.5 shrink-0 items-center justify-center rounded-full text-sm font-medium"),
+		h.Span(h.Class("stepper-success:hidden stepper-error:hidden"), label),
+		h.Span(h.Class("icon-[tabler--check] stepper-success:block hidden size-4 shrink-0"), g.Attr("aria-hidden", "true")),
+		h.Span(h.Class("icon-[tabler--x] stepper-error:block hidden size-4 shrink-0"), g.Attr("aria-hidden", "true")),
+	)
+	text := h.Span(
+		h.Class("flex flex-col"),
+		h.Span(h.Class("text-base-content text-sm font-medium"), g.Text(step.Title)),
+		g.If(step.Description != "", h.Span(h.Class("text-base-content/60 text-xs"), g.Text(step.Description))),
+		g.If(state == StepCompleted || state == StepError, h.Span(h.Class("sr-only"), g.Text(" ("+string(state)+")"))),
+	)
+
+	attrs := []g.Node{
+		h.Class(itemClasses),
+		g.Attr("data-stepper-nav-item", `{"index":`+strconv.Itoa(index+1)+`}`),
+		g.If(state == StepActive, g.Attr("aria-current", "step")),
+	}
+	if s.vertical {
+		return h.Li(append(attrs,
+			h.Div(
+				h.Class("flex flex-col items-center gap-y-1"),
+				indicator,
+				h.Div(h.Class("bg-base-content/20 stepper-success:bg-"+color+" min-h-6 w-px flex-1 group-last:hidden")),
+			),
+			h.Div(h.Class("pb-5"), text),
+		)...)
+	}
+	return h.Li(append(attrs,
+		h.Span(h.Class("min-h-7.5 min-w-7.5 inline-flex items-center gap-x-2 align-middle"), indicator, text),
+		h.Div(h.Class("bg-base-content/20 stepper-success:bg-"+color+" h-px w-full flex-1 group-last:hidden")),
+	)...)
+}
+
+// Ensure StepperComponent implements flyon.Component
+var _ flyon.Component = (*StepperComponent)(nil)
@@ -0,0 +1,61 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStepperComponent_Render(t *testing.T) {
+	stepper := NewStepper(
+		Step{Title: "Account", Description: "Create your account"},
+		Step{Title: "Profile", Icon: "icon-[tabler--user]"},
+		Step{Title: "Review"},
+	).WithActive(1)
+	html := renderToStringInput(stepper)
+
+	for _, want := range []string{
+		`aria-label="Progress"`,
+		`data-stepper-nav-item="{&#34;index&#34;:1}"`,
+		`data-stepper-nav-item="{&#34;index&#34;:3}"`,
+		`Create your account`,
+		`icon-[tabler--user]`,
+		`stepper-active:text-bg-primary`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in stepper, got: %s", want, html)
+		}
+	}
+	if strings.Count(html, `aria-current="step"`) != 1 {
+		t.Errorf("Expected exactly one current step, got: %s", html)
+	}
+	if !strings.Contains(html, "items-center gap-x-2 success") || !strings.Contains(html, "items-center gap-x-2 active") {
+		t.Errorf("Expected completed and active step classes, got: %s", html)
+	}
+}
+
+func TestStepperComponent_States(t *testing.T) {
+	stepper := NewStepper(Step{Title: "One"}, Step{Title: "Two"}).WithStepState(0, StepError).WithStepState(5, StepError)
+	html := renderToStringInput(stepper)
+	if !strings.Contains(html, "items-center gap-x-2 error") {
+		t.Errorf("Expected error step class, got: %s", html)
+	}
+	if !strings.Contains(html, "(error)") {
+		t.Errorf("Expected the error state to be announced, got: %s", html)
+	}
+
+	vertical := renderToStringInput(NewStepper(Step{Title: "One"}).WithVertical(true))
+	if !strings.Contains(vertical, "flex-col") {
+		t.Errorf("Expected vertical stepper, got: %s", vertical)
+	}
+}
+
+func TestStepperComponent_Immutability(t *testing.T) {
+	original := NewStepper(Step{Title: "One"}, Step{Title: "Two"})
+	modified := original.WithStepState(1, StepError)
+	if original.steps[1].State != "" {
+		t.Error("Expected WithStepState to leave the original stepper unchanged")
+	}
+	if modified.steps[1].State != StepError {
+		t.Error("Expected WithStepState to set the state")
+	}
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/ozanturksever/gomponents-flyonui/flyon"
@@ -63,6 +64,16 @@ type TabItem struct {
 	Label   string
 	Content gomponents.Node
 	Active  bool
+	// Icon is an icon class shown before the label, e.g. "icon-[tabler--user]".
+	Icon string
+	// Disabled tabs cannot be activated.
+	Disabled bool
+	// Lazy panels are filled on first activation, Content being shown until
+	// then. The content is fetched as HTML from LazyURL, or produced by the
+	// loader registered for the tab ID with wasm.RegisterTabLoader when
+	// LazyURL is empty.
+	Lazy    bool
+	LazyURL string
 }
 
 // TabsComponent represents a tabs component with multiple tab items.
@@ -73,6 +84,9 @@ type TabsComponent struct {
 	size     TabsSize
 	color    flyon.Color
 	classes  []string
+	vertical bool
+	active   string  // Tab ID overriding the Active flags of the items
+	sync     *string // Query parameter mirroring the active tab, "" for the hash
 }
 
 // NewTabs creates a new tabs component with the given tab items.
@@ -115,6 +129,44 @@ func (tc *TabsComponent) WithColor(color flyon.Color) *TabsComponent {
 	return newTC
 }
 
+// WithVertical stacks the tabs vertically beside their panels.
+func (tc *TabsComponent) WithVertical(vertical bool) *TabsComponent {
+	newTC := tc.copy()
+	newTC.vertical = vertical
+	return newTC
+}
+
+// WithActive activates the tab with the given ID instead of the tab marked
+// Active. Unknown or disabled tabs are ignored.
+func (tc *TabsComponent) WithActive(id string) *TabsComponent {
+	newTC := tc.copy()
+	newTC.active = id
+	return newTC
+}
+
+// WithURLSync keeps the active tab in the URL while the user switches tabs,
+// in the query parameter param or in the hash when param is empty, so the
+// tab survives reloads and can be linked to. Requires the wasm package.
+func (tc *TabsComponent) WithURLSync(param string) *TabsComponent {
+	newTC := tc.copy()
+	newTC.sync = &param
+	return newTC
+}
+
+// WithActiveFromRequest activates the tab named by the query parameter param
+// of r and keeps it in sync with the URL as in WithURLSync. Browsers do not
+// send the hash to the server, so with an empty param the tab named by the
+// hash is activated on hydration instead.
+func (tc *TabsComponent) WithActiveFromRequest(r *http.Request, param string) *TabsComponent {
+	newTC := tc.WithURLSync(param)
+	if r != nil && param != "" {
+		if id := r.URL.Query().Get(param); id != "" {
+			newTC.active = id
+		}
+	}
+	return newTC
+}
+
 // WithClasses adds additional CSS classes to the tabs component.
 func (tc *TabsComponent) WithClasses(classes ...string) *TabsComponent {
 	newTC := tc.copy()
@@ -159,15 +211,39 @@ func (tc *TabsComponent) copy() *TabsComponent {
 	copy(newClasses, tc.classes)
 	
 	return &TabsComponent{
-		id:      tc.id,
-		tabs:    newTabs,
-		variant: tc.variant,
-		size:    tc.size,
-		color:   tc.color,
-		classes: newClasses,
+		id:       tc.id,
+		tabs:     newTabs,
+		variant:  tc.variant,
+		size:     tc.size,
+		color:    tc.color,
+		classes:  newClasses,
+		vertical: tc.vertical,
+		active:   tc.active,
+		sync:     tc.sync,
 	}
 }
 
+// activeIndex returns the index of the active tab: the one selected with
+// WithActive, else the first marked Active, else the first enabled one
+func (tc *TabsComponent) activeIndex() int {
+	for i, tab := range tc.tabs {
+		if tc.active != "" && tab.ID == tc.active && !tab.Disabled {
+			return i
+		}
+	}
+	for i, tab := range tc.tabs {
+		if tab.Active && !tab.Disabled {
+			return i
+		}
+	}
+	for i, tab := range tc.tabs {
+		if !tab.Disabled {
+			return i
+		}
+	}
+	return -1
+}
+
 // Render renders the tabs component to HTML.
 func (tc *TabsComponent) Render(w io.Writer) error {
 	// Build CSS classes
@@ -180,56 +256,97 @@ func (tc *TabsComponent) Render(w io.Writer) error {
 	if tc.size != TabsSizeMedium {
 		classes = append(classes, tc.size.String())
 	}
-	
+
+	orientation := "horizontal"
+	if tc.vertical {
+		classes = append(classes, "tabs-vertical")
+		orientation = "vertical"
+	}
+
 	classes = append(classes, tc.classes...)
-	
-	// Create tab navigation
+
+	active := tc.activeIndex()
+
+	// Create tab navigation, wired to the panels for FlyonUI's HSTabs
 	tabNavItems := make([]gomponents.Node, 0, len(tc.tabs))
-	for _, tab := range tc.tabs {
-		tabClasses := []string{"tab"}
-		if tab.Active {
-			tabClasses = append(tabClasses, "tab-active")
+	for i, tab := range tc.tabs {
+		tabClasses := []string{"tab", "active-tab:tab-active"}
+		if i == active {
+			tabClasses = append(tabClasses, "active")
 		}
-		
-		tabNavItems = append(tabNavItems, h.A(
+		if tab.Icon != "" {
+			tabClasses = append(tabClasses, "gap-2")
+		}
+
+		selected, tabIndex := "false", "-1"
+		if i == active {
+			selected, tabIndex = "true", "0"
+		}
+
+		tabNavItems = append(tabNavItems, h.Button(
+			h.Type("button"),
+			h.ID(tab.ID+"-item"),
 			h.Class(strings.Join(tabClasses, " ")),
-			h.Href("#"+tab.ID),
-			gomponents.Attr("data-tab-id", tab.ID),
+			gomponents.Attr("data-tab", "#"+tab.ID),
+			h.Aria("controls", tab.ID),
+			h.Role("tab"),
+			h.Aria("selected", selected),
+			gomponents.Attr("tabindex", tabIndex),
+			gomponents.If(tab.Disabled, h.Disabled()),
+			gomponents.If(tab.Icon != "", h.Span(h.Class(tab.Icon+" size-5 shrink-0"), h.Aria("hidden", "true"))),
 			gomponents.Text(tab.Label),
 		))
 	}
 	
 	// Create tab content panels
 	tabContentItems := make([]gomponents.Node, 0, len(tc.tabs))
-	for _, tab := range tc.tabs {
+	for i, tab := range tc.tabs {
 		contentClasses := []string{"tab-content"}
-		if !tab.Active {
+		if i != active {
 			contentClasses = append(contentClasses, "hidden")
 		}
 		
 		tabContentItems = append(tabContentItems, h.Div(
 			h.ID(tab.ID),
 			h.Class(strings.Join(contentClasses, " ")),
+			h.Role("tabpanel"),
+			h.Aria("labelledby", tab.ID+"-item"),
+			gomponents.Attr("tabindex", "0"),
 			gomponents.Attr("data-tab-panel", tab.ID),
+			gomponents.If(tab.Lazy, gomponents.Attr("data-tab-lazy", tab.LazyURL)),
+			gomponents.If(tab.Lazy, h.Aria("busy", "true")),
 			tab.Content,
 		))
 	}
+
+	containerClasses, contentContainerClasses := "tabs-container", "tab-content-container"
+	if tc.vertical {
+		containerClasses, contentContainerClasses = "tabs-container flex", "tab-content-container ms-3 grow"
+	}
+
+	var syncAttr gomponents.Node
+	if tc.sync != nil {
+		syncAttr = gomponents.Attr("data-tab-sync", *tc.sync)
+	}
 	
 	// Render the complete tabs component
 	tabsContainer := h.Div(
 		h.ID(tc.id),
-		h.Class("tabs-container"),
+		h.Class(containerClasses),
 		gomponents.Attr("data-component", "tabs"),
-		
+		syncAttr,
+
 		// Tab navigation
-		h.Div(
+		h.Nav(
 			h.Class(strings.Join(classes, " ")),
+			h.Role("tablist"),
+			h.Aria("orientation", orientation),
 			gomponents.Group(tabNavItems),
 		),
 		
 		// Tab content
 		h.Div(
-			h.Class("tab-content-container"),
+			h.Class(contentContainerClasses),
 			gomponents.Group(tabContentItems),
 		),
 	)
@@ -255,4 +372,20 @@ func NewActiveTabItem(id, label string, content gomponents.Node) TabItem {
 		Content: content,
 		Active:  true,
 	}
-}
\ No newline at end of file
+}
+
+// NewLazyTabItem creates a tab item whose panel is filled on first
+// activation with HTML fetched from url, or by the loader registered with
+// wasm.RegisterTabLoader when url is empty. Placeholder is shown until then.
+func NewLazyTabItem(id, label, url string, placeholder gomponents.Node) TabItem {
+	return TabItem{
+		ID:      id,
+		Label:   label,
+		Content: placeholder,
+		Lazy:    true,
+		LazyURL: url,
+	}
+}
+
+// Ensure TabsComponent implements flyon.Component
+var _ flyon.Component = (*TabsComponent)(nil)
@@ -1,6 +1,8 @@
 package components
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -229,23 +231,23 @@ func TestTabsComponent_Render(t *testing.T) {
 	if !strings.Contains(html, `class="tabs"`) {
 		t.Error("Missing tabs class")
 	}
-	if !strings.Contains(html, `class="tab tab-active"`) {
+	if !strings.Contains(html, `role="tablist"`) {
+		t.Error("Missing tablist role")
+	}
+	if !strings.Contains(html, `class="tab active-tab:tab-active active"`) {
 		t.Error("Missing active tab class")
 	}
-	if !strings.Contains(html, `class="tab"`) {
+	if !strings.Contains(html, `class="tab active-tab:tab-active"`) {
 		t.Error("Missing tab class")
 	}
-	if !strings.Contains(html, `href="#tab1"`) {
-		t.Error("Missing tab1 href")
-	}
-	if !strings.Contains(html, `href="#tab2"`) {
-		t.Error("Missing tab2 href")
+	if !strings.Contains(html, `data-tab="#tab1" aria-controls="tab1" role="tab" aria-selected="true" tabindex="0"`) {
+		t.Error("Missing tab1 wiring")
 	}
-	if !strings.Contains(html, `data-tab-id="tab1"`) {
-		t.Error("Missing tab1 data-tab-id")
+	if !strings.Contains(html, `data-tab="#tab2" aria-controls="tab2" role="tab" aria-selected="false" tabindex="-1"`) {
+		t.Error("Missing tab2 wiring")
 	}
-	if !strings.Contains(html, `data-tab-id="tab2"`) {
-		t.Error("Missing tab2 data-tab-id")
+	if !strings.Contains(html, `id="tab1-item"`) {
+		t.Error("Missing tab1 trigger ID")
 	}
 
 	// Check tab content
@@ -264,6 +266,9 @@ func TestTabsComponent_Render(t *testing.T) {
 	if !strings.Contains(html, `class="tab-content hidden"`) {
 		t.Error("Missing hidden tab-content class for inactive tab")
 	}
+	if !strings.Contains(html, `role="tabpanel" aria-labelledby="tab2-item"`) {
+		t.Error("Missing tab2 panel labelling")
+	}
 	if !strings.Contains(html, `data-tab-panel="tab1"`) {
 		t.Error("Missing tab1 data-tab-panel")
 	}
@@ -387,4 +392,103 @@ func TestTabsComponent_Immutability(t *testing.T) {
 	if len(original.classes) != originalClassesLen {
 		t.Error("Original classes were modified")
 	}
-}
\ No newline at end of file
+}
+
+func TestTabsComponent_RenderDefaultsToFirstEnabledTab(t *testing.T) {
+	disabled := NewTabItem("tab1", "Tab 1", gomponents.Text("Content 1"))
+	disabled.Disabled = true
+	tabs := NewTabs(disabled, NewTabItem("tab2", "Tab 2", gomponents.Text("Content 2")))
+
+	var buf strings.Builder
+	if err := tabs.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `aria-selected="false" tabindex="-1" disabled`) {
+		t.Error("Missing disabled tab")
+	}
+	if !strings.Contains(html, `id="tab2-item" class="tab active-tab:tab-active active"`) {
+		t.Error("Expected the first enabled tab to be active")
+	}
+}
+
+func TestTabsComponent_RenderVerticalWithIcons(t *testing.T) {
+	tab := NewActiveTabItem("tab1", "Profile", gomponents.Text("Content 1"))
+	tab.Icon = "icon-[tabler--user]"
+	tabs := NewTabs(tab).WithVertical(true)
+
+	var buf strings.Builder
+	if err := tabs.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `class="tabs tabs-vertical" role="tablist" aria-orientation="vertical"`) {
+		t.Error("Missing vertical tablist")
+	}
+	if !strings.Contains(html, `class="tabs-container flex"`) {
+		t.Error("Missing vertical layout")
+	}
+	if !strings.Contains(html, `<span class="icon-[tabler--user] size-5 shrink-0" aria-hidden="true"></span>Profile`) {
+		t.Error("Missing tab icon")
+	}
+}
+
+func TestTabsComponent_WithActiveFromRequest(t *testing.T) {
+	tab1 := NewActiveTabItem("tab1", "Tab 1", gomponents.Text("Content 1"))
+	tab2 := NewTabItem("tab2", "Tab 2", gomponents.Text("Content 2"))
+
+	tests := []struct {
+		name   string
+		target string
+		want   string
+	}{
+		{"query parameter", "/settings?tab=tab2", "tab2"},
+		{"unknown tab", "/settings?tab=missing", "tab1"},
+		{"no selection", "/settings", "tab1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			tabs := NewTabs(tab1, tab2).WithActiveFromRequest(r, "tab")
+
+			var buf strings.Builder
+			if err := tabs.Render(&buf); err != nil {
+				t.Fatalf("Render failed: %v", err)
+			}
+
+			html := buf.String()
+			if !strings.Contains(html, `id="`+tt.want+`-item" class="tab active-tab:tab-active active"`) {
+				t.Errorf("Expected %s to be active", tt.want)
+			}
+			if !strings.Contains(html, `data-tab-sync="tab"`) {
+				t.Error("Missing URL sync attribute")
+			}
+		})
+	}
+}
+
+func TestTabsComponent_RenderLazyPanel(t *testing.T) {
+	tabs := NewTabs(
+		NewActiveTabItem("tab1", "Tab 1", gomponents.Text("Content 1")),
+		NewLazyTabItem("tab2", "Tab 2", "/tabs/reports", gomponents.Text("Loading")),
+		NewLazyTabItem("tab3", "Tab 3", "", gomponents.Text("Loading")),
+	)
+
+	var buf strings.Builder
+	if err := tabs.Render(&buf); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `data-tab-panel="tab2" data-tab-lazy="/tabs/reports" aria-busy="true">Loading`) {
+		t.Error("Missing lazy URL panel")
+	}
+	if !strings.Contains(html, `data-tab-panel="tab3" data-tab-lazy="" aria-busy="true"`) {
+		t.Error("Missing lazy Go loader panel")
+	}
+	if strings.Contains(html, `data-tab-panel="tab1" data-tab-lazy`) {
+		t.Error("Eager panel should not be lazy")
+	}
+}
@@ -0,0 +1,224 @@
+package components
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// tagsInputData is the data-tags-input configuration read by the WASM behavior
+type tagsInputData struct {
+	Max             int  `json:"max,omitempty"`
+	SuggestionsOnly bool `json:"suggestionsOnly,omitempty"`
+}
+
+// TagsInputComponent represents an input that turns typed text into removable
+// chips. Every tag is submitted as a hidden input with the component's name,
+// so handlers read them with r.Form[name].
+type TagsInputComponent struct {
+	id              string
+	name            string
+	placeholder     string
+	tags            []string
+	suggestions     []string
+	suggestionsOnly bool
+	maxTags         int
+	disabled        bool
+	color           flyon.Color
+	classes         []string
+}
+
+// NewTagsInput creates a new tags input component
+func NewTagsInput() *TagsInputComponent {
+	return &TagsInputComponent{
+		color:   flyon.Primary,
+		classes: make([]string, 0),
+	}
+}
+
+// WithID sets the ID of the text field
+func (t *TagsInputComponent) WithID(id string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.id = id
+	return newTags
+}
+
+// WithName sets the name the tags are submitted under
+func (t *TagsInputComponent) WithName(name string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.name = name
+	return newTags
+}
+
+// WithPlaceholder sets the placeholder of the text field
+func (t *TagsInputComponent) WithPlaceholder(placeholder string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.placeholder = placeholder
+	return newTags
+}
+
+// WithTags sets the initial tags
+func (t *TagsInputComponent) WithTags(tags ...string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.tags = append([]string(nil), tags...)
+	return newTags
+}
+
+// WithSuggestions sets the values offered while typing
+func (t *TagsInputComponent) WithSuggestions(suggestions ...string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.suggestions = append([]string(nil), suggestions...)
+	return newTags
+}
+
+// WithSuggestionsOnly restricts tags to the suggestions
+func (t *TagsInputComponent) WithSuggestionsOnly(only bool) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.suggestionsOnly = only
+	return newTags
+}
+
+// WithMaxTags limits the number of tags; zero means no limit
+func (t *TagsInputComponent) WithMaxTags(max int) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.maxTags = max
+	return newTags
+}
+
+// WithDisabled sets the tags input disabled state
+func (t *TagsInputComponent) WithDisabled(disabled bool) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.disabled = disabled
+	return newTags
+}
+
+// WithColor sets the color of the chips and the field
+func (t *TagsInputComponent) WithColor(color flyon.Color) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.color = color
+	return newTags
+}
+
+// WithClasses adds additional CSS classes to the wrapper
+func (t *TagsInputComponent) WithClasses(classes ...string) *TagsInputComponent {
+	newTags := t.copy()
+	newTags.classes = append(newTags.classes, classes...)
+	return newTags
+}
+
+// With applies modifiers to the tags input
+func (t *TagsInputComponent) With(modifiers ...any) flyon.Component {
+	newTags := t.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newTags.color = m
+		case string:
+			newTags.classes = append(newTags.classes, m)
+		}
+	}
+	return newTags
+}
+
+// copy creates a deep copy of the tags input component
+func (t *TagsInputComponent) copy() *TagsInputComponent {
+	newTags := *t
+	newTags.tags = append([]string(nil), t.tags...)
+	newTags.suggestions = append([]string(nil), t.suggestions...)
+	newTags.classes = make([]string, len(t.classes))
+	copy(newTags.classes, t.classes)
+	return &newTags
+}
+
+// Render generates the HTML for the tags input
+func (t *TagsInputComponent) Render(w io.Writer) error {
+	id := t.id
+	if id == "" {
+		id = "tags-" + generateID()
+	}
+	tags := NormalizeTags(t.tags, t.maxTags)
+	config, _ := json.Marshal(tagsInputData{Max: t.maxTags, SuggestionsOnly: t.suggestionsOnly})
+
+	wrapper := NewInput().WithColor(t.color).
+		WithClasses(append([]string{"h-auto", "min-h-10", "flex-wrap", "items-center", "gap-1.5", "py-1.5"}, t.classes...)...)
+
+	nodes := []g.Node{
+		h.Class(strings.Join(wrapper.classNames(), " ")),
+		g.Attr("data-tags-input", string(config)),
+	}
+	for _, tag := range tags {
+		nodes = append(nodes, t.tag(tag))
+	}
+	nodes = append(nodes, h.Input(
+		h.Type("text"),
+		h.ID(id),
+		h.Class("min-w-24 grow"),
+		g.If(t.placeholder != "", h.Placeholder(t.placeholder)),
+		g.If(len(t.suggestions) > 0, g.Attr("list", id+"-suggestions")),
+		h.AutoComplete("off"),
+		g.If(t.disabled, h.Disabled()),
+		g.Attr("data-tags-input-field", ""),
+	))
+	if len(t.suggestions) > 0 {
+		options := make([]g.Node, len(t.suggestions))
+		for i, suggestion := range t.suggestions {
+			options[i] = h.Option(h.Value(suggestion))
+		}
+		nodes = append(nodes, h.DataList(h.ID(id+"-suggestions"), g.Group(options)))
+	}
+	nodes = append(nodes, h.Template(g.Attr("data-tags-input-template", ""), t.tag("")))
+
+	return h.Div(nodes...).Render(w)
+}
+
+// tag renders a chip with its remove button and hidden form value
+func (t *TagsInputComponent) tag(tag string) g.Node {
+	return h.Span(
+		h.Class("badge badge-"+t.color.String()+" badge-soft gap-1"),
+		g.Attr("data-tags-input-tag", tag),
+		h.Span(g.Attr("data-tags-input-label", ""), g.Text(tag)),
+		g.If(!t.disabled, h.Button(
+			h.Type("button"),
+			h.Class("cursor-pointer"),
+			g.Attr("aria-label", "Remove "+tag),
+			g.Attr("data-tags-input-remove", ""),
+			h.Span(h.Class("icon-[tabler--x] size-3.5 shrink-0"), g.Attr("aria-hidden", "true")),
+		)),
+		g.If(t.name != "", h.Input(h.Type("hidden"), h.Name(t.name), h.Value(tag))),
+	)
+}
+
+// SplitTags splits typed or pasted text into tags at commas and line breaks
+func SplitTags(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ';'
+	})
+}
+
+// NormalizeTags trims tags, drops empty ones and case-insensitive duplicates,
+// and keeps at most max tags when max is positive
+func NormalizeTags(tags []string, max int) []string {
+	seen := make(map[string]bool, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), " ")
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		if max > 0 && len(result) == max {
+			break
+		}
+		seen[key] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// Ensure TagsInputComponent implements flyon.Component
+var _ flyon.Component = (*TagsInputComponent)(nil)
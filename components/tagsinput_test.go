@@ -0,0 +1,56 @@
+package components
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTagsInputComponent_Render(t *testing.T) {
+	tags := NewTagsInput().WithID("skills").WithName("skills").WithTags("go", "Go", " wasm ").
+		WithSuggestions("templ", "htmx").WithMaxTags(5).WithPlaceholder("Add skill")
+	html := renderToStringInput(tags)
+
+	if got := strings.Count(html, `<input type="hidden" name="skills"`); got != 3 {
+		t.Errorf("Expected two tags plus the template to carry hidden inputs, got %d: %s", got, html)
+	}
+	for _, want := range []string{
+		`data-tags-input="{&#34;max&#34;:5}"`,
+		`data-tags-input-tag="go"`,
+		`data-tags-input-tag="wasm"`,
+		`aria-label="Remove wasm"`,
+		`id="skills"`,
+		`placeholder="Add skill"`,
+		`list="skills-suggestions"`,
+		`<datalist id="skills-suggestions"><option value="templ"></option><option value="htmx"></option></datalist>`,
+		`<template data-tags-input-template="">`,
+		`badge badge-primary badge-soft`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in tags input, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, `data-tags-input-tag="Go"`) {
+		t.Errorf("Expected case-insensitive duplicates to be dropped, got: %s", html)
+	}
+}
+
+func TestTagsInputComponent_Disabled(t *testing.T) {
+	html := renderToStringInput(NewTagsInput().WithTags("go").WithDisabled(true).WithSuggestionsOnly(true))
+	if strings.Contains(html, "data-tags-input-remove") || !strings.Contains(html, "disabled") {
+		t.Errorf("Expected no remove buttons and a disabled field, got: %s", html)
+	}
+	if !strings.Contains(html, `&#34;suggestionsOnly&#34;:true`) {
+		t.Errorf("Expected suggestionsOnly in the configuration, got: %s", html)
+	}
+}
+
+func TestSplitAndNormalizeTags(t *testing.T) {
+	split := SplitTags("go, wasm;\nhtmx,,")
+	if got := NormalizeTags(split, 0); !reflect.DeepEqual(got, []string{"go", "wasm", "htmx"}) {
+		t.Errorf("Expected split and trimmed tags, got %v", got)
+	}
+	if got := NormalizeTags([]string{"a", "A", "b  c", "d"}, 2); !reflect.DeepEqual(got, []string{"a", "b c"}) {
+		t.Errorf("Expected deduplicated tags capped at 2, got %v", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"unicode/utf16"
 
 	"github.com/ozanturksever/gomponents-flyonui/flyon"
 	"maragu.dev/gomponents"
@@ -27,6 +28,12 @@ type TextareaComponent struct {
 	colorSet    bool
 	sizeSet     bool
 	classes     []string
+	maxLength   int
+	minLength   int
+	counter     bool
+	minRows     int
+	maxRows     int
+	markdown    bool
 }
 
 // NewTextarea creates a new textarea component
@@ -102,6 +109,46 @@ func (t *TextareaComponent) WithRequired(required bool) *TextareaComponent {
 	return new
 }
 
+// WithMaxLength limits the number of characters
+func (t *TextareaComponent) WithMaxLength(length int) *TextareaComponent {
+	new := t.copy()
+	new.maxLength = length
+	return new
+}
+
+// WithMinLength sets the minimum number of characters
+func (t *TextareaComponent) WithMinLength(length int) *TextareaComponent {
+	new := t.copy()
+	new.minLength = length
+	return new
+}
+
+// WithCounter shows a live character counter such as "120/500" below the
+// textarea. It turns to the error color near the maximum length.
+func (t *TextareaComponent) WithCounter(counter bool) *TextareaComponent {
+	new := t.copy()
+	new.counter = counter
+	return new
+}
+
+// WithAutoGrow grows the textarea with its content between minRows and
+// maxRows; it scrolls beyond maxRows
+func (t *TextareaComponent) WithAutoGrow(minRows, maxRows int) *TextareaComponent {
+	new := t.copy()
+	new.minRows = minRows
+	new.maxRows = maxRows
+	return new
+}
+
+// WithMarkdown adds a formatting toolbar (bold, italic, link, list) that
+// edits the value as markdown. The textarea still submits the plain markdown
+// under its name.
+func (t *TextareaComponent) WithMarkdown(markdown bool) *TextareaComponent {
+	new := t.copy()
+	new.markdown = markdown
+	return new
+}
+
 // WithColor sets the color variant
 func (t *TextareaComponent) WithColor(color flyon.Color) *TextareaComponent {
 	new := t.copy()
@@ -163,6 +210,12 @@ func (t *TextareaComponent) copy() *TextareaComponent {
 		colorSet:    t.colorSet,
 		sizeSet:     t.sizeSet,
 		classes:     make([]string, len(t.classes)),
+		maxLength:   t.maxLength,
+		minLength:   t.minLength,
+		counter:     t.counter,
+		minRows:     t.minRows,
+		maxRows:     t.maxRows,
+		markdown:    t.markdown,
 	}
 	copy(new.classes, t.classes)
 	return new
@@ -170,6 +223,29 @@ func (t *TextareaComponent) copy() *TextareaComponent {
 
 // Render implements the gomponents.Node interface
 func (t *TextareaComponent) Render(w io.Writer) error {
+	if !t.counter && !t.markdown {
+		return t.textarea().Render(w)
+	}
+
+	id := t.id
+	if id == "" {
+		id = "textarea-" + generateID()
+	}
+	textarea := t.WithID(id)
+	if t.markdown {
+		textarea = textarea.WithClasses("rounded-t-none")
+	}
+
+	return h.Div(
+		h.Class("w-full"),
+		g.If(t.markdown, textarea.markdownToolbar()),
+		textarea.textarea(),
+		g.If(t.counter, textarea.counterNode()),
+	).Render(w)
+}
+
+// textarea renders the textarea element
+func (t *TextareaComponent) textarea() gomponents.Node {
 	// Build CSS classes
 	classes := []string{"textarea"}
 	
@@ -182,6 +258,11 @@ func (t *TextareaComponent) Render(w io.Writer) error {
 	if t.sizeSet {
 		classes = append(classes, "textarea-"+t.size.String())
 	}
+
+	// Auto-grown textareas are sized by their content
+	if t.autoGrow() {
+		classes = append(classes, "resize-none")
+	}
 	
 	// Add additional classes
 	classes = append(classes, t.classes...)
@@ -207,14 +288,31 @@ func (t *TextareaComponent) Render(w io.Writer) error {
 	}
 	
 	// Add rows if set
-	if t.rows > 0 {
-		attrs = append(attrs, g.Attr("rows", strconv.Itoa(t.rows)))
+	if rows := t.initialRows(); rows > 0 {
+		attrs = append(attrs, g.Attr("rows", strconv.Itoa(rows)))
 	}
 	
 	// Add cols if set
 	if t.cols > 0 {
 		attrs = append(attrs, g.Attr("cols", strconv.Itoa(t.cols)))
 	}
+
+	// Add length limits if set
+	if t.maxLength > 0 {
+		attrs = append(attrs, g.Attr("maxlength", strconv.Itoa(t.maxLength)))
+	}
+
+	if t.minLength > 0 {
+		attrs = append(attrs, g.Attr("minlength", strconv.Itoa(t.minLength)))
+	}
+
+	if t.autoGrow() {
+		attrs = append(attrs, g.Attr("data-textarea-autogrow", strconv.Itoa(t.minRows)+","+strconv.Itoa(t.maxRows)))
+	}
+
+	if t.counter {
+		attrs = append(attrs, g.Attr("aria-describedby", t.id+"-counter"))
+	}
 	
 	// Add boolean attributes
 	if t.disabled {
@@ -234,14 +332,67 @@ func (t *TextareaComponent) Render(w io.Writer) error {
 	if t.value != "" {
 		content = g.Text(t.value)
 	}
-	
-	textareaEl := h.Textarea(append(attrs, content)...)
-	
-	return textareaEl.Render(w)
+
+	return h.Textarea(append(attrs, content)...)
+}
+
+// autoGrow reports whether the textarea grows with its content
+func (t *TextareaComponent) autoGrow() bool {
+	return t.maxRows > 0
+}
+
+// initialRows returns the rows attribute: the configured rows or, for
+// auto-grown textareas, the number of lines of the value within the bounds
+func (t *TextareaComponent) initialRows() int {
+	if !t.autoGrow() {
+		return t.rows
+	}
+	return AutoGrowRows(strings.Count(t.value, "\n")+1, t.minRows, t.maxRows)
+}
+
+// counterNode renders the character counter
+func (t *TextareaComponent) counterNode() gomponents.Node {
+	text, nearLimit := TextareaCount(t.value, t.maxLength)
+	classes := "text-base-content/60 text-sm"
+	if nearLimit {
+		classes = "text-error text-sm"
+	}
+	return h.Div(
+		h.Class("mt-1 flex justify-end"),
+		h.Span(
+			h.ID(t.id+"-counter"),
+			h.Class(classes),
+			g.Attr("data-textarea-counter", strconv.Itoa(t.maxLength)),
+			g.Text(text),
+		),
+	)
+}
+
+// TextareaCount returns the counter text of value, "120/500" with a maximum
+// length or "120" without, and whether the count is within 10% of the
+// maximum. Characters are counted like the browser's maxlength, in UTF-16
+// code units with line breaks counted once.
+func TextareaCount(value string, maxLength int) (text string, nearLimit bool) {
+	count := len(utf16.Encode([]rune(strings.ReplaceAll(value, "\r\n", "\n"))))
+	if maxLength <= 0 {
+		return strconv.Itoa(count), false
+	}
+	return strconv.Itoa(count) + "/" + strconv.Itoa(maxLength), count >= maxLength-max(1, maxLength/10)
+}
+
+// AutoGrowRows limits the rows needed by the content to the auto-grow bounds
+func AutoGrowRows(rows, minRows, maxRows int) int {
+	if rows < minRows {
+		rows = minRows
+	}
+	if maxRows > 0 && rows > maxRows {
+		rows = maxRows
+	}
+	return max(rows, 1)
 }
 
 // Ensure TextareaComponent implements the required interfaces
 var (
 	_ flyon.Component = (*TextareaComponent)(nil)
 	_ gomponents.Node = (*TextareaComponent)(nil)
-)
\ No newline at end of file
+)
@@ -0,0 +1,177 @@
+package components
+
+import (
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+// MarkdownAction is a formatting command of the markdown toolbar
+type MarkdownAction string
+
+const (
+	MarkdownBold   MarkdownAction = "bold"
+	MarkdownItalic MarkdownAction = "italic"
+	MarkdownLink   MarkdownAction = "link"
+	MarkdownList   MarkdownAction = "list"
+)
+
+// markdownButtons lists the toolbar buttons in display order
+var markdownButtons = []struct {
+	action   MarkdownAction
+	label    string
+	icon     string
+	shortcut string
+}{
+	{MarkdownBold, "Bold", "icon-[tabler--bold]", "Control+B"},
+	{MarkdownItalic, "Italic", "icon-[tabler--italic]", "Control+I"},
+	{MarkdownLink, "Link", "icon-[tabler--link]", "Control+K"},
+	{MarkdownList, "Bulleted list", "icon-[tabler--list]", ""},
+}
+
+// MarkdownEdit is the result of ApplyMarkdown: the new value and the
+// selection to restore, as rune offsets
+type MarkdownEdit struct {
+	Value      string
+	Start, End int
+}
+
+// ApplyMarkdown applies action to the selection from start to end (rune
+// offsets) of value. Bold and italic toggle their markers around the
+// selection, link wraps it into [text](url) with the part left to type
+// selected, and list toggles "- " in front of every selected line.
+func ApplyMarkdown(action MarkdownAction, value string, start, end int) MarkdownEdit {
+	runes := []rune(value)
+	start = min(max(start, 0), len(runes))
+	end = min(max(end, 0), len(runes))
+	if start > end {
+		start, end = end, start
+	}
+
+	switch action {
+	case MarkdownBold:
+		return toggleMarkdownMarker(runes, start, end, "**")
+	case MarkdownItalic:
+		return toggleMarkdownMarker(runes, start, end, "_")
+	case MarkdownLink:
+		return insertMarkdownLink(runes, start, end)
+	case MarkdownList:
+		return toggleMarkdownList(runes, start, end)
+	}
+	return MarkdownEdit{Value: value, Start: start, End: end}
+}
+
+// toggleMarkdownMarker wraps the selection in marker, or removes marker when
+// it already surrounds the selection
+func toggleMarkdownMarker(runes []rune, start, end int, marker string) MarkdownEdit {
+	m := len([]rune(marker))
+	before, selection, after := string(runes[:start]), string(runes[start:end]), string(runes[end:])
+
+	switch {
+	case strings.HasSuffix(before, marker) && strings.HasPrefix(after, marker):
+		return MarkdownEdit{
+			Value: strings.TrimSuffix(before, marker) + selection + strings.TrimPrefix(after, marker),
+			Start: start - m,
+			End:   end - m,
+		}
+	case end-start >= 2*m && strings.HasPrefix(selection, marker) && strings.HasSuffix(selection, marker):
+		inner := string(runes[start+m : end-m])
+		return MarkdownEdit{Value: before + inner + after, Start: start, End: end - 2*m}
+	}
+	return MarkdownEdit{Value: before + marker + selection + marker + after, Start: start + m, End: end + m}
+}
+
+// insertMarkdownLink wraps the selection into a link. A selected URL becomes
+// the target with "text" selected; other selections become the text with
+// "url" selected.
+func insertMarkdownLink(runes []rune, start, end int) MarkdownEdit {
+	before, selection, after := string(runes[:start]), string(runes[start:end]), string(runes[end:])
+
+	if strings.HasPrefix(selection, "http://") || strings.HasPrefix(selection, "https://") {
+		return MarkdownEdit{Value: before + "[text](" + selection + ")" + after, Start: start + 1, End: start + 5}
+	}
+	text := selection
+	if text == "" {
+		text = "text"
+	}
+	n := len([]rune(text))
+	edit := MarkdownEdit{Value: before + "[" + text + "](url)" + after, Start: start + n + 3, End: start + n + 6}
+	if selection == "" {
+		edit.Start, edit.End = start+1, start+1+n
+	}
+	return edit
+}
+
+// toggleMarkdownList adds "- " in front of every line touched by the
+// selection, or removes it when all of them already start with it
+func toggleMarkdownList(runes []rune, start, end int) MarkdownEdit {
+	const marker = "- "
+	lineStart := start
+	for lineStart > 0 && runes[lineStart-1] != '\n' {
+		lineStart--
+	}
+	lineEnd := end
+	for lineEnd < len(runes) && runes[lineEnd] != '\n' {
+		lineEnd++
+	}
+
+	lines := strings.Split(string(runes[lineStart:lineEnd]), "\n")
+	listed := true
+	for _, line := range lines {
+		if !strings.HasPrefix(line, marker) {
+			listed = false
+		}
+	}
+	delta := 0
+	for i, line := range lines {
+		switch {
+		case listed:
+			lines[i] = strings.TrimPrefix(line, marker)
+		case !strings.HasPrefix(line, marker):
+			lines[i] = marker + line
+		}
+		if i == 0 {
+			delta = len([]rune(lines[0])) - len([]rune(line))
+		}
+	}
+	block := strings.Join(lines, "\n")
+	value := string(runes[:lineStart]) + block + string(runes[lineEnd:])
+
+	if start == end {
+		// Keep the caret where it was within the text of the line
+		caret := max(start+delta, lineStart)
+		return MarkdownEdit{Value: value, Start: caret, End: caret}
+	}
+	return MarkdownEdit{Value: value, Start: lineStart, End: lineStart + len([]rune(block))}
+}
+
+// markdownToolbar renders the formatting toolbar of the textarea
+func (t *TextareaComponent) markdownToolbar() g.Node {
+	buttons := make([]g.Node, len(markdownButtons))
+	for i, button := range markdownButtons {
+		title := button.label
+		if button.shortcut != "" {
+			title += " (" + strings.Replace(button.shortcut, "Control", "Ctrl", 1) + ")"
+		}
+		buttons[i] = h.Button(
+			h.Type("button"),
+			h.Class("btn btn-text btn-square btn-sm"),
+			g.Attr("aria-label", button.label),
+			h.Title(title),
+			g.If(button.shortcut != "", g.Attr("aria-keyshortcuts", button.shortcut)),
+			g.If(t.disabled || t.readonly, h.Disabled()),
+			g.Attr("data-markdown-action", string(button.action)),
+			h.Span(h.Class(button.icon+" size-4 shrink-0"), g.Attr("aria-hidden", "true")),
+		)
+	}
+
+	return h.Div(
+		h.Class("border-base-content/25 rounded-t-field flex gap-1 border border-b-0 p-1"),
+		h.Role("toolbar"),
+		g.Attr("aria-label", "Formatting"),
+		g.Attr("aria-controls", t.id),
+		g.Attr("data-markdown-toolbar", t.id),
+		g.Group(buttons),
+	)
+}
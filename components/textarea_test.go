@@ -251,4 +251,115 @@ func TestTextareaComponent_Immutability(t *testing.T) {
 	if !strings.Contains(modifiedColorHTML, "textarea-primary") {
 		t.Errorf("Modified textarea should have primary color, got: %s", modifiedColorHTML)
 	}
-}
\ No newline at end of file
+}
+
+func TestTextareaComponent_LengthLimits(t *testing.T) {
+	html := renderToStringTextarea(NewTextarea().WithMaxLength(500).WithMinLength(10))
+	if !strings.Contains(html, `maxlength="500"`) || !strings.Contains(html, `minlength="10"`) {
+		t.Errorf("Expected length limits, got: %s", html)
+	}
+	if strings.Contains(html, "<div") {
+		t.Errorf("Expected no wrapper without counter or markdown, got: %s", html)
+	}
+}
+
+func TestTextareaComponent_Counter(t *testing.T) {
+	html := renderToStringTextarea(NewTextarea().WithID("bio").WithValue(strings.Repeat("a", 120)).WithMaxLength(500).WithCounter(true))
+	if !strings.Contains(html, `aria-describedby="bio-counter"`) {
+		t.Errorf("Expected the textarea to be described by the counter, got: %s", html)
+	}
+	if !strings.Contains(html, `<span id="bio-counter" class="text-base-content/60 text-sm" data-textarea-counter="500">120/500</span>`) {
+		t.Errorf("Expected a counter, got: %s", html)
+	}
+
+	html = renderToStringTextarea(NewTextarea().WithValue(strings.Repeat("a", 460)).WithMaxLength(500).WithCounter(true))
+	if !strings.Contains(html, `class="text-error text-sm"`) || !strings.Contains(html, `id="textarea-`) {
+		t.Errorf("Expected an error colored counter and a generated ID near the limit, got: %s", html)
+	}
+}
+
+func TestTextareaCount(t *testing.T) {
+	tests := []struct {
+		value     string
+		max       int
+		text      string
+		nearLimit bool
+	}{
+		{"hello", 0, "5", false},
+		{"a\r\nb", 10, "3/10", false},
+		{"😀", 10, "2/10", false},
+		{"123456789", 10, "9/10", true},
+		{"1234", 5, "4/5", true},
+	}
+	for _, tt := range tests {
+		text, nearLimit := TextareaCount(tt.value, tt.max)
+		if text != tt.text || nearLimit != tt.nearLimit {
+			t.Errorf("TextareaCount(%q, %d): expected %q, %v, got %q, %v", tt.value, tt.max, tt.text, tt.nearLimit, text, nearLimit)
+		}
+	}
+}
+
+func TestTextareaComponent_AutoGrow(t *testing.T) {
+	html := renderToStringTextarea(NewTextarea().WithValue("1\n2\n3\n4\n5").WithAutoGrow(2, 4))
+	if !strings.Contains(html, `rows="4"`) || !strings.Contains(html, `data-textarea-autogrow="2,4"`) || !strings.Contains(html, "resize-none") {
+		t.Errorf("Expected auto-grow rows capped at 4, got: %s", html)
+	}
+	html = renderToStringTextarea(NewTextarea().WithAutoGrow(2, 4))
+	if !strings.Contains(html, `rows="2"`) {
+		t.Errorf("Expected the minimum rows for an empty textarea, got: %s", html)
+	}
+}
+
+func TestTextareaComponent_Markdown(t *testing.T) {
+	html := renderToStringTextarea(NewTextarea().WithID("body").WithName("body").WithMarkdown(true))
+	for _, want := range []string{
+		`role="toolbar"`,
+		`aria-controls="body"`,
+		`data-markdown-toolbar="body"`,
+		`data-markdown-action="bold"`,
+		`data-markdown-action="italic"`,
+		`data-markdown-action="link"`,
+		`data-markdown-action="list"`,
+		`aria-keyshortcuts="Control+B"`,
+		`name="body"`,
+		`rounded-t-none`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in markdown textarea, got: %s", want, html)
+		}
+	}
+
+	html = renderToStringTextarea(NewTextarea().WithMarkdown(true).WithReadonly(true))
+	if strings.Count(html, "disabled") != 4 {
+		t.Errorf("Expected the toolbar to be disabled for a readonly textarea, got: %s", html)
+	}
+}
+
+func TestApplyMarkdown(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     MarkdownAction
+		value      string
+		start, end int
+		want       MarkdownEdit
+	}{
+		{"bold", MarkdownBold, "make it bold", 8, 12, MarkdownEdit{"make it **bold**", 10, 14}},
+		{"unbold", MarkdownBold, "make it **bold**", 10, 14, MarkdownEdit{"make it bold", 8, 12}},
+		{"unbold selected markers", MarkdownBold, "**bold**", 0, 8, MarkdownEdit{"bold", 0, 4}},
+		{"italic caret", MarkdownItalic, "ab", 1, 1, MarkdownEdit{"a__b", 2, 2}},
+		{"link text", MarkdownLink, "see docs", 4, 8, MarkdownEdit{"see [docs](url)", 11, 14}},
+		{"link url", MarkdownLink, "https://x.io", 0, 12, MarkdownEdit{"[text](https://x.io)", 1, 5}},
+		{"link empty", MarkdownLink, "", 0, 0, MarkdownEdit{"[text](url)", 1, 5}},
+		{"list lines", MarkdownList, "a\nb\nc", 0, 3, MarkdownEdit{"- a\n- b\nc", 0, 7}},
+		{"unlist lines", MarkdownList, "- a\n- b", 0, 7, MarkdownEdit{"a\nb", 0, 3}},
+		{"list caret", MarkdownList, "x\nitem", 4, 4, MarkdownEdit{"x\n- item", 6, 6}},
+		{"unicode", MarkdownBold, "héllo", 0, 5, MarkdownEdit{"**héllo**", 2, 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplyMarkdown(tt.action, tt.value, tt.start, tt.end); got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
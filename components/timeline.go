@@ -37,33 +37,45 @@ func NewTimeline(children ...g.Node) *TimelineComponent {
 
 // WithOrientation sets the orientation of the timeline
 func (t *TimelineComponent) WithOrientation(orientation TimelineOrientation) *TimelineComponent {
-	t.orientation = &orientation
-	return t
+	newTimeline := t.copy()
+	newTimeline.orientation = &orientation
+	return newTimeline
 }
 
 // WithCompact sets the timeline to compact mode
 func (t *TimelineComponent) WithCompact() *TimelineComponent {
-	t.compact = true
-	return t
+	newTimeline := t.copy()
+	newTimeline.compact = true
+	return newTimeline
 }
 
 // With applies modifiers to the timeline component
 func (t *TimelineComponent) With(items ...any) flyon.Component {
+	newTimeline := t.copy()
 	for _, item := range items {
 		switch v := item.(type) {
 		case flyon.Color:
-			t.classes = append(t.classes, "timeline-"+v.String())
+			newTimeline.classes = append(newTimeline.classes, "timeline-"+v.String())
 		case string:
-			t.classes = append(t.classes, v)
+			newTimeline.classes = append(newTimeline.classes, v)
 		case g.Node:
 			if isAttribute(v) {
-				t.attributes = append(t.attributes, v)
+				newTimeline.attributes = append(newTimeline.attributes, v)
 			} else {
-				t.children = append(t.children, v)
+				newTimeline.children = append(newTimeline.children, v)
 			}
 		}
 	}
-	return t
+	return newTimeline
+}
+
+// copy creates a deep copy of the timeline component
+func (t *TimelineComponent) copy() *TimelineComponent {
+	newTimeline := *t
+	newTimeline.children = append([]g.Node(nil), t.children...)
+	newTimeline.classes = append([]string(nil), t.classes...)
+	newTimeline.attributes = append([]g.Node(nil), t.attributes...)
+	return &newTimeline
 }
 
 // Render renders the timeline component
@@ -0,0 +1,332 @@
+package components
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// ToastPosition is the corner or edge of the viewport a toast container is pinned to
+type ToastPosition string
+
+const (
+	ToastTopStart     ToastPosition = "top-start"
+	ToastTopCenter    ToastPosition = "top-center"
+	ToastTopEnd       ToastPosition = "top-end"
+	ToastBottomStart  ToastPosition = "bottom-start"
+	ToastBottomCenter ToastPosition = "bottom-center"
+	ToastBottomEnd    ToastPosition = "bottom-end"
+)
+
+// toastPositionClasses places the container of each position. Top containers
+// are reversed so the newest toast, appended last, shows nearest the edge.
+var toastPositionClasses = map[ToastPosition]string{
+	ToastTopStart:     "top-0 start-0 flex-col-reverse",
+	ToastTopCenter:    "top-0 start-1/2 -translate-x-1/2 flex-col-reverse items-center",
+	ToastTopEnd:       "top-0 end-0 flex-col-reverse items-end",
+	ToastBottomStart:  "bottom-0 start-0 flex-col",
+	ToastBottomCenter: "bottom-0 start-1/2 -translate-x-1/2 flex-col items-center",
+	ToastBottomEnd:    "bottom-0 end-0 flex-col items-end",
+}
+
+// DefaultToastTimeout is the time after which toasts dismiss themselves
+const DefaultToastTimeout = 5 * time.Second
+
+// ToastAction is a button of a toast. Actions with an Href render as links;
+// the others dispatch a "toast:action" event carrying Value when clicked.
+type ToastAction struct {
+	Label string
+	Href  string
+	Value string
+}
+
+// toastData is the data-toast configuration read by the WASM behavior
+type toastData struct {
+	Timeout int64 `json:"timeout"`
+}
+
+// ToastComponent represents a notification shown in a ToastContainerComponent
+type ToastComponent struct {
+	id          string
+	title       string
+	message     string
+	icon        string
+	color       flyon.Color
+	colorSet    bool
+	timeout     time.Duration
+	dismissible bool
+	actions     []ToastAction
+	classes     []string
+}
+
+// NewToast creates a new dismissible toast closing after DefaultToastTimeout
+func NewToast(message string) *ToastComponent {
+	return &ToastComponent{
+		message:     message,
+		timeout:     DefaultToastTimeout,
+		dismissible: true,
+		classes:     make([]string, 0),
+	}
+}
+
+// WithID sets the toast ID
+func (t *ToastComponent) WithID(id string) *ToastComponent {
+	newToast := t.copy()
+	newToast.id = id
+	return newToast
+}
+
+// WithTitle sets the bold title above the message
+func (t *ToastComponent) WithTitle(title string) *ToastComponent {
+	newToast := t.copy()
+	newToast.title = title
+	return newToast
+}
+
+// WithIcon sets an icon class, e.g. "icon-[tabler--circle-check]"
+func (t *ToastComponent) WithIcon(icon string) *ToastComponent {
+	newToast := t.copy()
+	newToast.icon = icon
+	return newToast
+}
+
+// WithColor sets the toast color
+func (t *ToastComponent) WithColor(color flyon.Color) *ToastComponent {
+	newToast := t.copy()
+	newToast.color = color
+	newToast.colorSet = true
+	return newToast
+}
+
+// WithTimeout sets the time after which the toast dismisses itself; zero
+// keeps it until closed. Hovering or focusing the toast pauses the timer.
+func (t *ToastComponent) WithTimeout(timeout time.Duration) *ToastComponent {
+	newToast := t.copy()
+	newToast.timeout = timeout
+	return newToast
+}
+
+// WithDismissible sets whether the toast has a close button
+func (t *ToastComponent) WithDismissible(dismissible bool) *ToastComponent {
+	newToast := t.copy()
+	newToast.dismissible = dismissible
+	return newToast
+}
+
+// WithAction adds an action button
+func (t *ToastComponent) WithAction(action ToastAction) *ToastComponent {
+	newToast := t.copy()
+	newToast.actions = append(newToast.actions, action)
+	return newToast
+}
+
+// WithClasses adds additional CSS classes
+func (t *ToastComponent) WithClasses(classes ...string) *ToastComponent {
+	newToast := t.copy()
+	newToast.classes = append(newToast.classes, classes...)
+	return newToast
+}
+
+// With applies modifiers to the toast
+func (t *ToastComponent) With(modifiers ...any) flyon.Component {
+	newToast := t.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newToast.color = m
+			newToast.colorSet = true
+		case string:
+			newToast.classes = append(newToast.classes, m)
+		}
+	}
+	return newToast
+}
+
+// copy creates a deep copy of the toast
+func (t *ToastComponent) copy() *ToastComponent {
+	newToast := *t
+	newToast.actions = append([]ToastAction(nil), t.actions...)
+	newToast.classes = make([]string, len(t.classes))
+	copy(newToast.classes, t.classes)
+	return &newToast
+}
+
+// Render generates the HTML for the toast
+func (t *ToastComponent) Render(w io.Writer) error {
+	id := t.id
+	if id == "" {
+		id = "toast-" + generateID()
+	}
+
+	classes := []string{"alert", "pointer-events-auto", "w-full", "max-w-sm", "shadow-lg", "transition", "duration-300"}
+	if t.colorSet {
+		classes = append(classes, "alert-"+t.color.String())
+	}
+	classes = append(classes, t.classes...)
+
+	// Errors interrupt screen readers, other toasts wait their turn
+	role := "status"
+	if t.colorSet && t.color == flyon.Error {
+		role = "alert"
+	}
+
+	config, _ := json.Marshal(toastData{Timeout: t.timeout.Milliseconds()})
+
+	body := []g.Node{h.Class("flex grow flex-col gap-1")}
+	if t.title != "" {
+		body = append(body, h.Span(h.Class("font-semibold"), g.Text(t.title)))
+	}
+	body = append(body, h.P(g.Text(t.message)))
+	if len(t.actions) > 0 {
+		actions := make([]g.Node, len(t.actions))
+		for i, action := range t.actions {
+			if action.Href != "" {
+				actions[i] = h.A(h.Href(action.Href), h.Class("btn btn-sm btn-soft"), g.Text(action.Label))
+				continue
+			}
+			actions[i] = h.Button(
+				h.Type("button"),
+				h.Class("btn btn-sm btn-soft"),
+				g.Attr("data-toast-action", action.Value),
+				g.Text(action.Label),
+			)
+		}
+		body = append(body, h.Div(h.Class("mt-2 flex gap-2"), g.Group(actions)))
+	}
+
+	return h.Div(
+		h.ID(id),
+		h.Class(strings.Join(classes, " ")),
+		h.Role(role),
+		g.Attr("data-toast", string(config)),
+		g.If(t.icon != "", h.Span(h.Class(t.icon+" size-6 shrink-0"), g.Attr("aria-hidden", "true"))),
+		h.Div(body...),
+		g.If(t.dismissible, h.Button(
+			h.Type("button"),
+			h.Class("btn btn-text btn-circle btn-sm ms-auto"),
+			g.Attr("aria-label", "Close"),
+			// Lets FlyonUI's HSRemoveElement close the toast without the WASM behavior
+			g.Attr("data-remove-element", "#"+id),
+			g.Attr("data-toast-close", ""),
+			h.Span(h.Class("icon-[tabler--x] size-5"), g.Attr("aria-hidden", "true")),
+		)),
+	).Render(w)
+}
+
+// ToastContainerComponent stacks toasts at a position of the viewport
+type ToastContainerComponent struct {
+	id       string
+	position ToastPosition
+	limit    int
+	toasts   []*ToastComponent
+	classes  []string
+}
+
+// NewToastContainer creates a new container at the bottom end of the viewport
+func NewToastContainer(toasts ...*ToastComponent) *ToastContainerComponent {
+	return &ToastContainerComponent{
+		position: ToastBottomEnd,
+		toasts:   append([]*ToastComponent(nil), toasts...),
+		classes:  make([]string, 0),
+	}
+}
+
+// WithID sets the container ID
+func (tc *ToastContainerComponent) WithID(id string) *ToastContainerComponent {
+	newContainer := tc.copy()
+	newContainer.id = id
+	return newContainer
+}
+
+// WithPosition sets where the toasts are shown
+func (tc *ToastContainerComponent) WithPosition(position ToastPosition) *ToastContainerComponent {
+	newContainer := tc.copy()
+	newContainer.position = position
+	return newContainer
+}
+
+// WithLimit caps the number of toasts shown at once; the oldest are
+// dismissed first. Zero means no limit.
+func (tc *ToastContainerComponent) WithLimit(limit int) *ToastContainerComponent {
+	newContainer := tc.copy()
+	newContainer.limit = limit
+	return newContainer
+}
+
+// WithToasts appends toasts to the container
+func (tc *ToastContainerComponent) WithToasts(toasts ...*ToastComponent) *ToastContainerComponent {
+	newContainer := tc.copy()
+	newContainer.toasts = append(newContainer.toasts, toasts...)
+	return newContainer
+}
+
+// WithClasses adds additional CSS classes
+func (tc *ToastContainerComponent) WithClasses(classes ...string) *ToastContainerComponent {
+	newContainer := tc.copy()
+	newContainer.classes = append(newContainer.classes, classes...)
+	return newContainer
+}
+
+// With applies modifiers to the container
+func (tc *ToastContainerComponent) With(modifiers ...any) flyon.Component {
+	newContainer := tc.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case ToastPosition:
+			newContainer.position = m
+		case string:
+			newContainer.classes = append(newContainer.classes, m)
+		}
+	}
+	return newContainer
+}
+
+// copy creates a deep copy of the container
+func (tc *ToastContainerComponent) copy() *ToastContainerComponent {
+	newContainer := *tc
+	newContainer.toasts = append([]*ToastComponent(nil), tc.toasts...)
+	newContainer.classes = make([]string, len(tc.classes))
+	copy(newContainer.classes, tc.classes)
+	return &newContainer
+}
+
+// Render generates the HTML for the container
+func (tc *ToastContainerComponent) Render(w io.Writer) error {
+	position := tc.position
+	if _, ok := toastPositionClasses[position]; !ok {
+		position = ToastBottomEnd
+	}
+	classes := append([]string{"pointer-events-none", "fixed", "z-[80]", "flex", "w-full", "max-w-sm", "gap-3", "p-4", toastPositionClasses[position]}, tc.classes...)
+
+	toasts := tc.toasts
+	if tc.limit > 0 && len(toasts) > tc.limit {
+		toasts = toasts[len(toasts)-tc.limit:]
+	}
+	nodes := make([]g.Node, len(toasts))
+	for i, toast := range toasts {
+		nodes[i] = toast
+	}
+
+	return h.Div(
+		g.If(tc.id != "", h.ID(tc.id)),
+		h.Class(strings.Join(classes, " ")),
+		h.Role("region"),
+		g.Attr("aria-label", "Notifications"),
+		g.Attr("data-toast-container", string(position)),
+		g.If(tc.limit > 0, g.Attr("data-toast-limit", strconv.Itoa(tc.limit))),
+		g.Group(nodes),
+	).Render(w)
+}
+
+// Ensure ToastComponent implements flyon.Component
+var _ flyon.Component = (*ToastComponent)(nil)
+
+// Ensure ToastContainerComponent implements flyon.Component
+var _ flyon.Component = (*ToastContainerComponent)(nil)
@@ -0,0 +1,62 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+func TestToastComponent_Render(t *testing.T) {
+	toast := NewToast("Saved").WithID("saved").WithTitle("Done").WithColor(flyon.Success).WithIcon("icon-[tabler--check]").
+		WithTimeout(3 * time.Second).WithAction(ToastAction{Label: "Undo", Value: "undo"}).WithAction(ToastAction{Label: "View", Href: "/posts/1"})
+	html := renderToStringInput(toast)
+
+	for _, want := range []string{
+		`<div id="saved" class="alert pointer-events-auto w-full max-w-sm shadow-lg transition duration-300 alert-success" role="status"`,
+		`data-toast="{&#34;timeout&#34;:3000}"`,
+		`<span class="font-semibold">Done</span>`,
+		`<p>Saved</p>`,
+		`data-toast-action="undo"`,
+		`<a href="/posts/1" class="btn btn-sm btn-soft">View</a>`,
+		`data-remove-element="#saved"`,
+		`data-toast-close`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in toast, got: %s", want, html)
+		}
+	}
+}
+
+func TestToastComponent_Options(t *testing.T) {
+	html := renderToStringInput(NewToast("Failed").WithColor(flyon.Error).WithTimeout(0).WithDismissible(false))
+	if !strings.Contains(html, `role="alert"`) || !strings.Contains(html, `&#34;timeout&#34;:0`) {
+		t.Errorf("Expected a persistent error toast, got: %s", html)
+	}
+	if strings.Contains(html, "data-toast-close") {
+		t.Errorf("Expected no close button, got: %s", html)
+	}
+	if !strings.Contains(renderToStringInput(NewToast("Hi")), `id="toast-`) {
+		t.Error("Expected a generated ID")
+	}
+}
+
+func TestToastContainerComponent_Render(t *testing.T) {
+	container := NewToastContainer(NewToast("One"), NewToast("Two"), NewToast("Three")).WithPosition(ToastTopCenter).WithLimit(2)
+	html := renderToStringInput(container)
+
+	for _, want := range []string{`data-toast-container="top-center"`, `data-toast-limit="2"`, `flex-col-reverse`, `aria-label="Notifications"`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in container, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "One") || !strings.Contains(html, "Three") {
+		t.Errorf("Expected the oldest toast to be dropped, got: %s", html)
+	}
+
+	fallback := renderToStringInput(NewToastContainer().WithPosition("middle"))
+	if !strings.Contains(fallback, `data-toast-container="bottom-end"`) {
+		t.Errorf("Expected unknown positions to fall back to bottom-end, got: %s", fallback)
+	}
+}
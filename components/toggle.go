@@ -22,6 +22,7 @@ type ToggleComponent struct {
 	sizeSet    bool
 	classes    []string
 	attributes map[string]string
+	label      choiceLabel
 }
 
 // NewToggle creates a new toggle component with default values
@@ -70,6 +71,27 @@ func (t *ToggleComponent) WithDisabled(disabled bool) *ToggleComponent {
 	return &new
 }
 
+// WithLabel wraps the toggle in a label with text
+func (t *ToggleComponent) WithLabel(text string) *ToggleComponent {
+	new := *t
+	new.label.text = text
+	return &new
+}
+
+// WithDescription adds helper text below the label
+func (t *ToggleComponent) WithDescription(description string) *ToggleComponent {
+	new := *t
+	new.label.description = description
+	return &new
+}
+
+// WithLabelPlacement places the label before or after the toggle
+func (t *ToggleComponent) WithLabelPlacement(placement LabelPlacement) *ToggleComponent {
+	new := *t
+	new.label.placement = placement
+	return &new
+}
+
 // WithColor sets the toggle color
 func (t *ToggleComponent) WithColor(color flyon.Color) *ToggleComponent {
 	new := *t
@@ -190,10 +212,19 @@ func (t *ToggleComponent) Render(w io.Writer) error {
 		attrs = append(attrs, h.Disabled())
 	}
 
+	id := t.id
+	if t.label.description != "" && id == "" {
+		id = "toggle-" + generateID()
+		attrs = append(attrs, h.ID(id))
+	}
+	if describedBy := t.label.descriptionID(id); describedBy != "" {
+		attrs = append(attrs, g.Attr("aria-describedby", describedBy))
+	}
+
 	// Add custom attributes
 	for key, value := range t.attributes {
 		attrs = append(attrs, g.Attr(key, value))
 	}
 
-	return h.Input(attrs...).Render(w)
+	return t.label.wrap(h.Input(attrs...), id).Render(w)
 }
\ No newline at end of file
@@ -259,4 +259,11 @@ func TestToggleComponent_Immutability(t *testing.T) {
 	if modified.color != flyon.Success {
 		t.Error("Modified toggle color should be Success")
 	}
-}
\ No newline at end of file
+}
+
+func TestToggleComponent_Label(t *testing.T) {
+	html := renderToStringToggle(NewToggle().WithLabel("Notifications").WithLabelPlacement(LabelStart))
+	if !strings.Contains(html, `<label class="flex cursor-pointer gap-3 items-center"><span class="flex flex-col"><span class="label-text text-base">Notifications</span></span><input`) {
+		t.Errorf("Expected label before the toggle, got: %s", html)
+	}
+}
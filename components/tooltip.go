@@ -71,34 +71,46 @@ func NewTooltip(text string, children ...g.Node) *TooltipComponent {
 
 // WithPosition sets the tooltip position
 func (t *TooltipComponent) WithPosition(position TooltipPosition) *TooltipComponent {
-	t.position = &position
-	return t
+	newTooltip := t.copy()
+	newTooltip.position = &position
+	return newTooltip
 }
 
 // WithOpen sets the tooltip to be always open
 func (t *TooltipComponent) WithOpen() *TooltipComponent {
-	t.isOpen = true
-	return t
+	newTooltip := t.copy()
+	newTooltip.isOpen = true
+	return newTooltip
 }
 
 // With applies modifiers to the tooltip component.
 func (t *TooltipComponent) With(children ...any) flyon.Component {
+	newTooltip := t.copy()
 	for _, child := range children {
 		switch c := child.(type) {
 		case flyon.Color:
-			t.classes = append(t.classes, "tooltip-"+c.String())
+			newTooltip.classes = append(newTooltip.classes, "tooltip-"+c.String())
 		case string:
-			t.classes = append(t.classes, c)
+			newTooltip.classes = append(newTooltip.classes, c)
 		case g.Node:
 			// Check if it's an attribute or content
 			if isAttribute(c) {
-				t.attributes = append(t.attributes, c)
+				newTooltip.attributes = append(newTooltip.attributes, c)
 			} else {
-				t.children = append(t.children, c)
+				newTooltip.children = append(newTooltip.children, c)
 			}
 		}
 	}
-	return t
+	return newTooltip
+}
+
+// copy creates a deep copy of the tooltip component
+func (t *TooltipComponent) copy() *TooltipComponent {
+	newTooltip := *t
+	newTooltip.classes = append([]string(nil), t.classes...)
+	newTooltip.attributes = append([]g.Node(nil), t.attributes...)
+	newTooltip.children = append([]g.Node(nil), t.children...)
+	return &newTooltip
 }
 
 // Render implements the gomponents.Node interface
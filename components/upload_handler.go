@@ -0,0 +1,477 @@
+package components
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Headers of the chunked upload protocol spoken by FileUploadComponent
+const (
+	// UploadIDHeader carries the resumable upload ID issued by the server
+	UploadIDHeader = "Upload-ID"
+	// UploadNameHeader carries the URL-escaped file name
+	UploadNameHeader = "Upload-Name"
+	// UploadTypeHeader carries the MIME type of the file
+	UploadTypeHeader = "Upload-Type"
+	// UploadOffsetHeader reports how many bytes the server has stored
+	UploadOffsetHeader = "Upload-Offset"
+)
+
+// ErrUploadNotFound is returned by UploadStorage for IDs it never created
+var ErrUploadNotFound = errors.New("upload not found")
+
+// UploadInfo describes an upload, fixed by its first chunk
+type UploadInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+}
+
+// UploadStorage persists the chunks received by an UploadHandler.
+// Implementations only see sequential writes: WriteChunk is always called
+// with the offset last reported by Offset, and never concurrently for one ID.
+type UploadStorage interface {
+	// Create starts an empty upload described by info, with a new ID
+	Create(ctx context.Context, info UploadInfo) error
+	// Info returns the info the upload was created with. Unknown IDs return
+	// ErrUploadNotFound.
+	Info(ctx context.Context, id string) (UploadInfo, error)
+	// Offset returns the number of bytes stored for id and whether the upload
+	// was completed. Unknown IDs return ErrUploadNotFound.
+	Offset(ctx context.Context, id string) (offset int64, complete bool, err error)
+	// WriteChunk appends data at offset and returns the number of bytes written
+	WriteChunk(ctx context.Context, id string, offset int64, data io.Reader) (int64, error)
+	// Complete is called once all bytes of the upload were written
+	Complete(ctx context.Context, info UploadInfo) error
+}
+
+// uploadStatus is the JSON response of the UploadHandler
+type uploadStatus struct {
+	ID       string `json:"id"`
+	Offset   int64  `json:"offset"`
+	Complete bool   `json:"complete"`
+}
+
+// UploadHandler is an http.Handler that reassembles chunked uploads.
+//
+// Each chunk is POSTed with the URL-escaped Upload-Name and Upload-Type
+// headers and a Content-Range of the form "bytes start-end/total". The first
+// chunk carries no Upload-ID: the server issues a random ID in its response,
+// which every later chunk must send in the Upload-ID header. Being
+// unguessable, an ID is only known to the client that started the upload;
+// IDs the server did not issue are rejected with 404 Not Found. The name,
+// type and total size are fixed by the first chunk, later chunks claiming
+// others are rejected with 400 Bad Request.
+//
+// Chunks must arrive in order; a chunk that does not start at the stored
+// offset is rejected with 409 Conflict and the expected Upload-Offset, so
+// clients can resume an interrupted upload by asking for the offset with a
+// GET or HEAD request carrying ?id= and continuing from there.
+type UploadHandler struct {
+	storage UploadStorage
+	maxSize int64
+	accept  string
+	locks   *keyedMutex
+}
+
+// NewUploadHandler creates a new handler storing uploads in storage
+func NewUploadHandler(storage UploadStorage) *UploadHandler {
+	return &UploadHandler{
+		storage: storage,
+		locks:   &keyedMutex{locks: make(map[string]*keyedLock)},
+	}
+}
+
+// WithMaxSize rejects files larger than maxSize bytes
+func (u *UploadHandler) WithMaxSize(maxSize int64) *UploadHandler {
+	newHandler := *u
+	newHandler.maxSize = maxSize
+	return &newHandler
+}
+
+// WithAccept rejects files not matching accept, see AcceptsFile
+func (u *UploadHandler) WithAccept(accept string) *UploadHandler {
+	newHandler := *u
+	newHandler.accept = accept
+	return &newHandler
+}
+
+// ServeHTTP implements http.Handler
+func (u *UploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		u.serveStatus(w, r)
+	case http.MethodPost:
+		u.serveChunk(w, r)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// serveStatus reports the stored offset of an upload so clients can resume
+func (u *UploadHandler) serveStatus(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	if !validUploadID(id) {
+		http.Error(w, "invalid upload id", http.StatusBadRequest)
+		return
+	}
+	offset, complete, err := u.storage.Offset(r.Context(), id)
+	if err != nil {
+		writeUploadError(w, err)
+		return
+	}
+	writeUploadStatus(w, http.StatusOK, uploadStatus{ID: id, Offset: offset, Complete: complete})
+}
+
+// serveChunk stores one chunk and completes the upload after the last one
+func (u *UploadHandler) serveChunk(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(UploadIDHeader)
+	if id != "" && !validUploadID(id) {
+		http.Error(w, "invalid upload id", http.StatusBadRequest)
+		return
+	}
+	start, end, total, err := parseContentRange(r.Header.Get("Content-Range"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	name, err := url.PathUnescape(r.Header.Get(UploadNameHeader))
+	if err != nil {
+		http.Error(w, "invalid file name", http.StatusBadRequest)
+		return
+	}
+	info := UploadInfo{Name: filepath.Base(name), Type: r.Header.Get(UploadTypeHeader), Size: total}
+
+	if u.maxSize > 0 && total > u.maxSize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !AcceptsFile(u.accept, info.Name, info.Type) {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.Context()
+	if id == "" {
+		if info.ID, err = newUploadID(); err == nil {
+			err = u.storage.Create(ctx, info)
+		}
+		if err != nil {
+			writeUploadError(w, err)
+			return
+		}
+		id = info.ID
+	}
+
+	unlock := u.locks.lock(id)
+	defer unlock()
+
+	stored, err := u.storage.Info(ctx, id)
+	if err != nil {
+		writeUploadError(w, err)
+		return
+	}
+	if stored.Name != info.Name || stored.Type != info.Type || stored.Size != info.Size {
+		http.Error(w, "chunk does not match the upload", http.StatusBadRequest)
+		return
+	}
+
+	offset, complete, err := u.storage.Offset(ctx, id)
+	if err != nil {
+		writeUploadError(w, err)
+		return
+	}
+	if complete {
+		writeUploadStatus(w, http.StatusOK, uploadStatus{ID: id, Offset: offset, Complete: true})
+		return
+	}
+	if start != offset {
+		writeUploadStatus(w, http.StatusConflict, uploadStatus{ID: id, Offset: offset})
+		return
+	}
+
+	if length := end - start; length > 0 {
+		written, err := u.storage.WriteChunk(ctx, id, offset, http.MaxBytesReader(w, r.Body, length))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "chunk larger than its Content-Range", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		offset += written
+		if written != length {
+			writeUploadStatus(w, http.StatusBadRequest, uploadStatus{ID: id, Offset: offset})
+			return
+		}
+	}
+
+	if offset == total {
+		if err := u.storage.Complete(ctx, stored); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		complete = true
+	}
+	writeUploadStatus(w, http.StatusOK, uploadStatus{ID: id, Offset: offset, Complete: complete})
+}
+
+// writeUploadStatus writes status as JSON and mirrors the offset in a header
+func writeUploadStatus(w http.ResponseWriter, code int, status uploadStatus) {
+	w.Header().Set(UploadOffsetHeader, strconv.FormatInt(status.Offset, 10))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// writeUploadError reports a storage error, unknown IDs as 404 Not Found
+func writeUploadError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUploadNotFound) {
+		http.Error(w, "unknown upload id", http.StatusNotFound)
+		return
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// newUploadID returns a random upload ID
+func newUploadID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// parseContentRange parses "bytes start-end/total" into a half-open range
+// [start, end). "bytes */total" describes an empty chunk, used for empty files.
+func parseContentRange(header string) (start, end, total int64, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, 0, 0, errors.New("missing or invalid Content-Range")
+	}
+	rng, totalStr, ok := strings.Cut(spec, "/")
+	if !ok {
+		return 0, 0, 0, errors.New("invalid Content-Range")
+	}
+	total, err = strconv.ParseInt(totalStr, 10, 64)
+	if err != nil || total < 0 {
+		return 0, 0, 0, errors.New("invalid Content-Range total")
+	}
+	if rng == "*" {
+		return total, total, total, nil
+	}
+	startStr, endStr, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, 0, errors.New("invalid Content-Range")
+	}
+	start, err1 := strconv.ParseInt(startStr, 10, 64)
+	last, err2 := strconv.ParseInt(endStr, 10, 64)
+	if err1 != nil || err2 != nil || start < 0 || last < start || last >= total {
+		return 0, 0, 0, errors.New("invalid Content-Range")
+	}
+	return start, last + 1, total, nil
+}
+
+// validUploadID reports whether id is safe to use as a storage key and file name
+func validUploadID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// keyedMutex serializes work on the same upload ID
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock acquires the lock for key and returns its release func
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// DirUploadStorage is an UploadStorage keeping uploads in a directory.
+// Partial uploads are stored as <id>.part and renamed to <id> on completion,
+// next to an <id>.json file holding the UploadInfo. Uploads abandoned by
+// their client are kept until removed with RemoveStale.
+type DirUploadStorage struct {
+	dir string
+}
+
+// NewDirUploadStorage creates a storage writing to dir, which must exist
+func NewDirUploadStorage(dir string) *DirUploadStorage {
+	return &DirUploadStorage{dir: dir}
+}
+
+// Create implements UploadStorage
+func (s *DirUploadStorage) Create(ctx context.Context, info UploadInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filepath.Join(s.dir, info.ID+".part"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.dir, info.ID+".json"), data, 0o644)
+}
+
+// Info implements UploadStorage
+func (s *DirUploadStorage) Info(ctx context.Context, id string) (UploadInfo, error) {
+	var info UploadInfo
+	data, err := os.ReadFile(filepath.Join(s.dir, id+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return info, ErrUploadNotFound
+	}
+	if err != nil {
+		return info, err
+	}
+	err = json.Unmarshal(data, &info)
+	return info, err
+}
+
+// Offset implements UploadStorage
+func (s *DirUploadStorage) Offset(ctx context.Context, id string) (int64, bool, error) {
+	if stat, err := os.Stat(filepath.Join(s.dir, id)); err == nil {
+		return stat.Size(), true, nil
+	}
+	stat, err := os.Stat(filepath.Join(s.dir, id+".part"))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, ErrUploadNotFound
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return stat.Size(), false, nil
+}
+
+// WriteChunk implements UploadStorage
+func (s *DirUploadStorage) WriteChunk(ctx context.Context, id string, offset int64, data io.Reader) (int64, error) {
+	file, err := os.OpenFile(filepath.Join(s.dir, id+".part"), os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	written, err := io.Copy(file, data)
+	if err != nil {
+		// Drop the partial chunk so the offset stays on a chunk boundary
+		if truncErr := file.Truncate(offset); truncErr != nil {
+			return 0, truncErr
+		}
+		return 0, err
+	}
+	return written, nil
+}
+
+// Complete implements UploadStorage
+func (s *DirUploadStorage) Complete(ctx context.Context, info UploadInfo) error {
+	return os.Rename(filepath.Join(s.dir, info.ID+".part"), filepath.Join(s.dir, info.ID))
+}
+
+// RemoveStale removes the partial uploads that received no chunk for
+// maxAge. Call it periodically, uploads are otherwise kept forever.
+func (s *DirUploadStorage) RemoveStale(maxAge time.Duration) error {
+	parts, err := filepath.Glob(filepath.Join(s.dir, "*.part"))
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().Add(-maxAge)
+	for _, part := range parts {
+		stat, err := os.Stat(part)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if stat.ModTime().After(cutoff) {
+			continue
+		}
+		if err := os.Remove(part); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if err := os.Remove(strings.TrimSuffix(part, ".part") + ".json"); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Open returns the content and info of a completed upload
+func (s *DirUploadStorage) Open(id string) (*os.File, UploadInfo, error) {
+	var info UploadInfo
+	if !validUploadID(id) {
+		return nil, info, fmt.Errorf("invalid upload id %q", id)
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, id+".json"))
+	if err != nil {
+		return nil, info, err
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, info, err
+	}
+	file, err := os.Open(filepath.Join(s.dir, id))
+	if err != nil {
+		return nil, info, err
+	}
+	return file, info, nil
+}
+
+// Ensure UploadHandler implements http.Handler and DirUploadStorage implements UploadStorage
+var (
+	_ http.Handler  = (*UploadHandler)(nil)
+	_ UploadStorage = (*DirUploadStorage)(nil)
+)
@@ -0,0 +1,246 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func postChunk(handler http.Handler, id, name, contentRange, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/uploads", strings.NewReader(body))
+	if id != "" {
+		req.Header.Set(UploadIDHeader, id)
+	}
+	req.Header.Set(UploadNameHeader, name)
+	req.Header.Set(UploadTypeHeader, "text/plain")
+	req.Header.Set("Content-Range", contentRange)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeUploadStatus(t *testing.T, rec *httptest.ResponseRecorder) uploadStatus {
+	t.Helper()
+	var status uploadStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatalf("Expected JSON status, got %q: %v", rec.Body.String(), err)
+	}
+	return status
+}
+
+func TestUploadHandler_ChunksAndResume(t *testing.T) {
+	storage := NewDirUploadStorage(t.TempDir())
+	handler := NewUploadHandler(storage)
+	content := "hello chunked world"
+
+	rec := postChunk(handler, "", "notes%20v2.txt", fmt.Sprintf("bytes 0-4/%d", len(content)), content[:5])
+	if rec.Code != http.StatusOK || decodeUploadStatus(t, rec).Offset != 5 {
+		t.Fatalf("Expected first chunk to be stored, got %d %s", rec.Code, rec.Body.String())
+	}
+	id := decodeUploadStatus(t, rec).ID
+	if !validUploadID(id) || len(id) != 32 {
+		t.Fatalf("Expected the server to issue an upload ID, got %q", id)
+	}
+
+	// A client that lost track of the offset is told where to continue
+	rec = postChunk(handler, id, "notes%20v2.txt", fmt.Sprintf("bytes 10-14/%d", len(content)), content[10:15])
+	if rec.Code != http.StatusConflict || rec.Header().Get(UploadOffsetHeader) != "5" {
+		t.Fatalf("Expected 409 with offset 5, got %d %v", rec.Code, rec.Header())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/uploads?id="+id, nil))
+	if status := decodeUploadStatus(t, rec); status.Offset != 5 || status.Complete {
+		t.Fatalf("Expected resumable status at offset 5, got %+v", status)
+	}
+
+	rec = postChunk(handler, id, "notes%20v2.txt", fmt.Sprintf("bytes 5-%d/%d", len(content)-1, len(content)), content[5:])
+	status := decodeUploadStatus(t, rec)
+	if !status.Complete || status.Offset != int64(len(content)) {
+		t.Fatalf("Expected upload to complete, got %+v", status)
+	}
+
+	file, info, err := storage.Open(id)
+	if err != nil {
+		t.Fatalf("Expected completed upload to open, got %v", err)
+	}
+	defer file.Close()
+	data, _ := io.ReadAll(file)
+	if string(data) != content {
+		t.Errorf("Expected reassembled content %q, got %q", content, data)
+	}
+	if info.Name != "notes v2.txt" || info.Size != int64(len(content)) || info.Type != "text/plain" {
+		t.Errorf("Unexpected upload info: %+v", info)
+	}
+}
+
+func TestUploadHandler_EmptyFile(t *testing.T) {
+	storage := NewDirUploadStorage(t.TempDir())
+	rec := postChunk(NewUploadHandler(storage), "", "empty.txt", "bytes */0", "")
+	status := decodeUploadStatus(t, rec)
+	if !status.Complete {
+		t.Fatalf("Expected empty upload to complete, got %s", rec.Body.String())
+	}
+	file, _, err := storage.Open(status.ID)
+	if err != nil {
+		t.Fatalf("Expected empty upload to open, got %v", err)
+	}
+	file.Close()
+}
+
+func TestUploadHandler_Rejections(t *testing.T) {
+	handler := NewUploadHandler(NewDirUploadStorage(t.TempDir())).WithMaxSize(10).WithAccept(".txt")
+
+	tests := []struct {
+		name     string
+		id       string
+		fileName string
+		rng      string
+		body     string
+		expected int
+	}{
+		{"invalid id", "../etc", "a.txt", "bytes 0-0/1", "a", http.StatusBadRequest},
+		{"unknown id", "chosen-by-client", "a.txt", "bytes 0-0/1", "a", http.StatusNotFound},
+		{"missing range", "", "a.txt", "", "a", http.StatusBadRequest},
+		{"too large", "", "a.txt", "bytes 0-0/11", "a", http.StatusRequestEntityTooLarge},
+		{"wrong type", "", "a.exe", "bytes 0-0/1", "a", http.StatusUnsupportedMediaType},
+		{"oversized chunk", "", "a.txt", "bytes 0-1/5", "abcde", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := postChunk(handler, tt.id, tt.fileName, tt.rng, tt.body); rec.Code != tt.expected {
+				t.Errorf("Expected status %d, got %d: %s", tt.expected, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/uploads?id=chosen-by-client", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected the status of an unknown ID to be 404, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/uploads", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", rec.Code)
+	}
+}
+
+func TestUploadHandler_FixedInfo(t *testing.T) {
+	storage := NewDirUploadStorage(t.TempDir())
+	handler := NewUploadHandler(storage)
+
+	rec := postChunk(handler, "", "a.txt", "bytes 0-1/6", "ab")
+	id := decodeUploadStatus(t, rec).ID
+
+	// Later chunks cannot shrink the upload to complete it early, or rename it
+	if rec := postChunk(handler, id, "a.txt", "bytes 2-3/4", "cd"); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected a smaller total to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := postChunk(handler, id, "b.txt", "bytes 2-3/6", "cd"); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected a new name to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = postChunk(handler, id, "a.txt", "bytes 2-5/6", "cdef")
+	if status := decodeUploadStatus(t, rec); !status.Complete {
+		t.Fatalf("Expected upload to complete, got %+v", status)
+	}
+	file, info, err := storage.Open(id)
+	if err != nil {
+		t.Fatalf("Expected completed upload to open, got %v", err)
+	}
+	file.Close()
+	if info.Name != "a.txt" || info.Size != 6 {
+		t.Errorf("Expected the info of the first chunk, got %+v", info)
+	}
+}
+
+func TestUploadHandler_CSRF(t *testing.T) {
+	csrf := NewCSRFProtection([]byte("secret")).WithSecureCookie(false).WithHeaderName("X-Upload-Token")
+	uploads := NewUploadHandler(NewDirUploadStorage(t.TempDir()))
+	var rendered string
+	handler := csrf.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			uploads.ServeHTTP(w, r)
+			return
+		}
+		var sb strings.Builder
+		NewFileUpload().WithUploadURL("/uploads").WithCSRF(csrf, r).Render(&sb)
+		rendered = sb.String()
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	cookie := rec.Result().Cookies()[0]
+	token := csrf.sign(cookie.Value)
+	if !strings.Contains(rendered, `data-upload-csrf-header="X-Upload-Token" data-upload-csrf-token="`+token+`"`) {
+		t.Fatalf("Expected the CSRF header and token on the upload, got: %s", rendered)
+	}
+
+	send := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/uploads", strings.NewReader("a"))
+		req.Header.Set(UploadNameHeader, "a.txt")
+		req.Header.Set("Content-Range", "bytes 0-0/1")
+		req.Header.Set("Content-Type", "application/octet-stream")
+		if token != "" {
+			req.Header.Set("X-Upload-Token", token)
+		}
+		req.AddCookie(cookie)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := send(""); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected a chunk without token to be rejected, got %d", rec.Code)
+	}
+	if rec := send(token); rec.Code != http.StatusOK || !decodeUploadStatus(t, rec).Complete {
+		t.Errorf("Expected a chunk with the rendered token to complete the upload, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestDirUploadStorage_RemoveStale(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewDirUploadStorage(dir)
+	handler := NewUploadHandler(storage)
+
+	abandoned := decodeUploadStatus(t, postChunk(handler, "", "a.txt", "bytes 0-0/2", "a")).ID
+	done := decodeUploadStatus(t, postChunk(handler, "", "b.txt", "bytes 0-0/1", "b")).ID
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, abandoned+".part"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.RemoveStale(time.Hour); err != nil {
+		t.Fatalf("RemoveStale failed: %v", err)
+	}
+	if _, err := storage.Info(context.Background(), abandoned); !errors.Is(err, ErrUploadNotFound) {
+		t.Errorf("Expected the abandoned upload to be removed, got %v", err)
+	}
+	file, _, err := storage.Open(done)
+	if err != nil {
+		t.Fatalf("Expected the completed upload to be kept, got %v", err)
+	}
+	file.Close()
+}
+
+func TestParseContentRange(t *testing.T) {
+	start, end, total, err := parseContentRange("bytes 100-199/1000")
+	if err != nil || start != 100 || end != 200 || total != 1000 {
+		t.Errorf("Unexpected range: %d-%d/%d, %v", start, end, total, err)
+	}
+	for _, header := range []string{"", "bytes 5-4/10", "bytes 0-10/10", "items 0-1/2", "bytes 0-1/x"} {
+		if _, _, _, err := parseContentRange(header); err == nil {
+			t.Errorf("Expected %q to be rejected", header)
+		}
+	}
+}
@@ -0,0 +1,424 @@
+package components
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+)
+
+// Form fields used by the wizard itself
+const (
+	WizardStepField   = "_wizard_step"
+	WizardNavField    = "_wizard_nav"
+	WizardStateField  = "_wizard_state"
+	WizardClientField = "_wizard_client"
+)
+
+// Navigation values submitted in WizardNavField
+const (
+	WizardBack   = "back"
+	WizardNext   = "next"
+	WizardFinish = "finish"
+)
+
+// ErrWizardState is returned by Process when the signed state token was tampered with
+var ErrWizardState = errors.New("invalid wizard state")
+
+// WizardStep is a step of a WizardComponent
+type WizardStep struct {
+	Title       string
+	Description string
+	Icon        string
+	// Fields lists the names of the form fields rendered by Content
+	Fields []string
+	// Content renders the fields of the step with the values entered so far
+	// and the errors of the last validation, keyed by field name
+	Content func(values url.Values, errors map[string]string) g.Node
+	// Validate checks the fields of the step and returns error messages keyed
+	// by field name; nil or empty means the step is valid
+	Validate func(values url.Values) map[string]string
+}
+
+// carried reports whether values hold any field of the step
+func (s WizardStep) carried(values url.Values) bool {
+	for _, field := range s.Fields {
+		if _, ok := values[field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WizardState is the progress through a wizard, as returned by Process
+type WizardState struct {
+	// Step is the zero-based index of the current step
+	Step int
+	// Values holds the values of every step entered so far
+	Values url.Values
+	// Errors holds the validation errors of the current step
+	Errors map[string]string
+	// Done is set once finish was submitted and every step is valid
+	Done bool
+}
+
+// WizardRequired returns a Validate function reporting empty fields
+func WizardRequired(message string, fields ...string) func(url.Values) map[string]string {
+	return func(values url.Values) map[string]string {
+		errs := make(map[string]string)
+		for _, field := range fields {
+			if strings.TrimSpace(values.Get(field)) == "" {
+				errs[field] = message
+			}
+		}
+		return errs
+	}
+}
+
+// WizardComponent splits a form across steps. Without JavaScript every
+// step is a round trip: Process reads the submitted step, validates it and
+// moves on, while the values of the other steps travel along in hidden
+// inputs or a signed token. With the WASM behavior, steps switch on the
+// client and the whole form is submitted once at the end.
+type WizardComponent struct {
+	id       string
+	action   string
+	steps    []WizardStep
+	state    WizardState
+	secret   []byte
+	vertical bool
+	labels   [3]string
+	color    flyon.Color
+	classes  []string
+}
+
+// NewWizard creates a new wizard posting to the current URL
+func NewWizard(id string, steps ...WizardStep) *WizardComponent {
+	return &WizardComponent{
+		id:      id,
+		steps:   append([]WizardStep(nil), steps...),
+		state:   WizardState{Values: url.Values{}},
+		labels:  [3]string{"Back", "Next", "Finish"},
+		color:   flyon.Primary,
+		classes: make([]string, 0),
+	}
+}
+
+// WithAction sets the URL the wizard posts to
+func (wz *WizardComponent) WithAction(action string) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.action = action
+	return newWizard
+}
+
+// WithState renders the wizard at the state returned by Process
+func (wz *WizardComponent) WithState(state WizardState) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.state = state
+	if newWizard.state.Values == nil {
+		newWizard.state.Values = url.Values{}
+	}
+	return newWizard
+}
+
+// WithSecret carries the values of other steps in a token signed with
+// secret and bound to the wizard ID instead of plain hidden inputs, so they
+// cannot be altered between steps after validation. Signed values win over
+// submitted ones even in client mode, where the WASM behavior keeps the
+// steps they belong to read-only.
+func (wz *WizardComponent) WithSecret(secret []byte) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.secret = secret
+	return newWizard
+}
+
+// WithVertical shows the steps in a vertical stepper
+func (wz *WizardComponent) WithVertical(vertical bool) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.vertical = vertical
+	return newWizard
+}
+
+// WithButtonLabels sets the labels of the back, next and finish buttons
+func (wz *WizardComponent) WithButtonLabels(back, next, finish string) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.labels = [3]string{back, next, finish}
+	return newWizard
+}
+
+// WithColor sets the color of the stepper and buttons
+func (wz *WizardComponent) WithColor(color flyon.Color) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.color = color
+	return newWizard
+}
+
+// WithClasses adds additional CSS classes to the form
+func (wz *WizardComponent) WithClasses(classes ...string) *WizardComponent {
+	newWizard := wz.copy()
+	newWizard.classes = append(newWizard.classes, classes...)
+	return newWizard
+}
+
+// With applies modifiers to the wizard
+func (wz *WizardComponent) With(modifiers ...any) flyon.Component {
+	newWizard := wz.copy()
+	for _, modifier := range modifiers {
+		switch m := modifier.(type) {
+		case flyon.Color:
+			newWizard.color = m
+		case string:
+			newWizard.classes = append(newWizard.classes, m)
+		}
+	}
+	return newWizard
+}
+
+// copy creates a deep copy of the wizard
+func (wz *WizardComponent) copy() *WizardComponent {
+	newWizard := *wz
+	newWizard.steps = append([]WizardStep(nil), wz.steps...)
+	newWizard.classes = make([]string, len(wz.classes))
+	copy(newWizard.classes, wz.classes)
+	return &newWizard
+}
+
+// Process reads a submitted wizard step from r and returns the new state:
+// back moves to the previous step, next validates the current step before
+// advancing, and finish validates every step, stopping at the first invalid
+// one. Requests without wizard fields start at the first step.
+func (wz *WizardComponent) Process(r *http.Request) (WizardState, error) {
+	state := WizardState{Values: url.Values{}}
+	if err := r.ParseForm(); err != nil {
+		return state, err
+	}
+	form := r.PostForm
+	if form.Get(WizardStepField) == "" || len(wz.steps) == 0 {
+		return state, nil
+	}
+	step, err := strconv.Atoi(form.Get(WizardStepField))
+	if err != nil {
+		return state, err
+	}
+	step = min(max(step, 0), len(wz.steps)-1)
+	client := form.Get(WizardClientField) != ""
+
+	// The client flag is only trusted for steps no signed token vouches for
+	var carried url.Values
+	if wz.secret != nil {
+		if carried, err = wz.verify(form.Get(WizardStateField)); err != nil {
+			return state, err
+		}
+	}
+	for i, s := range wz.steps {
+		source := form
+		switch {
+		case carried == nil:
+			// Without a secret every field comes from the form
+		case s.carried(carried):
+			source = carried
+		case i != step && !client:
+			source = carried
+		}
+		for _, field := range s.Fields {
+			// Unchecked checkboxes submit nothing, so missing fields are empty
+			if values, ok := source[field]; ok {
+				state.Values[field] = values
+			}
+		}
+	}
+
+	state.Step = step
+	switch nav := form.Get(WizardNavField); {
+	case nav == WizardBack:
+		state.Step = max(step-1, 0)
+	case nav == WizardFinish || (nav == WizardNext && step == len(wz.steps)-1):
+		for i := range wz.steps {
+			if errs := wz.validate(i, state.Values); len(errs) > 0 {
+				state.Step, state.Errors = i, errs
+				return state, nil
+			}
+		}
+		state.Done = true
+	default:
+		if errs := wz.validate(step, state.Values); len(errs) > 0 {
+			state.Errors = errs
+			return state, nil
+		}
+		state.Step = step + 1
+	}
+	return state, nil
+}
+
+// validate runs the validation of step i
+func (wz *WizardComponent) validate(i int, values url.Values) map[string]string {
+	if wz.steps[i].Validate == nil {
+		return nil
+	}
+	return wz.steps[i].Validate(values)
+}
+
+// sign encodes values as a token signed with the wizard secret, prefixed
+// with the wizard ID so it is only accepted by the wizard that issued it
+func (wz *WizardComponent) sign(values url.Values) string {
+	return signToken(wz.secret, signingWizard, []byte(wz.id+"\n"+values.Encode()))
+}
+
+// verify decodes a token created by sign
+func (wz *WizardComponent) verify(token string) (url.Values, error) {
+	if token == "" {
+		return url.Values{}, nil
+	}
+	payload, ok := verifyToken(wz.secret, signingWizard, token)
+	if !ok {
+		return nil, ErrWizardState
+	}
+	encoded, ok := strings.CutPrefix(string(payload), wz.id+"\n")
+	if !ok {
+		return nil, ErrWizardState
+	}
+	values, err := url.ParseQuery(encoded)
+	if err != nil {
+		return nil, ErrWizardState
+	}
+	return values, nil
+}
+
+// wizardData is the data-wizard configuration read by the WASM behavior
+type wizardData struct {
+	Step  int `json:"step"`
+	Steps int `json:"steps"`
+}
+
+// Render generates the HTML for the wizard. Every step is rendered, but only
+// the current one is visible and enabled; the disabled fieldsets of the
+// others neither submit nor block native validation.
+func (wz *WizardComponent) Render(w io.Writer) error {
+	state := wz.state
+	step := min(max(state.Step, 0), max(len(wz.steps)-1, 0))
+	last := len(wz.steps) - 1
+
+	steps := make([]Step, len(wz.steps))
+	for i, s := range wz.steps {
+		steps[i] = Step{Title: s.Title, Description: s.Description, Icon: s.Icon}
+	}
+	stepper := NewStepper(steps...).WithActive(step).WithVertical(wz.vertical).WithColor(wz.color)
+	if len(state.Errors) > 0 {
+		stepper = stepper.WithStepState(step, StepError)
+	}
+
+	config, _ := json.Marshal(wizardData{Step: step, Steps: len(wz.steps)})
+	nodes := []g.Node{
+		h.ID(wz.id),
+		h.Method("post"),
+		g.If(wz.action != "", h.Action(wz.action)),
+		h.Class(strings.Join(append([]string{"w-full"}, wz.classes...), " ")),
+		g.Attr("data-wizard", string(config)),
+		h.Input(h.Type("hidden"), h.Name(WizardStepField), h.Value(strconv.Itoa(step)), g.Attr("data-wizard-step-input", "")),
+		h.Input(h.Type("hidden"), h.Name(WizardClientField), h.Value(""), g.Attr("data-wizard-client", "")),
+	}
+
+	// Carry the values of the other steps
+	carried := url.Values{}
+	for i, s := range wz.steps {
+		if i == step {
+			continue
+		}
+		for _, field := range s.Fields {
+			if values, ok := state.Values[field]; ok {
+				carried[field] = values
+			}
+		}
+	}
+	if wz.secret != nil {
+		nodes = append(nodes, h.Input(h.Type("hidden"), h.Name(WizardStateField), h.Value(wz.sign(carried)), g.Attr("data-wizard-state", "")))
+	} else {
+		fields := make([]string, 0, len(carried))
+		for field := range carried {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			for _, value := range carried[field] {
+				nodes = append(nodes, h.Input(h.Type("hidden"), h.Name(field), h.Value(value), g.Attr("data-wizard-carry", "")))
+			}
+		}
+	}
+
+	panels := make([]g.Node, len(wz.steps))
+	for i, s := range wz.steps {
+		var errs map[string]string
+		if i == step {
+			errs = state.Errors
+		}
+		var content g.Node
+		if s.Content != nil {
+			content = s.Content(state.Values, errs)
+		}
+		panels[i] = h.FieldSet(
+			h.Class("min-w-0"),
+			g.Attr("data-wizard-panel", strconv.Itoa(i)),
+			g.Attr("aria-label", s.Title),
+			g.If(i != step, h.Disabled()),
+			g.If(i != step, g.Attr("hidden", "")),
+			g.If(wz.secret != nil && s.carried(carried), g.Attr("data-wizard-signed", "")),
+			content,
+		)
+	}
+
+	layout := h.Div(h.Class("mt-5"), g.Group(panels))
+	if wz.vertical {
+		nodes = append(nodes, h.Div(h.Class("flex gap-8"), h.Div(h.Class("shrink-0"), stepper), h.Div(h.Class("grow"), g.Group(panels))))
+	} else {
+		nodes = append(nodes, stepper, layout)
+	}
+
+	nodes = append(nodes, h.Div(
+		h.Class("mt-5 flex items-center justify-between gap-x-2"),
+		// Enter submits with the first button in the markup, which must not be back
+		h.Div(
+			h.Class("order-last flex gap-x-2"),
+			wz.button(WizardNext, wz.labels[1], step == last, nil),
+			wz.button(WizardFinish, wz.labels[2], step != last, nil),
+		),
+		wz.button(WizardBack, wz.labels[0], step == 0, flyon.VariantSoft),
+	))
+
+	return h.Form(nodes...).Render(w)
+}
+
+// button renders a navigation button; hidden buttons stay in the markup for
+// the WASM behavior to reveal
+func (wz *WizardComponent) button(nav, label string, hidden bool, variant any) g.Node {
+	children := []g.Node{
+		h.Type("submit"),
+		h.Name(WizardNavField),
+		h.Value(nav),
+		g.Attr("data-wizard-"+nav, ""),
+		g.Text(label),
+	}
+	if nav == WizardBack {
+		// Going back saves the step without validating it
+		children = append(children, g.Attr("formnovalidate", "formnovalidate"))
+	}
+	modifiers := []any{wz.color}
+	if variant != nil {
+		modifiers = append(modifiers, variant)
+	}
+	if hidden {
+		modifiers = append(modifiers, "hidden")
+	}
+	return NewButton(children...).With(modifiers...)
+}
+
+// Ensure WizardComponent implements flyon.Component
+var _ flyon.Component = (*WizardComponent)(nil)
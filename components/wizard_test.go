@@ -0,0 +1,137 @@
+package components
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	g "maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+)
+
+func testWizard() *WizardComponent {
+	field := func(name string) func(url.Values, map[string]string) g.Node {
+		return func(values url.Values, errs map[string]string) g.Node {
+			return h.Div(
+				h.Input(h.Name(name), h.Value(values.Get(name)), h.Required()),
+				g.If(errs[name] != "", h.Span(h.Class("label-text-alt text-error"), g.Text(errs[name]))),
+			)
+		}
+	}
+	return NewWizard("signup",
+		WizardStep{Title: "Account", Fields: []string{"email"}, Content: field("email"), Validate: WizardRequired("Required", "email")},
+		WizardStep{Title: "Profile", Fields: []string{"name"}, Content: field("name"), Validate: WizardRequired("Required", "name")},
+		WizardStep{Title: "Review"},
+	)
+}
+
+func postWizard(form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestWizardComponent_Render(t *testing.T) {
+	html := renderToStringInput(testWizard().WithState(WizardState{Step: 1, Values: url.Values{"email": {"a@b.c"}}}))
+
+	for _, want := range []string{
+		`<form id="signup" method="post"`,
+		`data-wizard="{&#34;step&#34;:1,&#34;steps&#34;:3}"`,
+		`name="_wizard_step" value="1"`,
+		`data-wizard-client`,
+		`name="email" value="a@b.c" data-wizard-carry`,
+		`data-wizard-panel="0" aria-label="Account" disabled hidden`,
+		`data-wizard-panel="1" aria-label="Profile">`,
+		`formnovalidate`,
+		`data-wizard-finish`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in wizard, got: %s", want, html)
+		}
+	}
+	if strings.Index(html, "data-wizard-next") > strings.Index(html, "data-wizard-back") {
+		t.Errorf("Expected next to precede back so Enter advances, got: %s", html)
+	}
+}
+
+func TestWizardComponent_ProcessNavigation(t *testing.T) {
+	wizard := testWizard()
+
+	state, err := wizard.Process(httptest.NewRequest(http.MethodGet, "/signup", nil))
+	if err != nil || state.Step != 0 {
+		t.Fatalf("Expected initial state, got %+v, %v", state, err)
+	}
+
+	state, _ = wizard.Process(postWizard(url.Values{"_wizard_step": {"0"}, "_wizard_nav": {"next"}}))
+	if state.Step != 0 || state.Errors["email"] != "Required" {
+		t.Errorf("Expected invalid step to stay with errors, got %+v", state)
+	}
+	html := renderToStringInput(wizard.WithState(state))
+	if !strings.Contains(html, "Required") || !strings.Contains(html, "gap-x-2 error") {
+		t.Errorf("Expected errors to render, got: %s", html)
+	}
+
+	state, _ = wizard.Process(postWizard(url.Values{"_wizard_step": {"0"}, "_wizard_nav": {"next"}, "email": {"a@b.c"}}))
+	if state.Step != 1 || len(state.Errors) != 0 {
+		t.Errorf("Expected to advance, got %+v", state)
+	}
+
+	state, _ = wizard.Process(postWizard(url.Values{"_wizard_step": {"1"}, "_wizard_nav": {"back"}, "email": {"a@b.c"}}))
+	if state.Step != 0 || len(state.Errors) != 0 {
+		t.Errorf("Expected back without validation, got %+v", state)
+	}
+
+	state, _ = wizard.Process(postWizard(url.Values{"_wizard_step": {"2"}, "_wizard_nav": {"finish"}, "name": {"Ann"}}))
+	if state.Done || state.Step != 0 {
+		t.Errorf("Expected finish to stop at the first invalid step, got %+v", state)
+	}
+
+	state, _ = wizard.Process(postWizard(url.Values{"_wizard_step": {"2"}, "_wizard_nav": {"finish"}, "name": {"Ann"}, "email": {"a@b.c"}}))
+	if !state.Done || state.Values.Get("name") != "Ann" {
+		t.Errorf("Expected done, got %+v", state)
+	}
+}
+
+func TestWizardComponent_SignedState(t *testing.T) {
+	wizard := testWizard().WithSecret([]byte("secret"))
+	html := renderToStringInput(wizard.WithState(WizardState{Step: 1, Values: url.Values{"email": {"a@b.c"}}}))
+	if strings.Contains(html, `name="email" value="a@b.c" data-wizard-carry`) {
+		t.Errorf("Expected values to be carried in a token, got: %s", html)
+	}
+	start := strings.Index(html, `name="_wizard_state" value="`)
+	if start < 0 {
+		t.Fatalf("Expected a state token, got: %s", html)
+	}
+	token := html[start+len(`name="_wizard_state" value="`):]
+	token = token[:strings.Index(token, `"`)]
+
+	form := url.Values{"_wizard_step": {"1"}, "_wizard_nav": {"next"}, "name": {"Ann"}, "email": {"evil"}, "_wizard_state": {token}}
+	state, err := wizard.Process(postWizard(form))
+	if err != nil || state.Step != 2 || state.Values.Get("email") != "a@b.c" {
+		t.Errorf("Expected the signed email to win, got %+v, %v", state, err)
+	}
+
+	form.Set("_wizard_state", token+"x")
+	if _, err := wizard.Process(postWizard(form)); !errors.Is(err, ErrWizardState) {
+		t.Errorf("Expected tampered token to fail, got %v", err)
+	}
+
+	form.Set("_wizard_state", token)
+	form.Set("_wizard_client", "1")
+	form.Set("_wizard_step", "0")
+	state, err = wizard.Process(postWizard(form))
+	if err != nil || state.Values.Get("email") != "a@b.c" || state.Values.Get("name") != "Ann" {
+		t.Errorf("Expected the signed email to win in client mode, got %+v, %v", state, err)
+	}
+	if !strings.Contains(html, `data-wizard-panel="0" aria-label="Account" disabled hidden="" data-wizard-signed=""`) {
+		t.Errorf("Expected the signed step to be marked, got: %s", html)
+	}
+
+	other := NewWizard("other", testWizard().steps...).WithSecret([]byte("secret"))
+	if _, err := other.Process(postWizard(form)); !errors.Is(err, ErrWizardState) {
+		t.Errorf("Expected a token of another wizard to fail, got %v", err)
+	}
+}
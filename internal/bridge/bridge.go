@@ -0,0 +1,178 @@
+//go:build js && wasm
+
+// Package bridge isolates the syscall/js calls the hydration layer needs for
+// APIs that honnef.co/go/js/dom/v2 does not wrap, most notably FlyonUI's
+// HSStaticMethods.
+package bridge
+
+import (
+	"syscall/js"
+
+	"honnef.co/go/js/dom/v2"
+)
+
+// GoStringsToJSArray converts a Go string slice to a JavaScript Array
+func GoStringsToJSArray(items []string) js.Value {
+	jsArray := js.Global().Get("Array").New(len(items))
+	for i, item := range items {
+		jsArray.SetIndex(i, item)
+	}
+	return jsArray
+}
+
+// InitializeFlyonComponents calls HSStaticMethods.autoInit for the given
+// component names, or for all components when none are given. It reports
+// whether flyonui.js was available.
+func InitializeFlyonComponents(components ...string) bool {
+	hsStaticMethods := js.Global().Get("HSStaticMethods")
+	if hsStaticMethods.IsUndefined() {
+		return false
+	}
+	if len(components) == 0 {
+		hsStaticMethods.Call("autoInit")
+		return true
+	}
+	hsStaticMethods.Call("autoInit", GoStringsToJSArray(components))
+	return true
+}
+
+// HasGlobal reports whether a global JavaScript object (e.g. a FlyonUI
+// plugin class such as HSDropdown) is defined
+func HasGlobal(name string) bool {
+	v := js.Global().Get(name)
+	return !v.IsUndefined() && !v.IsNull()
+}
+
+// NewEvent creates a bubbling DOM event of the given type
+func NewEvent(typ string) dom.Event {
+	return dom.WrapEvent(js.Global().Get("Event").New(typ, map[string]any{"bubbles": true}))
+}
+
+// NewCustomEvent creates a bubbling CustomEvent carrying detail
+func NewCustomEvent(typ string, detail any) dom.Event {
+	return dom.WrapEvent(js.Global().Get("CustomEvent").New(typ, map[string]any{
+		"bubbles": true,
+		"detail":  detail,
+	}))
+}
+
+// Flatpickr initializes flatpickr on el with the JSON encoded options. Dates
+// falling on one of disabledWeekdays (0 = Sunday) are disabled and, when
+// firstDay is not negative, it overrides the first day of the week of the
+// configured locale. A rangeSeparator option overrides the one of the
+// locale as well. It reports whether flatpickr was available.
+func Flatpickr(el dom.Element, options string, disabledWeekdays []int, firstDay int) bool {
+	flatpickr := js.Global().Get("flatpickr")
+	if flatpickr.IsUndefined() {
+		return false
+	}
+	opts := js.Global().Get("JSON").Call("parse", options)
+
+	if len(disabledWeekdays) > 0 {
+		disabled := make(map[int]bool, len(disabledWeekdays))
+		for _, day := range disabledWeekdays {
+			disabled[day] = true
+		}
+		// The function is owned by the flatpickr instance for the lifetime of
+		// the page, so it is intentionally never released
+		rule := js.FuncOf(func(this js.Value, args []js.Value) any {
+			return len(args) > 0 && disabled[args[0].Call("getDay").Int()]
+		})
+		disable := opts.Get("disable")
+		if disable.IsUndefined() {
+			disable = js.Global().Get("Array").New()
+			opts.Set("disable", disable)
+		}
+		disable.Call("push", rule)
+	}
+
+	separator := opts.Get("rangeSeparator")
+	if firstDay >= 0 || separator.Type() == js.TypeString {
+		locale := js.Global().Get("Object").New()
+		if name := opts.Get("locale"); name.Type() == js.TypeString {
+			if l10n := flatpickr.Get("l10ns").Get(name.String()); !l10n.IsUndefined() {
+				js.Global().Get("Object").Call("assign", locale, l10n)
+			}
+		}
+		if firstDay >= 0 {
+			locale.Set("firstDayOfWeek", firstDay)
+		}
+		if separator.Type() == js.TypeString {
+			locale.Set("rangeSeparator", separator)
+			opts.Delete("rangeSeparator")
+		}
+		opts.Set("locale", locale)
+	}
+
+	flatpickr.Invoke(el.Underlying(), opts)
+	return true
+}
+
+// DroppedFiles returns the files carried by a drop event
+func DroppedFiles(event dom.Event) []*dom.File {
+	transfer := event.Underlying().Get("dataTransfer")
+	if transfer.IsUndefined() || transfer.IsNull() {
+		return nil
+	}
+	files := transfer.Get("files")
+	out := make([]*dom.File, files.Get("length").Int())
+	for i := range out {
+		out[i] = &dom.File{Value: files.Call("item", i)}
+	}
+	return out
+}
+
+// SetInputFiles replaces the files of a file input, e.g. with dropped files
+func SetInputFiles(input *dom.HTMLInputElement, files []*dom.File) {
+	transfer := js.Global().Get("DataTransfer").New()
+	for _, file := range files {
+		transfer.Get("items").Call("add", file.Value)
+	}
+	input.Set("files", transfer.Get("files"))
+}
+
+// ReadFileRange reads bytes [start, end) of file. It blocks until the
+// browser has read the data and must not be called from an event handler
+// goroutine.
+func ReadFileRange(file *dom.File, start, end int64) ([]byte, error) {
+	buffer, err := Await(file.Call("slice", start, end).Call("arrayBuffer"))
+	if err != nil {
+		return nil, err
+	}
+	array := js.Global().Get("Uint8Array").New(buffer)
+	data := make([]byte, array.Get("length").Int())
+	js.CopyBytesToGo(data, array)
+	return data, nil
+}
+
+// Await blocks until promise settles and returns its value or rejection
+func Await(promise js.Value) (js.Value, error) {
+	type result struct {
+		value js.Value
+		err   error
+	}
+	done := make(chan result, 1)
+	onResolve := js.FuncOf(func(this js.Value, args []js.Value) any {
+		done <- result{value: args[0]}
+		return nil
+	})
+	onReject := js.FuncOf(func(this js.Value, args []js.Value) any {
+		done <- result{err: js.Error{Value: args[0]}}
+		return nil
+	})
+	defer onResolve.Release()
+	defer onReject.Release()
+	promise.Call("then", onResolve, onReject)
+	r := <-done
+	return r.value, r.err
+}
+
+// CreateObjectURL returns a blob: URL for file, e.g. for image previews
+func CreateObjectURL(file *dom.File) string {
+	return js.Global().Get("URL").Call("createObjectURL", file.Value).String()
+}
+
+// RevokeObjectURL releases a URL created with CreateObjectURL
+func RevokeObjectURL(url string) {
+	js.Global().Get("URL").Call("revokeObjectURL", url)
+}
@@ -0,0 +1,135 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"syscall/js"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// Accordion item events, dispatched on the item by FlyonUI's HSAccordion or
+// by the fallback below
+const (
+	AccordionOpenEvent  = "open.hs.accordion"
+	AccordionCloseEvent = "close.hs.accordion"
+)
+
+// HydrateAccordion makes AccordionComponents below root open and close their
+// items when FlyonUI's HSAccordion is not loaded
+func HydrateAccordion(root Root) {
+	if bridge.HasGlobal("HSAccordion") {
+		return
+	}
+	for _, el := range root.QuerySelectorAll("[data-component=accordion]") {
+		if markHydrated(el, "accordion") {
+			continue
+		}
+		for _, item := range accordionItems(el) {
+			if toggle := item.QuerySelector(":scope > .accordion-toggle"); toggle != nil {
+				toggle.AddEventListener("click", false, func(dom.Event) { ToggleAccordionItem(item) })
+			}
+		}
+	}
+}
+
+// OpenAccordionItem opens item, an item of a rendered AccordionComponent,
+// closing its siblings unless the accordion is always open
+func OpenAccordionItem(item dom.Element) {
+	if hs, ok := hsAccordion(); ok {
+		hs.Call("show", item.Underlying())
+		return
+	}
+	if item.Class().Contains("active") {
+		return
+	}
+	if accordion := item.ParentElement(); accordion != nil && !accordion.HasAttribute("data-accordion-always-open") {
+		for _, sibling := range accordionItems(accordion) {
+			if !sibling.Underlying().Equal(item.Underlying()) {
+				CloseAccordionItem(sibling)
+			}
+		}
+	}
+	setAccordionItemOpen(item, true)
+	item.DispatchEvent(bridge.NewCustomEvent(AccordionOpenEvent, item.Underlying()))
+}
+
+// CloseAccordionItem closes item
+func CloseAccordionItem(item dom.Element) {
+	if hs, ok := hsAccordion(); ok {
+		hs.Call("hide", item.Underlying())
+		return
+	}
+	if !item.Class().Contains("active") {
+		return
+	}
+	setAccordionItemOpen(item, false)
+	item.DispatchEvent(bridge.NewCustomEvent(AccordionCloseEvent, item.Underlying()))
+}
+
+// ToggleAccordionItem opens item when closed and closes it when open
+func ToggleAccordionItem(item dom.Element) {
+	if item.Class().Contains("active") {
+		CloseAccordionItem(item)
+	} else {
+		OpenAccordionItem(item)
+	}
+}
+
+// OnAccordionToggle calls fn with the new state whenever item opens or
+// closes. Events of nested accordions are ignored. The returned function
+// removes the callback.
+func OnAccordionToggle(item dom.Element, fn func(open bool)) (remove func()) {
+	handler := func(open bool) func(dom.Event) {
+		return func(event dom.Event) {
+			if target := event.Target(); target != nil && target.Underlying().Equal(item.Underlying()) {
+				fn(open)
+			}
+		}
+	}
+	opened := item.AddEventListener(AccordionOpenEvent, false, handler(true))
+	closed := item.AddEventListener(AccordionCloseEvent, false, handler(false))
+	return func() {
+		item.RemoveEventListener(AccordionOpenEvent, false, opened)
+		item.RemoveEventListener(AccordionCloseEvent, false, closed)
+		opened.Release()
+		closed.Release()
+	}
+}
+
+// accordionItems returns the items of the accordion el, excluding those of
+// nested accordions
+func accordionItems(el dom.Element) []dom.Element {
+	return el.QuerySelectorAll(":scope > .accordion-item")
+}
+
+// setAccordionItemOpen reflects the state of item on its toggle and content
+func setAccordionItemOpen(item dom.Element, open bool) {
+	expanded := "false"
+	if open {
+		expanded = "true"
+		item.Class().Add("active")
+	} else {
+		item.Class().Remove("active")
+	}
+	if toggle := item.QuerySelector(":scope > .accordion-toggle"); toggle != nil {
+		toggle.SetAttribute("aria-expanded", expanded)
+	}
+	if content := item.QuerySelector(":scope > .accordion-content"); content != nil {
+		if open {
+			content.Class().Remove("hidden")
+		} else {
+			content.Class().Add("hidden")
+		}
+	}
+}
+
+// hsAccordion returns FlyonUI's HSAccordion class, if loaded
+func hsAccordion() (js.Value, bool) {
+	if !bridge.HasGlobal("HSAccordion") {
+		return js.Undefined(), false
+	}
+	return js.Global().Get("HSAccordion"), true
+}
@@ -0,0 +1,43 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateAlert dispatches an "alert:dismiss" event on dismissible alerts
+// below root when their close button is clicked. Without FlyonUI's
+// HSRemoveElement it also plays the removing transition and removes the alert.
+func HydrateAlert(root Root) {
+	for _, button := range root.QuerySelectorAll("[data-alert-dismiss]") {
+		alert := button.Closest(".alert")
+		if alert == nil || markHydrated(button, "alert") {
+			continue
+		}
+		button.AddEventListener("click", false, func(dom.Event) {
+			alert.DispatchEvent(bridge.NewEvent("alert:dismiss"))
+			if bridge.HasGlobal("HSRemoveElement") {
+				return
+			}
+			alert.Class().Add("removing")
+			dom.GetWindow().SetTimeout(func() {
+				if parent := alert.ParentNode(); parent != nil {
+					parent.RemoveChild(alert)
+				}
+			}, 300)
+		})
+	}
+}
+
+// OnAlertDismiss calls fn when the dismissible alert el is closed. The
+// returned function removes the callback.
+func OnAlertDismiss(el dom.Element, fn func()) (remove func()) {
+	listener := el.AddEventListener("alert:dismiss", false, func(dom.Event) { fn() })
+	return func() {
+		el.RemoveEventListener("alert:dismiss", false, listener)
+		listener.Release()
+	}
+}
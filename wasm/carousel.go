@@ -0,0 +1,324 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"syscall/js"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// CarouselUpdateEvent is dispatched on a carousel, by FlyonUI's HSCarousel or
+// the fallback below, when its current slide changes. Its detail is the
+// index of the slide.
+const CarouselUpdateEvent = "update.hs.carousel"
+
+// carouselGoToEvent asks the fallback of a carousel to show the slide at the
+// index in its detail
+const carouselGoToEvent = "carousel:goto"
+
+// carouselBreakpoints are the minimum widths of the breakpoints used as keys
+// of slidesQty, smallest first
+var carouselBreakpoints = []struct {
+	name  string
+	width int
+}{{"xs", 0}, {"sm", 640}, {"md", 768}, {"lg", 1024}, {"xl", 1280}, {"2xl", 1536}}
+
+// carouselOptions is the data-carousel JSON rendered by CarouselComponent
+type carouselOptions struct {
+	LoadingClasses string         `json:"loadingClasses"`
+	CurrentIndex   int            `json:"currentIndex"`
+	IsAutoPlay     bool           `json:"isAutoPlay"`
+	Speed          int            `json:"speed"`
+	IsInfiniteLoop bool           `json:"isInfiniteLoop"`
+	IsSnap         bool           `json:"isSnap"`
+	SlidesQty      map[string]int `json:"slidesQty"`
+}
+
+// carousel is the fallback behavior of a carousel without HSCarousel
+type carousel struct {
+	el, body   dom.Element
+	slides     []dom.Element
+	items      []dom.Element
+	prev, next dom.Element
+	options    carouselOptions
+	index      int
+	timer      int
+}
+
+// HydrateCarousel makes CarouselComponents below root slide when FlyonUI's
+// HSCarousel is not loaded, and keeps the current indicator announced to
+// assistive technology either way
+func HydrateCarousel(root Root) {
+	for _, el := range root.QuerySelectorAll("[data-carousel]") {
+		if markHydrated(el, "carousel") {
+			continue
+		}
+		el.AddEventListener(CarouselUpdateEvent, false, func(event dom.Event) {
+			if event.Target().Underlying().Equal(el.Underlying()) {
+				setCarouselCurrent(el.QuerySelectorAll(".carousel-pagination-item"), event.Underlying().Get("detail").Int())
+			}
+		})
+		if bridge.HasGlobal("HSCarousel") {
+			continue
+		}
+
+		c := &carousel{
+			el:     el,
+			body:   el.QuerySelector(".carousel-body"),
+			slides: el.QuerySelectorAll(".carousel-slide"),
+			items:  el.QuerySelectorAll(".carousel-pagination-item"),
+			prev:   el.QuerySelector(".carousel-prev"),
+			next:   el.QuerySelector(".carousel-next"),
+		}
+		if c.body == nil || len(c.slides) == 0 {
+			continue
+		}
+		_ = json.Unmarshal([]byte(el.GetAttribute("data-carousel")), &c.options)
+		c.init()
+	}
+}
+
+// GoToSlide shows the slide at index of el, a rendered CarouselComponent
+func GoToSlide(el dom.Element, index int) {
+	if hs, ok := hsCarousel(el); ok {
+		hs.Call("goTo", index)
+		return
+	}
+	el.DispatchEvent(bridge.NewCustomEvent(carouselGoToEvent, index))
+}
+
+// OnSlideChange calls fn with the index of the current slide whenever it
+// changes on the carousel el. The returned function removes the callback.
+func OnSlideChange(el dom.Element, fn func(index int)) (remove func()) {
+	listener := el.AddEventListener(CarouselUpdateEvent, false, func(event dom.Event) {
+		if event.Target().Underlying().Equal(el.Underlying()) {
+			fn(event.Underlying().Get("detail").Int())
+		}
+	})
+	return func() {
+		el.RemoveEventListener(CarouselUpdateEvent, false, listener)
+		listener.Release()
+	}
+}
+
+// init lays the slides out and wires the controls
+func (c *carousel) init() {
+	for _, class := range strings.Fields(c.options.LoadingClasses) {
+		c.body.Class().Remove(class)
+	}
+	c.index = c.options.CurrentIndex
+
+	if c.prev != nil {
+		c.prev.AddEventListener("click", false, func(dom.Event) { c.goTo(c.index - 1) })
+	}
+	if c.next != nil {
+		c.next.AddEventListener("click", false, func(dom.Event) { c.goTo(c.index + 1) })
+	}
+	for i, item := range c.items {
+		item.AddEventListener("click", false, func(dom.Event) { c.goTo(i) })
+		item.AddEventListener("keydown", false, func(event dom.Event) {
+			if key := event.(*dom.KeyboardEvent).Key(); key == "Enter" || key == " " {
+				event.PreventDefault()
+				c.goTo(i)
+			}
+		})
+	}
+	c.el.AddEventListener("keydown", false, func(event dom.Event) {
+		switch event.(*dom.KeyboardEvent).Key() {
+		case "ArrowLeft":
+			c.goTo(c.index - c.direction())
+		case "ArrowRight":
+			c.goTo(c.index + c.direction())
+		}
+	})
+	c.el.AddEventListener(carouselGoToEvent, false, func(event dom.Event) {
+		c.goTo(event.Underlying().Get("detail").Int())
+	})
+	dom.GetWindow().AddEventListener("resize", false, func(dom.Event) { c.render() })
+
+	if c.options.IsSnap {
+		// Native scrolling moves the slides, follow it
+		c.body.AddEventListener("scroll", false, func(dom.Event) { c.followScroll() })
+	} else {
+		c.body.Underlying().Get("style").Set("display", "flex")
+		c.body.Underlying().Get("style").Set("transition", "transform 0.5s ease")
+	}
+
+	if c.options.IsAutoPlay && c.options.Speed > 0 {
+		c.play()
+		c.el.AddEventListener("mouseenter", false, func(dom.Event) { c.pause() })
+		c.el.AddEventListener("mouseleave", false, func(dom.Event) { c.play() })
+	}
+	c.render()
+}
+
+// perView returns the number of slides shown at the current window width
+func (c *carousel) perView() int {
+	count, width := 1, dom.GetWindow().InnerWidth()
+	for _, breakpoint := range carouselBreakpoints {
+		if n, ok := c.options.SlidesQty[breakpoint.name]; ok && width >= breakpoint.width {
+			count = n
+		}
+	}
+	return max(1, min(count, len(c.slides)))
+}
+
+// lastIndex returns the index of the last slide that can lead the view
+func (c *carousel) lastIndex() int {
+	return len(c.slides) - c.perView()
+}
+
+// direction returns -1 in right-to-left layouts, where slides advance to
+// the left, and 1 otherwise
+func (c *carousel) direction() int {
+	if dom.GetWindow().GetComputedStyle(c.el, "").GetPropertyValue("direction") == "rtl" {
+		return -1
+	}
+	return 1
+}
+
+// goTo shows the slide at index, wrapping around in infinite loop mode
+func (c *carousel) goTo(index int) {
+	last := c.lastIndex()
+	switch {
+	case index < 0 && c.options.IsInfiniteLoop:
+		index = last
+	case index > last && c.options.IsInfiniteLoop:
+		index = 0
+	case index < 0:
+		index = 0
+	case index > last:
+		index = last
+	}
+	if index == c.index {
+		return
+	}
+	c.index = index
+	c.render()
+	c.el.DispatchEvent(bridge.NewCustomEvent(CarouselUpdateEvent, index))
+}
+
+// render moves the slides to the current index and updates the controls
+func (c *carousel) render() {
+	perView := c.perView()
+	c.index = max(0, min(c.index, c.lastIndex()))
+
+	for i, slide := range c.slides {
+		if !c.options.IsSnap {
+			style := slide.Underlying().Get("style")
+			style.Set("flex", fmt.Sprintf("0 0 %g%%", 100/float64(perView)))
+		}
+		if i >= c.index && i < c.index+perView {
+			slide.RemoveAttribute("aria-hidden")
+		} else {
+			slide.SetAttribute("aria-hidden", "true")
+		}
+	}
+
+	if c.options.IsSnap {
+		offset := c.slides[c.index].Underlying().Get("offsetLeft").Int() - c.slides[0].Underlying().Get("offsetLeft").Int()
+		c.body.Underlying().Call("scrollTo", map[string]any{"left": offset, "behavior": "smooth"})
+	} else {
+		shift := -float64(c.index*c.direction()) * 100 / float64(perView)
+		c.body.Underlying().Get("style").Set("transform", fmt.Sprintf("translateX(%g%%)", shift))
+	}
+
+	c.markItems()
+
+	if !c.options.IsInfiniteLoop {
+		setCarouselControlDisabled(c.prev, c.index == 0)
+		setCarouselControlDisabled(c.next, c.index == c.lastIndex())
+	}
+}
+
+// markItems activates the indicator or thumbnail of the current slide
+func (c *carousel) markItems() {
+	for i, item := range c.items {
+		if i == c.index {
+			item.Class().Add("active")
+		} else {
+			item.Class().Remove("active")
+		}
+	}
+	setCarouselCurrent(c.items, c.index)
+}
+
+// followScroll picks up the slide scrolled to in snap mode
+func (c *carousel) followScroll() {
+	width := c.slides[0].Underlying().Get("offsetWidth").Float()
+	if width == 0 {
+		return
+	}
+	index := int(math.Round(math.Abs(c.body.Underlying().Get("scrollLeft").Float()) / width))
+	if index == c.index || index < 0 || index >= len(c.slides) {
+		return
+	}
+	c.index = index
+	c.markItems()
+	c.el.DispatchEvent(bridge.NewCustomEvent(CarouselUpdateEvent, index))
+}
+
+// play starts advancing the slides every Speed milliseconds
+func (c *carousel) play() {
+	if c.timer != 0 {
+		return
+	}
+	c.timer = dom.GetWindow().SetInterval(func() {
+		if c.index >= c.lastIndex() {
+			c.goTo(0)
+		} else {
+			c.goTo(c.index + 1)
+		}
+	}, c.options.Speed)
+}
+
+// pause stops autoplay until play is called again
+func (c *carousel) pause() {
+	if c.timer != 0 {
+		dom.GetWindow().ClearInterval(c.timer)
+		c.timer = 0
+	}
+}
+
+// setCarouselCurrent marks the indicator or thumbnail of slide index as
+// current for assistive technology
+func setCarouselCurrent(items []dom.Element, index int) {
+	for i, item := range items {
+		if i == index {
+			item.SetAttribute("aria-current", "true")
+		} else {
+			item.RemoveAttribute("aria-current")
+		}
+	}
+}
+
+// setCarouselControlDisabled disables a prev or next control at either end
+// of the slides
+func setCarouselControlDisabled(control dom.Element, disabled bool) {
+	if control == nil {
+		return
+	}
+	if disabled {
+		control.Class().Add("disabled")
+		control.SetAttribute("disabled", "")
+	} else {
+		control.Class().Remove("disabled")
+		control.RemoveAttribute("disabled")
+	}
+}
+
+// hsCarousel returns the HSCarousel instance of el, if FlyonUI is loaded
+func hsCarousel(el dom.Element) (js.Value, bool) {
+	if !bridge.HasGlobal("HSCarousel") {
+		return js.Undefined(), false
+	}
+	instance := js.Global().Get("HSCarousel").Call("getInstance", el.Underlying())
+	return instance, instance.Truthy()
+}
@@ -0,0 +1,73 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateCheckbox applies the indeterminate state of checkboxes below root,
+// which has no HTML attribute, and links select all checkboxes to the
+// checkboxes of their group
+func HydrateCheckbox(root Root) {
+	for _, el := range root.QuerySelectorAll("input[data-indeterminate]") {
+		if markHydrated(el, "indeterminate") {
+			continue
+		}
+		el.Underlying().Set("indeterminate", true)
+	}
+
+	for _, el := range root.QuerySelectorAll("input[data-checkbox-select-all]") {
+		parent, ok := el.(*dom.HTMLInputElement)
+		if !ok || markHydrated(parent, "select-all") {
+			continue
+		}
+		group := parent.GetAttribute("data-checkbox-select-all")
+		// Children may be rendered anywhere in the form, so look beyond root
+		scope := dom.Element(parent.Form())
+		if parent.Form() == nil {
+			scope = dom.GetWindow().Document().DocumentElement()
+		}
+		children := func() []*dom.HTMLInputElement {
+			var inputs []*dom.HTMLInputElement
+			for _, child := range scope.QuerySelectorAll("input[data-checkbox-group]") {
+				if input, ok := child.(*dom.HTMLInputElement); ok && input.GetAttribute("data-checkbox-group") == group {
+					inputs = append(inputs, input)
+				}
+			}
+			return inputs
+		}
+
+		sync := func() {
+			var states []bool
+			for _, child := range children() {
+				states = append(states, child.Checked())
+			}
+			checked, indeterminate := components.SelectAllState(states)
+			parent.SetChecked(checked)
+			parent.Underlying().Set("indeterminate", indeterminate)
+		}
+
+		parent.AddEventListener("change", false, func(dom.Event) {
+			for _, child := range children() {
+				if child.Disabled() || child.Checked() == parent.Checked() {
+					continue
+				}
+				child.SetChecked(parent.Checked())
+				child.DispatchEvent(bridge.NewEvent("change"))
+			}
+			// Disabled children keep their state and may leave the parent mixed
+			sync()
+		})
+		// Listening on the scope also covers children added later
+		scope.AddEventListener("change", false, func(event dom.Event) {
+			if target := event.Target(); target != nil && !target.Underlying().Equal(parent.Underlying()) && target.GetAttribute("data-checkbox-group") == group {
+				sync()
+			}
+		})
+		sync()
+	}
+}
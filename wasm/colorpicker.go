@@ -0,0 +1,60 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateColorPicker keeps the native color input, the hex text field and the
+// swatches of every ColorPickerComponent below root in sync
+func HydrateColorPicker(root Root) {
+	for _, wrapper := range root.QuerySelectorAll("[data-color-picker]") {
+		input, ok := wrapper.QuerySelector("[data-color-picker-input]").(*dom.HTMLInputElement)
+		if !ok || markHydrated(wrapper, "color-picker") {
+			continue
+		}
+		text, _ := wrapper.QuerySelector("[data-color-picker-text]").(*dom.HTMLInputElement)
+		swatches := wrapper.QuerySelectorAll("[data-color-picker-swatch]")
+
+		show := func(color string) {
+			if text != nil && !isActive(text) {
+				text.SetValue(color)
+			}
+			for _, swatch := range swatches {
+				pressed := "false"
+				if swatch.GetAttribute("data-color-picker-swatch") == color {
+					pressed = "true"
+				}
+				swatch.SetAttribute("aria-pressed", pressed)
+			}
+		}
+		set := func(color string) {
+			input.SetValue(color)
+			show(color)
+			input.DispatchEvent(bridge.NewEvent("input"))
+			input.DispatchEvent(bridge.NewEvent("change"))
+		}
+
+		input.AddEventListener("input", false, func(dom.Event) { show(input.Value()) })
+		if text != nil {
+			text.AddEventListener("input", false, func(dom.Event) {
+				if color, ok := components.NormalizeHexColor(text.Value()); ok && len(text.Value()) >= 4 {
+					set(color)
+				}
+			})
+			text.AddEventListener("blur", false, func(dom.Event) {
+				// Drop incomplete values and expand the short #rgb form
+				text.SetValue(input.Value())
+			})
+		}
+		for _, swatch := range swatches {
+			swatch.AddEventListener("click", false, func(dom.Event) {
+				set(swatch.GetAttribute("data-color-picker-swatch"))
+			})
+		}
+	}
+}
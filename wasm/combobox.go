@@ -0,0 +1,377 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+	"honnef.co/go/js/dom/v2"
+)
+
+// combobox holds the runtime state of an AutocompleteComponent or
+// ComboboxComponent. It implements the WAI-ARIA combobox pattern with a
+// listbox popup; options are filtered locally or, when the wrapper carries a
+// data-autocomplete-url, fetched from a remote source.
+type combobox struct {
+	wrapper dom.Element
+	input   *dom.HTMLInputElement
+	hidden  *dom.HTMLInputElement
+	listbox dom.Element
+	// freeText is true for autocompletes, where typed text is a valid value
+	freeText bool
+
+	source   string
+	debounce int
+	minChars int
+	limit    int
+
+	timer  int
+	seq    int
+	cancel context.CancelFunc
+	active int
+}
+
+// HydrateCombobox wires every autocomplete and combobox below root with
+// keyboard navigation (arrows, Home/End, Enter, Escape), filtering with
+// matched substring highlighting, aria-activedescendant tracking and syncing
+// of the chosen value into the hidden input named from WithName.
+func HydrateCombobox(root Root) {
+	for _, wrapper := range root.QuerySelectorAll("[data-combobox]") {
+		if markHydrated(wrapper, "combobox") {
+			continue
+		}
+		input, ok := wrapper.QuerySelector("[role=combobox]").(*dom.HTMLInputElement)
+		listbox := wrapper.QuerySelector("[role=listbox]")
+		if !ok || listbox == nil {
+			continue
+		}
+
+		cb := &combobox{
+			wrapper:  wrapper,
+			input:    input,
+			listbox:  listbox,
+			freeText: wrapper.GetAttribute("data-combobox") == "autocomplete",
+			source:   wrapper.GetAttribute("data-autocomplete-url"),
+			debounce: attrInt(wrapper, "data-autocomplete-debounce", 250),
+			minChars: attrInt(wrapper, "data-autocomplete-min-chars", 1),
+			limit:    attrInt(wrapper, "data-autocomplete-limit", 10),
+			active:   -1,
+		}
+		if hidden, ok := wrapper.QuerySelector("[data-combobox-value]").(*dom.HTMLInputElement); ok {
+			cb.hidden = hidden
+		}
+		cb.close()
+
+		input.AddEventListener("input", false, func(dom.Event) { cb.onInput() })
+		input.AddEventListener("keydown", false, cb.onKeyDown)
+		input.AddEventListener("click", false, func(dom.Event) {
+			if cb.source == "" {
+				cb.filter(cb.input.Value())
+			}
+		})
+		input.AddEventListener("blur", false, func(dom.Event) {
+			// Delay so a click on an option lands before the listbox hides
+			dom.GetWindow().SetTimeout(cb.close, 150)
+		})
+		listbox.AddEventListener("mousedown", false, func(event dom.Event) {
+			// Keep focus in the input while picking an option
+			event.PreventDefault()
+		})
+		listbox.AddEventListener("click", false, func(event dom.Event) {
+			option := event.Target().Closest("[role=option]")
+			if option != nil && option.GetAttribute("aria-disabled") != "true" {
+				event.PreventDefault()
+				cb.choose(option)
+			}
+		})
+	}
+}
+
+// onInput syncs free text into the hidden input and refreshes the options
+func (cb *combobox) onInput() {
+	if cb.hidden != nil {
+		if cb.freeText {
+			cb.hidden.SetValue(cb.input.Value())
+		} else {
+			// Typing invalidates the previous choice until an option is picked
+			cb.hidden.SetValue("")
+		}
+	}
+	for _, option := range cb.options() {
+		option.SetAttribute("aria-selected", "false")
+	}
+
+	if cb.source != "" {
+		cb.schedule()
+		return
+	}
+	cb.filter(cb.input.Value())
+}
+
+// filter hides options that do not contain query and highlights the match
+func (cb *combobox) filter(query string) {
+	query = strings.TrimSpace(query)
+	for _, option := range cb.options() {
+		label := option.GetAttribute("data-label")
+		_, _, ok := matchRange(label, query)
+		if query == "" || ok {
+			option.Class().Remove("hidden")
+		} else {
+			option.Class().Add("hidden")
+		}
+		cb.highlightLabel(option, query)
+	}
+
+	visible := cb.visibleOptions()
+	if len(visible) == 0 {
+		cb.close()
+		return
+	}
+	cb.open()
+	if query != "" {
+		// Typeahead: the best match becomes the active descendant
+		cb.activate(visible, 0)
+	}
+}
+
+// highlightLabel marks the part of the option label matching query
+func (cb *combobox) highlightLabel(option dom.Element, query string) {
+	labelEl := option.QuerySelector("[data-option-label]")
+	if labelEl == nil {
+		return
+	}
+	labelEl.SetInnerHTML(highlightHTML(option.GetAttribute("data-label"), query))
+}
+
+// schedule debounces a remote fetch for the current input value
+func (cb *combobox) schedule() {
+	window := dom.GetWindow()
+	if cb.timer != 0 {
+		window.ClearTimeout(cb.timer)
+		cb.timer = 0
+	}
+
+	query := strings.TrimSpace(cb.input.Value())
+	if len([]rune(query)) < cb.minChars {
+		cb.abort()
+		cb.close()
+		return
+	}
+
+	cb.timer = window.SetTimeout(func() {
+		cb.timer = 0
+		cb.abort()
+		cb.seq++
+		ctx, cancel := context.WithCancel(context.Background())
+		cb.cancel = cancel
+		// Network calls block, so they must leave the event loop goroutine
+		go cb.fetch(ctx, cb.seq, query)
+	}, cb.debounce)
+}
+
+// abort cancels the in-flight request, if any
+func (cb *combobox) abort() {
+	if cb.cancel != nil {
+		cb.cancel()
+		cb.cancel = nil
+	}
+}
+
+// fetch requests the HTML fragment for query and renders it unless a newer
+// request has been started in the meantime
+func (cb *combobox) fetch(ctx context.Context, seq int, query string) {
+	endpoint, err := url.Parse(cb.source)
+	if err != nil {
+		return
+	}
+	params := endpoint.Query()
+	params.Set("q", query)
+	params.Set("limit", strconv.Itoa(cb.limit))
+	params.Set("format", "html")
+	endpoint.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Accept", "text/html")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || seq != cb.seq {
+		return
+	}
+
+	cb.listbox.SetInnerHTML(string(body))
+	listboxID := cb.listbox.ID()
+	for i, option := range cb.options() {
+		if option.ID() == "" {
+			option.SetID(listboxID + "-option-" + strconv.Itoa(i))
+		}
+		cb.highlightLabel(option, query)
+	}
+	cb.active = -1
+	cb.input.RemoveAttribute("aria-activedescendant")
+	if len(cb.visibleOptions()) == 0 {
+		cb.close()
+		return
+	}
+	cb.open()
+}
+
+// options returns all options of the listbox
+func (cb *combobox) options() []dom.Element {
+	return cb.listbox.QuerySelectorAll("[role=option]")
+}
+
+// visibleOptions returns the options that can currently be navigated to
+func (cb *combobox) visibleOptions() []dom.Element {
+	var visible []dom.Element
+	for _, option := range cb.options() {
+		if option.Class().Contains("hidden") || option.GetAttribute("aria-disabled") == "true" {
+			continue
+		}
+		visible = append(visible, option)
+	}
+	return visible
+}
+
+// onKeyDown implements the keyboard interaction of the combobox pattern
+func (cb *combobox) onKeyDown(event dom.Event) {
+	options := cb.visibleOptions()
+	switch event.(*dom.KeyboardEvent).Key() {
+	case "ArrowDown":
+		event.PreventDefault()
+		if !cb.isOpen() {
+			cb.reopen()
+			options = cb.visibleOptions()
+		}
+		if len(options) > 0 {
+			cb.activate(options, (cb.active+1)%len(options))
+		}
+	case "ArrowUp":
+		event.PreventDefault()
+		if !cb.isOpen() {
+			cb.reopen()
+			options = cb.visibleOptions()
+		}
+		if len(options) > 0 {
+			next := cb.active - 1
+			if next < 0 {
+				next = len(options) - 1
+			}
+			cb.activate(options, next)
+		}
+	case "Home":
+		if cb.isOpen() && len(options) > 0 {
+			event.PreventDefault()
+			cb.activate(options, 0)
+		}
+	case "End":
+		if cb.isOpen() && len(options) > 0 {
+			event.PreventDefault()
+			cb.activate(options, len(options)-1)
+		}
+	case "Enter":
+		if cb.isOpen() && cb.active >= 0 && cb.active < len(options) {
+			event.PreventDefault()
+			cb.choose(options[cb.active])
+		}
+	case "Escape":
+		event.PreventDefault()
+		if cb.isOpen() {
+			cb.close()
+			return
+		}
+		// A second Escape clears the input, as recommended by WAI-ARIA
+		cb.input.SetValue("")
+		cb.onInput()
+		cb.close()
+	case "Tab":
+		cb.close()
+	}
+}
+
+// reopen shows the listbox for the current input without waiting for typing
+func (cb *combobox) reopen() {
+	if cb.source == "" {
+		cb.filter(cb.input.Value())
+		return
+	}
+	if len(cb.options()) > 0 {
+		cb.open()
+	}
+}
+
+// activate makes the option at index the active descendant
+func (cb *combobox) activate(options []dom.Element, index int) {
+	for i, option := range options {
+		if i == index {
+			option.Class().Add("active")
+			cb.input.SetAttribute("aria-activedescendant", option.ID())
+			option.Underlying().Call("scrollIntoView", map[string]any{"block": "nearest"})
+		} else {
+			option.Class().Remove("active")
+		}
+	}
+	cb.active = index
+}
+
+// choose copies the chosen option into the inputs and closes the listbox
+func (cb *combobox) choose(option dom.Element) {
+	for _, o := range cb.options() {
+		o.SetAttribute("aria-selected", "false")
+	}
+	option.SetAttribute("aria-selected", "true")
+
+	value := option.GetAttribute("data-value")
+	cb.input.SetValue(option.GetAttribute("data-label"))
+	cb.wrapper.SetAttribute("data-selected-value", value)
+	if cb.hidden != nil {
+		cb.hidden.SetValue(value)
+		cb.hidden.DispatchEvent(bridge.NewEvent("change"))
+	}
+	cb.close()
+	cb.input.DispatchEvent(bridge.NewEvent("change"))
+}
+
+func (cb *combobox) isOpen() bool {
+	return cb.input.GetAttribute("aria-expanded") == "true"
+}
+
+func (cb *combobox) open() {
+	cb.listbox.Class().Remove("hidden")
+	cb.wrapper.Class().Add("dropdown-open")
+	cb.input.SetAttribute("aria-expanded", "true")
+}
+
+func (cb *combobox) close() {
+	cb.listbox.Class().Add("hidden")
+	cb.wrapper.Class().Remove("dropdown-open")
+	cb.input.SetAttribute("aria-expanded", "false")
+	cb.input.RemoveAttribute("aria-activedescendant")
+	for _, option := range cb.options() {
+		option.Class().Remove("active")
+	}
+	cb.active = -1
+}
+
+// attrInt reads an integer attribute, falling back to def when missing or invalid
+func attrInt(el dom.Element, name string, def int) int {
+	if v, err := strconv.Atoi(el.GetAttribute(name)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,109 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"honnef.co/go/js/dom/v2"
+	g "maragu.dev/gomponents"
+)
+
+// mount renders node into a fresh container appended to the body
+func mount(t *testing.T, node g.Node) dom.Element {
+	t.Helper()
+	var sb strings.Builder
+	if err := node.Render(&sb); err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	doc := dom.GetWindow().Document()
+	container := doc.CreateElement("div")
+	container.SetInnerHTML(sb.String())
+	doc.(dom.HTMLDocument).Body().AppendChild(container)
+	t.Cleanup(container.Remove)
+	return container
+}
+
+// keydown dispatches a keydown event for key on el
+func keydown(el dom.Element, key string) {
+	el.DispatchEvent(dom.WrapEvent(js.Global().Get("KeyboardEvent").New("keydown", map[string]any{
+		"key":     key,
+		"bubbles": true,
+	})))
+}
+
+// typeInto sets the value of input and dispatches an input event
+func typeInto(input *dom.HTMLInputElement, value string) {
+	input.SetValue(value)
+	input.DispatchEvent(dom.WrapEvent(js.Global().Get("Event").New("input", map[string]any{"bubbles": true})))
+}
+
+func TestHydrateCombobox_KeyboardNavigation(t *testing.T) {
+	root := mount(t, components.NewCombobox().
+		WithID("fruit").
+		WithName("fruit").
+		WithOptions([]components.ComboboxOption{
+			{Value: "a", Label: "Apple"},
+			{Value: "b", Label: "Banana"},
+			{Value: "c", Label: "Cherry"},
+		}))
+	HydrateCombobox(root)
+
+	input := root.QuerySelector("#fruit").(*dom.HTMLInputElement)
+	hidden := root.QuerySelector("[data-combobox-value]").(*dom.HTMLInputElement)
+
+	keydown(input, "ArrowDown")
+	if input.GetAttribute("aria-expanded") != "true" {
+		t.Fatal("Expected ArrowDown to open the listbox")
+	}
+	if got := input.GetAttribute("aria-activedescendant"); got != "fruit-listbox-option-0" {
+		t.Errorf("Expected first option to be active, got %q", got)
+	}
+
+	keydown(input, "End")
+	if got := input.GetAttribute("aria-activedescendant"); got != "fruit-listbox-option-2" {
+		t.Errorf("Expected End to activate the last option, got %q", got)
+	}
+
+	keydown(input, "Home")
+	keydown(input, "ArrowDown")
+	keydown(input, "Enter")
+	if input.Value() != "Banana" || hidden.Value() != "b" {
+		t.Errorf("Expected Banana/b to be chosen, got %q/%q", input.Value(), hidden.Value())
+	}
+	if input.GetAttribute("aria-expanded") != "false" {
+		t.Error("Expected Enter to close the listbox")
+	}
+	if root.QuerySelector("#fruit-listbox-option-1").GetAttribute("aria-selected") != "true" {
+		t.Error("Expected chosen option to be aria-selected")
+	}
+
+	keydown(input, "Escape")
+	if input.Value() != "" || hidden.Value() != "" {
+		t.Errorf("Expected Escape on a closed listbox to clear the value, got %q/%q", input.Value(), hidden.Value())
+	}
+}
+
+func TestHydrateCombobox_FilterAndHighlight(t *testing.T) {
+	root := mount(t, components.NewAutocomplete().
+		WithID("city").
+		WithOptions("Berlin", "Bern", "Paris"))
+	HydrateCombobox(root)
+
+	input := root.QuerySelector("#city").(*dom.HTMLInputElement)
+	typeInto(input, "ber")
+
+	if root.QuerySelector("#city-listbox-option-2").Class().Contains("hidden") == false {
+		t.Error("Expected non-matching option to be hidden")
+	}
+	label := root.QuerySelector("#city-listbox-option-0 [data-option-label]")
+	if !strings.Contains(label.InnerHTML(), "<mark") {
+		t.Errorf("Expected matched text to be highlighted, got %s", label.InnerHTML())
+	}
+	if got := input.GetAttribute("aria-activedescendant"); got != "city-listbox-option-0" {
+		t.Errorf("Expected the first match to become active, got %q", got)
+	}
+}
@@ -0,0 +1,34 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateDatePicker initializes flatpickr on every DatePickerComponent below
+// root that was rendered with WithConfig. Inputs are left untouched when
+// flatpickr.js is not loaded.
+func HydrateDatePicker(root Root) {
+	if !bridge.HasGlobal("flatpickr") {
+		return
+	}
+	for _, input := range root.QuerySelectorAll("input[data-datepicker]") {
+		if markHydrated(input, "datepicker") {
+			continue
+		}
+
+		var weekdays []int
+		for _, day := range strings.Split(input.GetAttribute("data-datepicker-disable-weekdays"), ",") {
+			if n, err := strconv.Atoi(strings.TrimSpace(day)); err == nil {
+				weekdays = append(weekdays, n)
+			}
+		}
+		firstDay := attrInt(input, "data-datepicker-first-day", -1)
+
+		bridge.Flatpickr(input, input.GetAttribute("data-datepicker"), weekdays, firstDay)
+	}
+}
@@ -0,0 +1,192 @@
+//go:build js && wasm
+
+package dialogs
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"honnef.co/go/js/dom/v2"
+	"maragu.dev/gomponents"
+	h "maragu.dev/gomponents/html"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"github.com/ozanturksever/gomponents-flyonui/wasm/overlay"
+)
+
+// ErrCanceled is returned by Prompt when the dialog is dismissed without
+// submitting a value
+var ErrCanceled = errors.New("dialog canceled")
+
+// Option configures a dialog
+type Option func(*config)
+
+type config struct {
+	ok     string
+	cancel string
+	color  flyon.Color
+}
+
+// WithLabels sets the labels of the confirm and cancel buttons
+func WithLabels(ok, cancel string) Option {
+	return func(c *config) {
+		if ok != "" {
+			c.ok = ok
+		}
+		if cancel != "" {
+			c.cancel = cancel
+		}
+	}
+}
+
+// WithColor sets the color of the confirm button, e.g. flyon.Error for
+// destructive actions
+func WithColor(color flyon.Color) Option {
+	return func(c *config) { c.color = color }
+}
+
+func newConfig(opts []Option) config {
+	c := config{ok: "OK", cancel: "Cancel", color: flyon.Primary}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// result is the answer of a dialog
+type result struct {
+	ok    bool
+	value string
+}
+
+// Confirm asks a yes or no question and reports whether it was confirmed.
+// Escape, the backdrop and the close button answer no.
+func Confirm(ctx context.Context, title, body string, opts ...Option) (bool, error) {
+	c := newConfig(opts)
+	modal := components.NewModal(title, h.P(gomponents.Text(body))).WithActions(
+		components.ModalAction(c.cancel, flyon.Secondary, h.Class("btn-soft"), gomponents.Attr("data-dialog-cancel", "")),
+		components.ModalAction(c.ok, c.color, gomponents.Attr("data-dialog-ok", "")),
+	)
+	answer, err := show(ctx, modal, nil)
+	return answer.ok, err
+}
+
+// Alert shows a message and returns once it was acknowledged
+func Alert(ctx context.Context, title, body string, opts ...Option) error {
+	c := newConfig(opts)
+	modal := components.NewModal(title, h.P(gomponents.Text(body))).WithActions(
+		components.ModalAction(c.ok, c.color, gomponents.Attr("data-dialog-ok", "")),
+	)
+	_, err := show(ctx, modal, nil)
+	return err
+}
+
+// Prompt asks for a value with input and returns it once submitted. The
+// input is validated by the browser first, so WithRequired and WithType
+// apply. A dismissed dialog returns ErrCanceled.
+func Prompt(ctx context.Context, title string, input *components.InputComponent, opts ...Option) (string, error) {
+	c := newConfig(opts)
+	// The form lets Enter submit the value
+	modal := components.NewModal(title, h.Form(gomponents.Attr("data-dialog-form", ""), gomponents.Attr("novalidate", ""), input)).WithActions(
+		components.ModalAction(c.cancel, flyon.Secondary, h.Class("btn-soft"), gomponents.Attr("data-dialog-cancel", "")),
+		components.ModalAction(c.ok, c.color, gomponents.Attr("data-dialog-ok", "")),
+	)
+	answer, err := show(ctx, modal, func(el dom.Element) (string, bool) {
+		field, ok := el.QuerySelector("[data-dialog-form] input").(*dom.HTMLInputElement)
+		if !ok {
+			return "", true
+		}
+		if !field.Underlying().Call("reportValidity").Bool() {
+			return "", false
+		}
+		return field.Value(), true
+	})
+	if err != nil {
+		return "", err
+	}
+	if !answer.ok {
+		return "", ErrCanceled
+	}
+	return answer.value, nil
+}
+
+// show renders modal into the page, opens it and waits for an answer.
+// submit reads the value when confirming and may refuse an invalid one.
+func show(ctx context.Context, modal *components.ModalComponent, submit func(el dom.Element) (string, bool)) (result, error) {
+	if err := ctx.Err(); err != nil {
+		return result{}, err
+	}
+	var html strings.Builder
+	if err := modal.WithPosition(components.ModalPositionMiddle).Render(&html); err != nil {
+		return result{}, err
+	}
+	doc := dom.GetWindow().Document().(dom.HTMLDocument)
+	holder := doc.CreateElement("div")
+	holder.SetInnerHTML(html.String())
+	el := holder.QuerySelector(".modal")
+	doc.Body().AppendChild(el)
+	el.SetAttribute("aria-modal", "true")
+
+	// Only the first answer counts; closing the overlay afterwards reports a
+	// cancel that is dropped
+	answers := make(chan result, 1)
+	answer := func(r result) {
+		select {
+		case answers <- r:
+		default:
+		}
+	}
+	confirm := func() {
+		r := result{ok: true}
+		if submit != nil {
+			value, valid := submit(el)
+			if !valid {
+				return
+			}
+			r.value = value
+		}
+		answer(r)
+		overlay.Close(el)
+	}
+
+	if button := el.QuerySelector("[data-dialog-ok]"); button != nil {
+		button.AddEventListener("click", false, func(dom.Event) { confirm() })
+	}
+	if button := el.QuerySelector("[data-dialog-cancel]"); button != nil {
+		button.AddEventListener("click", false, func(dom.Event) {
+			answer(result{})
+			overlay.Close(el)
+		})
+	}
+	if form := el.QuerySelector("[data-dialog-form]"); form != nil {
+		form.AddEventListener("submit", false, func(event dom.Event) {
+			event.PreventDefault()
+			confirm()
+		})
+	}
+	removeOnClose := overlay.OnClose(el, func() { answer(result{}) })
+	defer func() {
+		removeOnClose()
+		overlay.Dispose(el)
+	}()
+
+	// The overlay manager returns the focus to the previous element on close
+	overlay.Open(el)
+	focus := el.QuerySelector("[data-dialog-form] input")
+	if focus == nil {
+		focus = el.QuerySelector("[data-dialog-ok]")
+	}
+	if focus, ok := focus.(dom.HTMLElement); ok {
+		focus.Focus()
+	}
+
+	select {
+	case r := <-answers:
+		return r, nil
+	case <-ctx.Done():
+		overlay.Close(el)
+		return result{}, ctx.Err()
+	}
+}
@@ -0,0 +1,5 @@
+// Package dialogs shows confirm, prompt and alert dialogs built on
+// ModalComponent and waits for the answer, replacing window.confirm and
+// friends. The functions block, so call them from a goroutine rather than
+// directly inside an event listener.
+package dialogs
@@ -0,0 +1,4 @@
+// Package wasm provides the client-side hydration layer of gomponents-flyonui.
+// It attaches Go behavior to markup rendered by the components package and
+// only builds for the js/wasm target; other targets see an empty package.
+package wasm
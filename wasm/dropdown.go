@@ -0,0 +1,231 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+	"honnef.co/go/js/dom/v2"
+)
+
+// typeaheadTimeout is how long typed characters accumulate into one search
+const typeaheadTimeout = 500 * time.Millisecond
+
+// dropdownMenu holds the runtime state of a DropdownComponent menu
+type dropdownMenu struct {
+	toggle dom.HTMLElement
+	menu   dom.Element
+
+	typed     string
+	typedTime time.Time
+}
+
+// itemRoles matches the menu items of a dropdown
+const itemRoles = "[role=menuitem], [role=menuitemcheckbox], [role=menuitemradio]"
+
+// HydrateDropdown adds WAI-ARIA menu keyboard support (arrows, Home/End,
+// typeahead, Escape) to dropdowns below root, including nested submenus and
+// checkbox and radio items. FlyonUI's HSDropdown already implements this,
+// so the Go behavior only runs when flyonui.js is absent.
+func HydrateDropdown(root Root) {
+	if bridge.HasGlobal("HSDropdown") {
+		return
+	}
+	for _, wrapper := range root.QuerySelectorAll(".dropdown") {
+		// Like HSDropdown, fall back to the first child for custom triggers
+		toggle, ok := wrapper.QuerySelector(":scope > .dropdown-toggle").(dom.HTMLElement)
+		if !ok {
+			toggle, ok = dom.WrapElement(wrapper.Underlying().Get("firstElementChild")).(dom.HTMLElement)
+		}
+		menu := wrapper.QuerySelector(":scope > [role=menu]")
+		if !ok || menu == nil || markHydrated(wrapper, "dropdown") {
+			continue
+		}
+
+		dd := &dropdownMenu{toggle: toggle, menu: menu}
+		for _, item := range dd.items() {
+			item.SetAttribute("tabindex", "-1")
+		}
+		// Submenus are list items of their parent menu
+		submenu := wrapper.TagName() == "LI"
+
+		toggle.AddEventListener("click", false, func(event dom.Event) {
+			event.PreventDefault()
+			if dd.isOpen() {
+				dd.close(false)
+			} else {
+				dd.open(0)
+			}
+		})
+		toggle.AddEventListener("keydown", false, func(event dom.Event) {
+			key := event.(*dom.KeyboardEvent).Key()
+			switch {
+			case key == "Enter" || key == " " || key == "ArrowRight" && submenu || key == "ArrowDown" && !submenu:
+				event.PreventDefault()
+				event.StopPropagation()
+				dd.open(0)
+			case key == "ArrowUp" && !submenu:
+				event.PreventDefault()
+				dd.open(-1)
+			}
+		})
+		if strings.Contains(wrapper.GetAttribute("class"), "[--trigger:hover]") {
+			wrapper.AddEventListener("mouseenter", false, func(dom.Event) { dd.show() })
+			wrapper.AddEventListener("mouseleave", false, func(dom.Event) { dd.close(false) })
+		}
+		menu.AddEventListener("keydown", false, func(event dom.Event) {
+			key := event.(*dom.KeyboardEvent).Key()
+			if submenu && (key == "ArrowLeft" || key == "Escape") {
+				event.PreventDefault()
+				event.StopPropagation()
+				dd.close(true)
+				return
+			}
+			dd.onKeyDown(event)
+			// Keep the parent menu from handling keys a submenu handled
+			if submenu && key != "Tab" {
+				event.StopPropagation()
+			}
+		})
+		menu.AddEventListener("click", false, func(event dom.Event) {
+			if item := event.Target().Closest("[role=menuitem]"); item != nil && !item.HasAttribute("aria-haspopup") {
+				dd.close(true)
+			}
+		})
+		menu.AddEventListener("change", false, func(dom.Event) { dd.syncChecked() })
+	}
+}
+
+// items returns the enabled items of the menu, excluding those of submenus
+func (dd *dropdownMenu) items() []dom.Element {
+	var items []dom.Element
+	for _, item := range dd.menu.QuerySelectorAll(itemRoles) {
+		if item.GetAttribute("aria-disabled") == "true" || !item.ParentElement().Closest("[role=menu]").Underlying().Equal(dd.menu.Underlying()) {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// syncChecked mirrors the state of checkbox and radio items in aria-checked
+func (dd *dropdownMenu) syncChecked() {
+	for _, item := range dd.menu.QuerySelectorAll("[role=menuitemcheckbox], [role=menuitemradio]") {
+		if input, ok := item.QuerySelector("input").(*dom.HTMLInputElement); ok {
+			item.SetAttribute("aria-checked", strconv.FormatBool(input.Checked()))
+		}
+	}
+}
+
+// onKeyDown moves focus between items
+func (dd *dropdownMenu) onKeyDown(event dom.Event) {
+	items := dd.items()
+	if len(items) == 0 {
+		return
+	}
+	current := -1
+	if active := activeElement(); active != nil {
+		for i, item := range items {
+			if item.Underlying().Equal(active.Underlying()) {
+				current = i
+				break
+			}
+		}
+	}
+
+	key := event.(*dom.KeyboardEvent).Key()
+	switch key {
+	case "ArrowDown":
+		event.PreventDefault()
+		dd.focus(items, (current+1)%len(items))
+	case "ArrowUp":
+		event.PreventDefault()
+		if current <= 0 {
+			current = len(items)
+		}
+		dd.focus(items, current-1)
+	case "Home":
+		event.PreventDefault()
+		dd.focus(items, 0)
+	case "End":
+		event.PreventDefault()
+		dd.focus(items, len(items)-1)
+	case "Escape":
+		event.PreventDefault()
+		dd.close(true)
+	case "Tab":
+		dd.close(false)
+	case "Enter", " ":
+		// Labels do not toggle their input from the keyboard
+		if current >= 0 && items[current].GetAttribute("role") != "menuitem" {
+			event.PreventDefault()
+			if input, ok := items[current].QuerySelector("input").(dom.HTMLElement); ok {
+				input.Click()
+			}
+		}
+	default:
+		// Single printable characters drive the typeahead
+		if len([]rune(key)) != 1 || strings.TrimSpace(key) == "" {
+			return
+		}
+		now := time.Now()
+		if now.Sub(dd.typedTime) > typeaheadTimeout {
+			dd.typed = ""
+		}
+		dd.typed += key
+		dd.typedTime = now
+
+		labels := make([]string, len(items))
+		for i, item := range items {
+			labels[i] = item.TextContent()
+		}
+		if idx := typeaheadIndex(labels, max(current, 0), dd.typed); idx >= 0 {
+			dd.focus(items, idx)
+		}
+	}
+}
+
+// focus moves focus to the item at index
+func (dd *dropdownMenu) focus(items []dom.Element, index int) {
+	if item, ok := items[index].(dom.HTMLElement); ok {
+		item.Focus()
+	}
+}
+
+func (dd *dropdownMenu) isOpen() bool {
+	return dd.toggle.GetAttribute("aria-expanded") == "true"
+}
+
+// show shows the menu without moving focus
+func (dd *dropdownMenu) show() {
+	dd.menu.Class().Remove("hidden")
+	dd.menu.Class().Add("opened")
+	dd.toggle.SetAttribute("aria-expanded", "true")
+}
+
+// open shows the menu and focuses the item at index (negative counts from the end)
+func (dd *dropdownMenu) open(index int) {
+	dd.show()
+	items := dd.items()
+	if len(items) == 0 {
+		return
+	}
+	if index < 0 {
+		index = len(items) + index
+	}
+	dd.focus(items, index)
+}
+
+// close hides the menu, optionally returning focus to the toggle
+func (dd *dropdownMenu) close(restoreFocus bool) {
+	dd.menu.Class().Add("hidden")
+	dd.menu.Class().Remove("opened")
+	dd.toggle.SetAttribute("aria-expanded", "false")
+	dd.typed = ""
+	if restoreFocus {
+		dd.toggle.Focus()
+	}
+}
@@ -0,0 +1,103 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+)
+
+// submitButtons selects the buttons that submit a form
+const submitButtons = `button:not([type]), button[type="submit"], input[type="submit"]`
+
+// HydrateForm guards every FormComponent below root rendered with
+// WithSubmitGuard against double submission: once submitted, its submit
+// buttons are disabled and the clicked one shows a spinner until the next
+// page loads. Forms submitted by scripts are released by dispatching a
+// "form:done" event on them.
+func HydrateForm(root Root) {
+	for _, el := range root.QuerySelectorAll("form[data-form-submit-guard]") {
+		form, ok := el.(*dom.HTMLFormElement)
+		if !ok || markHydrated(form, "submit-guard") {
+			continue
+		}
+		loadingText := form.GetAttribute("data-form-submit-guard")
+		// The spinner markup of a loading ButtonComponent, rendered with the form
+		var spinnerTemplate dom.Element
+		if template, ok := form.QuerySelector("template[data-form-submit-spinner]").(*dom.HTMLTemplateElement); ok {
+			spinnerTemplate = template.Content().QuerySelector("[data-button-loading]")
+		}
+		var restore func()
+
+		release := func() {
+			if restore != nil {
+				restore()
+				restore = nil
+			}
+		}
+
+		form.AddEventListener("submit", false, func(event dom.Event) {
+			if restore != nil {
+				event.PreventDefault()
+				return
+			}
+			// Another behavior, e.g. validation, cancelled the submission
+			if event.DefaultPrevented() {
+				return
+			}
+
+			doc := dom.GetWindow().Document()
+			var undo []func()
+			// A disabled submitter is left out of the form data, so its
+			// value is carried by a hidden input instead
+			if submitter := event.Underlying().Get("submitter"); submitter.Truthy() {
+				button := dom.WrapElement(submitter)
+				if name := button.GetAttribute("name"); name != "" {
+					input := doc.CreateElement("input").(*dom.HTMLInputElement)
+					input.SetType("hidden")
+					input.SetName(name)
+					input.SetValue(submitter.Get("value").String())
+					input.SetAttribute("data-form-submitter", "")
+					form.AppendChild(input)
+					undo = append(undo, func() { input.ParentNode().RemoveChild(input) })
+				}
+				if button.TagName() == "BUTTON" {
+					label := button.InnerHTML()
+					if loadingText != "" {
+						button.SetTextContent(loadingText)
+					}
+					if spinnerTemplate != nil {
+						spinner := spinnerTemplate.CloneNode(true)
+						if first := button.FirstChild(); first != nil {
+							button.InsertBefore(spinner, first)
+						} else {
+							button.AppendChild(spinner)
+						}
+					}
+					button.SetAttribute("aria-busy", "true")
+					undo = append(undo, func() {
+						button.SetInnerHTML(label)
+						button.RemoveAttribute("aria-busy")
+					})
+				}
+			}
+			for _, button := range form.QuerySelectorAll(submitButtons) {
+				if button.HasAttribute("disabled") {
+					continue
+				}
+				button.SetAttribute("disabled", "")
+				undo = append(undo, func() { button.RemoveAttribute("disabled") })
+			}
+			form.SetAttribute("aria-busy", "true")
+			undo = append(undo, func() { form.RemoveAttribute("aria-busy") })
+
+			restore = func() {
+				for _, fn := range undo {
+					fn()
+				}
+			}
+		})
+		form.AddEventListener("form:done", false, func(dom.Event) { release() })
+		// Pages restored from the back/forward cache would keep their buttons disabled
+		dom.GetWindow().AddEventListener("pageshow", false, func(dom.Event) { release() })
+	}
+}
@@ -0,0 +1,245 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+	"github.com/ozanturksever/gomponents-flyonui/wasm/overlay"
+)
+
+// GalleryChangeEvent is dispatched on a gallery when its lightbox shows
+// another image. Its detail is the index of the image.
+const GalleryChangeEvent = "gallery:change"
+
+// galleryOpenEvent asks a gallery to open its lightbox on the image at the
+// index in its detail
+const galleryOpenEvent = "gallery:open"
+
+// gallerySwipeDistance is the horizontal distance in pixels a pointer must
+// travel for a swipe to change the image
+const gallerySwipeDistance = 50
+
+// galleryZoom is the scale of a zoomed lightbox image
+const galleryZoom = 2
+
+// gallery is the lightbox behavior of a GalleryComponent
+type gallery struct {
+	el       dom.Element
+	lightbox dom.Element // The modal
+	slides   []dom.Element
+	counter  dom.Element
+	zoom     dom.Element
+	index    int
+	zoomed   bool
+	swipeX   float64
+	swipeY   float64
+	swiping  bool
+	swiped   bool // Ignore the click ending a swipe
+}
+
+// HydrateGallery makes the lightboxes of GalleryComponents below root show
+// the clicked image, with arrow keys, swipes and buttons to move between
+// images and zooming on click
+func HydrateGallery(root Root) {
+	for _, el := range root.QuerySelectorAll("[data-gallery]") {
+		if markHydrated(el, "gallery") {
+			continue
+		}
+		content := el.QuerySelector("[data-gallery-lightbox]")
+		if content == nil {
+			continue
+		}
+		g := &gallery{
+			el:       el,
+			lightbox: content.Closest(".overlay"),
+			slides:   content.QuerySelectorAll("[data-gallery-slide]"),
+			counter:  content.QuerySelector("[data-gallery-counter]"),
+			zoom:     content.QuerySelector("[data-gallery-zoom]"),
+		}
+		if g.lightbox == nil || len(g.slides) == 0 {
+			continue
+		}
+		g.init(content)
+	}
+}
+
+// OpenGallery opens the lightbox of el, a rendered GalleryComponent, on the
+// image at index
+func OpenGallery(el dom.Element, index int) {
+	el.DispatchEvent(bridge.NewCustomEvent(galleryOpenEvent, index))
+}
+
+// OnGalleryChange calls fn with the index of the image shown whenever the
+// lightbox of el opens or moves to another image. The returned function
+// removes the callback.
+func OnGalleryChange(el dom.Element, fn func(index int)) (remove func()) {
+	listener := el.AddEventListener(GalleryChangeEvent, false, func(event dom.Event) {
+		fn(event.Underlying().Get("detail").Int())
+	})
+	return func() {
+		el.RemoveEventListener(GalleryChangeEvent, false, listener)
+		listener.Release()
+	}
+}
+
+// init wires the grid buttons and the lightbox controls
+func (g *gallery) init(content dom.Element) {
+	// The lightbox is opened by the data-overlay attribute of the buttons,
+	// through HSOverlay or HydrateOverlay, while this listener picks the image
+	for _, button := range g.el.QuerySelectorAll("[data-gallery-index]") {
+		index, err := strconv.Atoi(button.GetAttribute("data-gallery-index"))
+		if err != nil {
+			continue
+		}
+		button.AddEventListener("click", false, func(dom.Event) { g.show(index) })
+	}
+	g.el.AddEventListener(galleryOpenEvent, false, func(event dom.Event) {
+		g.show(event.Underlying().Get("detail").Int())
+		overlay.Open(g.lightbox)
+	})
+
+	if prev := content.QuerySelector("[data-gallery-prev]"); prev != nil {
+		prev.AddEventListener("click", false, func(dom.Event) { g.show(g.index - 1) })
+	}
+	if next := content.QuerySelector("[data-gallery-next]"); next != nil {
+		next.AddEventListener("click", false, func(dom.Event) { g.show(g.index + 1) })
+	}
+	if g.zoom != nil {
+		g.zoom.AddEventListener("click", false, func(dom.Event) { g.setZoom(!g.zoomed, 0.5, 0.5) })
+	}
+	for _, slide := range g.slides {
+		img := slide.QuerySelector("img")
+		if img == nil {
+			continue
+		}
+		img.AddEventListener("click", false, func(event dom.Event) {
+			if g.swiped {
+				g.swiped = false
+				return
+			}
+			x, y := g.pointerRatio(img, event)
+			g.setZoom(!g.zoomed, x, y)
+		})
+		// Zoomed images follow the pointer to pan
+		img.AddEventListener("pointermove", false, func(event dom.Event) {
+			if g.zoomed {
+				x, y := g.pointerRatio(img, event)
+				img.Underlying().Get("style").Set("transformOrigin", fmt.Sprintf("%g%% %g%%", x*100, y*100))
+			}
+		})
+	}
+
+	g.lightbox.AddEventListener("keydown", false, func(event dom.Event) {
+		switch event.(*dom.KeyboardEvent).Key() {
+		case "ArrowLeft":
+			g.show(g.index - g.direction())
+		case "ArrowRight":
+			g.show(g.index + g.direction())
+		case "Home":
+			g.show(0)
+		case "End":
+			g.show(len(g.slides) - 1)
+		default:
+			return
+		}
+		event.PreventDefault()
+	})
+
+	content.AddEventListener("pointerdown", false, func(event dom.Event) {
+		g.swiped = false
+		g.swipeX = event.Underlying().Get("clientX").Float()
+		g.swipeY = event.Underlying().Get("clientY").Float()
+		g.swiping = !g.zoomed
+	})
+	content.AddEventListener("pointerup", false, func(event dom.Event) {
+		if !g.swiping {
+			return
+		}
+		g.swiping = false
+		dx := event.Underlying().Get("clientX").Float() - g.swipeX
+		dy := event.Underlying().Get("clientY").Float() - g.swipeY
+		if math.Abs(dx) < gallerySwipeDistance || math.Abs(dx) < math.Abs(dy) {
+			return
+		}
+		g.swiped = true
+		// Swiping left brings the next image in
+		if dx < 0 {
+			g.show(g.index + g.direction())
+		} else {
+			g.show(g.index - g.direction())
+		}
+	})
+	content.AddEventListener("pointercancel", false, func(dom.Event) { g.swiping = false })
+
+	overlay.OnClose(g.lightbox, func() { g.setZoom(false, 0.5, 0.5) })
+}
+
+// show shows the image at index, wrapping around at either end
+func (g *gallery) show(index int) {
+	index = (index%len(g.slides) + len(g.slides)) % len(g.slides)
+	g.setZoom(false, 0.5, 0.5)
+	for i, slide := range g.slides {
+		if i == index {
+			slide.Class().Remove("hidden")
+		} else {
+			slide.Class().Add("hidden")
+		}
+	}
+	g.index = index
+	if g.counter != nil {
+		g.counter.SetTextContent(fmt.Sprintf("%d / %d", index+1, len(g.slides)))
+	}
+	g.el.DispatchEvent(bridge.NewCustomEvent(GalleryChangeEvent, index))
+}
+
+// setZoom zooms the current image in or out around the point at the given
+// ratios of its width and height
+func (g *gallery) setZoom(zoomed bool, x, y float64) {
+	g.zoomed = zoomed
+	if g.zoom != nil {
+		g.zoom.SetAttribute("aria-pressed", strconv.FormatBool(zoomed))
+	}
+	img := g.slides[g.index].QuerySelector("img")
+	if img == nil {
+		return
+	}
+	style := img.Underlying().Get("style")
+	if zoomed {
+		style.Set("transformOrigin", fmt.Sprintf("%g%% %g%%", x*100, y*100))
+		style.Set("transform", fmt.Sprintf("scale(%d)", galleryZoom))
+		img.Class().Remove("cursor-zoom-in")
+		img.Class().Add("cursor-zoom-out")
+	} else {
+		style.Set("transform", "")
+		style.Set("transformOrigin", "")
+		img.Class().Remove("cursor-zoom-out")
+		img.Class().Add("cursor-zoom-in")
+	}
+}
+
+// pointerRatio returns the position of the pointer of event over img as
+// ratios of its width and height
+func (g *gallery) pointerRatio(img dom.Element, event dom.Event) (float64, float64) {
+	rect := img.GetBoundingClientRect()
+	if rect.Width() == 0 || rect.Height() == 0 {
+		return 0.5, 0.5
+	}
+	x := (event.Underlying().Get("clientX").Float() - rect.Left()) / rect.Width()
+	y := (event.Underlying().Get("clientY").Float() - rect.Top()) / rect.Height()
+	return math.Max(0, math.Min(1, x)), math.Max(0, math.Min(1, y))
+}
+
+// direction returns -1 in right-to-left layouts, where the arrow keys and
+// swipes move the other way, and 1 otherwise
+func (g *gallery) direction() int {
+	if dom.GetWindow().GetComputedStyle(g.el, "").GetPropertyValue("direction") == "rtl" {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,71 @@
+//go:build js && wasm
+
+package wasm
+
+import "honnef.co/go/js/dom/v2"
+
+// Root is a DOM subtree that can be scanned for components, typically the
+// document itself or an element whose content was replaced at runtime.
+type Root interface {
+	QuerySelectorAll(selectors string) []dom.Element
+}
+
+// Hydrate attaches behavior to every supported component below root.
+// Passing nil hydrates the whole document.
+func Hydrate(root Root) {
+	if root == nil {
+		root = dom.GetWindow().Document()
+	}
+	HydrateCombobox(root)
+	HydrateDropdown(root)
+	HydrateDatePicker(root)
+	HydrateFileUpload(root)
+	HydrateMasks(root)
+	HydrateInputGroup(root)
+	HydrateNumberInput(root)
+	HydratePinInput(root)
+	HydrateTagsInput(root)
+	HydrateColorPicker(root)
+	HydratePassword(root)
+	HydrateRangeSlider(root)
+	HydrateTextarea(root)
+	HydrateCheckbox(root)
+	HydrateWizard(root)
+	HydrateToast(root)
+	HydrateAlert(root)
+	HydrateModal(root)
+	HydrateOverlay(root)
+	HydratePopover(root)
+	HydrateTabs(root)
+	HydrateAccordion(root)
+	HydrateCarousel(root)
+	HydrateGallery(root)
+	// Last, so submissions cancelled by validation above are not guarded
+	HydrateForm(root)
+}
+
+// markHydrated flags el as hydrated for the given behavior and reports
+// whether it was already hydrated, so Hydrate can safely run repeatedly.
+func markHydrated(el dom.Element, behavior string) bool {
+	attr := "data-hydrated-" + behavior
+	if el.HasAttribute(attr) {
+		return true
+	}
+	el.SetAttribute(attr, "")
+	return false
+}
+
+// activeElement returns the focused element of the document, if any
+func activeElement() dom.HTMLElement {
+	if doc, ok := dom.GetWindow().Document().(dom.HTMLDocument); ok {
+		return doc.ActiveElement()
+	}
+	return nil
+}
+
+// isActive reports whether el is the focused element. DOM wrappers are not
+// comparable with ==, every lookup returns a new one.
+func isActive(el dom.Element) bool {
+	active := activeElement()
+	return active != nil && active.Underlying().Equal(el.Underlying())
+}
@@ -0,0 +1,71 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateInputGroup wires the clear buttons of every InputGroupComponent below
+// root. Password toggles are handled by FlyonUI's HSTogglePassword plugin; a
+// minimal fallback toggles them when the plugin is not loaded.
+func HydrateInputGroup(root Root) {
+	for _, group := range root.QuerySelectorAll("[data-input-group]") {
+		if markHydrated(group, "input-group") {
+			continue
+		}
+		clear := group.QuerySelector("[data-input-clear]")
+		input, ok := group.QuerySelector("input").(*dom.HTMLInputElement)
+		if clear == nil || !ok {
+			continue
+		}
+
+		update := func() {
+			if input.Value() == "" {
+				clear.Class().Add("hidden")
+			} else {
+				clear.Class().Remove("hidden")
+			}
+		}
+		input.AddEventListener("input", false, func(dom.Event) { update() })
+		clear.AddEventListener("click", false, func(dom.Event) {
+			input.SetValue("")
+			input.DispatchEvent(bridge.NewEvent("input"))
+			input.DispatchEvent(bridge.NewEvent("change"))
+			input.Focus()
+		})
+		update()
+	}
+
+	if bridge.HasGlobal("HSTogglePassword") {
+		return
+	}
+	for _, toggle := range root.QuerySelectorAll("[data-toggle-password]") {
+		if markHydrated(toggle, "toggle-password") {
+			continue
+		}
+		var options struct {
+			Target string `json:"target"`
+		}
+		if err := json.Unmarshal([]byte(toggle.GetAttribute("data-toggle-password")), &options); err != nil || options.Target == "" {
+			continue
+		}
+		toggle.AddEventListener("click", false, func(dom.Event) {
+			input, ok := dom.GetWindow().Document().QuerySelector(options.Target).(*dom.HTMLInputElement)
+			if !ok {
+				return
+			}
+			if input.Type() == "password" {
+				input.SetType("text")
+				toggle.Class().Add("password-active")
+			} else {
+				input.SetType("password")
+				toggle.Class().Remove("password-active")
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+)
+
+// HydrateMasks formats every input rendered with InputGroupComponent.WithMask
+// below root while the user types, keeping the caret after the character it
+// followed.
+func HydrateMasks(root Root) {
+	for _, el := range root.QuerySelectorAll("input[data-mask]") {
+		input, ok := el.(*dom.HTMLInputElement)
+		if !ok || markHydrated(input, "mask") {
+			continue
+		}
+		mask, err := components.ParseInputMask(input.GetAttribute("data-mask"))
+		if err != nil {
+			continue
+		}
+
+		input.AddEventListener("input", false, func(dom.Event) {
+			value := input.Value()
+			masked := mask.Apply(value)
+			if masked == value {
+				return
+			}
+			caret := maskCaret(mask, value, input.SelectionStart(), masked)
+			input.SetValue(masked)
+			if isActive(input) {
+				input.SetSelectionRange(caret, caret, "none")
+			}
+		})
+	}
+}
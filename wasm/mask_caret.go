@@ -0,0 +1,34 @@
+package wasm
+
+import (
+	"strings"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+)
+
+// maskCaret returns the caret position in masked that keeps the caret after
+// the same significant character it followed in the unformatted value old.
+// Positions are rune offsets.
+func maskCaret(mask components.InputMask, old string, caret int, masked string) int {
+	runes := []rune(old)
+	if caret > len(runes) {
+		caret = len(runes)
+	}
+	want := significantLen(mask, string(runes[:caret]))
+	if want == 0 {
+		// Keep the caret after a typed prefix such as a currency symbol
+		return min(caret, len([]rune(masked)))
+	}
+	formatted := []rune(masked)
+	for i := 1; i <= len(formatted); i++ {
+		if significantLen(mask, string(formatted[:i])) >= want {
+			return i
+		}
+	}
+	return len(formatted)
+}
+
+// significantLen counts the characters of value kept by the mask
+func significantLen(mask components.InputMask, value string) int {
+	return len([]rune(strings.TrimPrefix(mask.Strip(value), "-")))
+}
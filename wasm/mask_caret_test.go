@@ -0,0 +1,32 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+)
+
+func TestMaskCaret(t *testing.T) {
+	phone := components.PhoneMask("")
+	tests := []struct {
+		name   string
+		mask   components.InputMask
+		old    string
+		caret  int
+		masked string
+		want   int
+	}{
+		{"typing at the end", phone, "(555) 1234", 10, "(555) 123-4", 11},
+		{"typing in the middle", phone, "(5559) 123", 5, "(555) 912-3", 7},
+		{"deleting before a literal", phone, "(555 123", 4, "(555) 123", 4},
+		{"currency grouping", components.CurrencyMask("$", 2), "$12345", 6, "$12,345", 7},
+		{"empty value", phone, "", 0, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskCaret(tt.mask, tt.old, tt.caret, tt.masked); got != tt.want {
+				t.Errorf("Expected caret %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
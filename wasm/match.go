@@ -0,0 +1,84 @@
+package wasm
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// matchRange finds the first case-insensitive occurrence of query in text and
+// returns its rune offsets. It works on runes so case folding never shifts
+// byte offsets.
+func matchRange(text, query string) (start, end int, ok bool) {
+	t := []rune(text)
+	q := []rune(query)
+	if len(q) == 0 || len(q) > len(t) {
+		return 0, 0, false
+	}
+	for i := 0; i+len(q) <= len(t); i++ {
+		matched := true
+		for j := range q {
+			if unicode.ToLower(t[i+j]) != unicode.ToLower(q[j]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i, i + len(q), true
+		}
+	}
+	return 0, 0, false
+}
+
+// highlightHTML returns text as escaped HTML with the first match of query
+// wrapped in a mark element.
+func highlightHTML(text, query string) string {
+	start, end, ok := matchRange(text, strings.TrimSpace(query))
+	if !ok {
+		return html.EscapeString(text)
+	}
+	r := []rune(text)
+	return html.EscapeString(string(r[:start])) +
+		`<mark class="bg-transparent font-semibold text-primary">` +
+		html.EscapeString(string(r[start:end])) +
+		`</mark>` +
+		html.EscapeString(string(r[end:]))
+}
+
+// typeaheadIndex returns the index of the first label after start (wrapping
+// around) that begins with prefix, or -1. Repeating the same character cycles
+// through the labels starting with it, as in native select elements.
+func typeaheadIndex(labels []string, start int, prefix string) int {
+	if prefix == "" || len(labels) == 0 {
+		return -1
+	}
+	p := []rune(strings.ToLower(prefix))
+	allSame := true
+	for _, c := range p[1:] {
+		if c != p[0] {
+			allSame = false
+			break
+		}
+	}
+	if allSame && len(p) > 1 {
+		p = p[:1]
+	}
+
+	needle := string(p)
+	// A fresh search starts at the current item so longer prefixes can keep
+	// matching it; cycling a single character moves on to the next one.
+	offset := 0
+	if len(p) == 1 {
+		offset = 1
+	}
+	for i := 0; i < len(labels); i++ {
+		idx := (start + offset + i) % len(labels)
+		if idx < 0 {
+			idx += len(labels)
+		}
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(labels[idx])), needle) {
+			return idx
+		}
+	}
+	return -1
+}
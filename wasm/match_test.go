@@ -0,0 +1,81 @@
+package wasm
+
+import "testing"
+
+func TestMatchRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		query      string
+		start, end int
+		ok         bool
+	}{
+		{"prefix", "Germany", "ger", 0, 3, true},
+		{"middle", "United Kingdom", "KING", 7, 11, true},
+		{"unicode", "Österreich", "ös", 0, 2, true},
+		{"no match", "France", "xyz", 0, 0, false},
+		{"empty query", "France", "", 0, 0, false},
+		{"query longer than text", "Fr", "France", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := matchRange(tt.text, tt.query)
+			if ok != tt.ok || start != tt.start || end != tt.end {
+				t.Errorf("matchRange(%q, %q) = %d, %d, %t; want %d, %d, %t",
+					tt.text, tt.query, start, end, ok, tt.start, tt.end, tt.ok)
+			}
+		})
+	}
+}
+
+func TestHighlightHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		query    string
+		expected string
+	}{
+		{"match", "Germany", "man", `Ger<mark class="bg-transparent font-semibold text-primary">man</mark>y`},
+		{"no match", "Germany", "xyz", "Germany"},
+		{"escapes text", "<b>Tom & Jerry</b>", "tom", `&lt;b&gt;<mark class="bg-transparent font-semibold text-primary">Tom</mark> &amp; Jerry&lt;/b&gt;`},
+		{"trims query", "Paris", " par ", `<mark class="bg-transparent font-semibold text-primary">Par</mark>is`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightHTML(tt.text, tt.query); got != tt.expected {
+				t.Errorf("highlightHTML(%q, %q) = %q; want %q", tt.text, tt.query, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTypeaheadIndex(t *testing.T) {
+	labels := []string{"Apple", "Banana", "Blueberry", "Cherry", "avocado"}
+
+	tests := []struct {
+		name     string
+		start    int
+		prefix   string
+		expected int
+	}{
+		{"first match after start", 0, "b", 1},
+		{"cycles same letter", 1, "b", 2},
+		{"wraps around", 2, "b", 1},
+		{"case insensitive", 0, "c", 3},
+		{"longer prefix keeps current", 2, "bl", 2},
+		{"longer prefix moves on", 1, "bl", 2},
+		{"repeated letter cycles", 0, "aa", 4},
+		{"no match", 0, "z", -1},
+		{"empty prefix", 0, "", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := typeaheadIndex(labels, tt.start, tt.prefix); got != tt.expected {
+				t.Errorf("typeaheadIndex(%d, %q) = %d; want %d", tt.start, tt.prefix, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+	"github.com/ozanturksever/gomponents-flyonui/wasm/overlay"
+)
+
+// HydrateModal starts the overlay manager, which stacks modals and drawers
+// and manages their focus and page scrolling, and makes ModalCloseAction
+// buttons below root close the modal they are rendered in
+func HydrateModal(root Root) {
+	overlay.Init()
+	for _, button := range root.QuerySelectorAll("[data-modal-close]") {
+		modal := button.Closest(".overlay")
+		if modal == nil || markHydrated(button, "modal") {
+			continue
+		}
+		button.AddEventListener("click", false, func(dom.Event) { overlay.Close(modal) })
+	}
+}
+
+// HydrateOverlay makes data-overlay triggers below root, such as
+// DrawerToggleButton and the images of a GalleryComponent, open and close
+// their overlay when FlyonUI's HSOverlay is not loaded. With HSOverlay the
+// triggers are left to it.
+func HydrateOverlay(root Root) {
+	for _, trigger := range root.QuerySelectorAll("[data-overlay]") {
+		if markHydrated(trigger, "overlay") {
+			continue
+		}
+		selector := trigger.GetAttribute("data-overlay")
+		trigger.AddEventListener("click", false, func(event dom.Event) {
+			if bridge.HasGlobal("HSOverlay") {
+				return
+			}
+			if target := dom.GetWindow().Document().QuerySelector(selector); target != nil {
+				event.PreventDefault()
+				overlay.Toggle(target)
+			}
+		})
+	}
+}
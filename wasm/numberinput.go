@@ -0,0 +1,63 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateNumberInput wires the increment and decrement buttons of every
+// NumberInputComponent below root. FlyonUI's HSInputNumber handles them when
+// flyonui.js is loaded, so the Go behavior only runs without it.
+func HydrateNumberInput(root Root) {
+	if bridge.HasGlobal("HSInputNumber") {
+		return
+	}
+	for _, wrapper := range root.QuerySelectorAll("[data-input-number]") {
+		input, ok := wrapper.QuerySelector("[data-input-number-input]").(*dom.HTMLInputElement)
+		decrement, okDec := wrapper.QuerySelector("[data-input-number-decrement]").(*dom.HTMLButtonElement)
+		increment, okInc := wrapper.QuerySelector("[data-input-number-increment]").(*dom.HTMLButtonElement)
+		if !ok || !okDec || !okInc || markHydrated(wrapper, "input-number") {
+			continue
+		}
+		var config components.NumberInputConfig
+		if err := json.Unmarshal([]byte(wrapper.GetAttribute("data-input-number")), &config); err != nil {
+			continue
+		}
+
+		value := func() float64 {
+			v, err := strconv.ParseFloat(input.Value(), 64)
+			if err != nil {
+				return config.Clamp(0)
+			}
+			return v
+		}
+		update := func() {
+			v := value()
+			decrement.SetDisabled(input.Disabled() || !config.CanIncrement(v, -1))
+			increment.SetDisabled(input.Disabled() || !config.CanIncrement(v, 1))
+		}
+		step := func(n int) {
+			input.SetValue(strconv.FormatFloat(config.Increment(value(), n), 'f', -1, 64))
+			input.DispatchEvent(bridge.NewEvent("input"))
+			input.DispatchEvent(bridge.NewEvent("change"))
+		}
+
+		decrement.AddEventListener("click", false, func(dom.Event) { step(-1) })
+		increment.AddEventListener("click", false, func(dom.Event) { step(1) })
+		input.AddEventListener("input", false, func(dom.Event) { update() })
+		input.AddEventListener("change", false, func(dom.Event) {
+			if input.Value() != "" {
+				input.SetValue(strconv.FormatFloat(config.Clamp(value()), 'f', -1, 64))
+			}
+			update()
+		})
+		update()
+	}
+}
@@ -0,0 +1,6 @@
+// Package overlay opens and closes FlyonUI overlays such as modals from Go.
+// It drives FlyonUI's HSOverlay when flyonui.js is loaded and toggles the
+// overlay classes itself otherwise. Its manager, started by Init, stacks open
+// overlays, traps focus in the top one, locks page scrolling and restores
+// the focus of the trigger on close.
+package overlay
@@ -0,0 +1,173 @@
+//go:build js && wasm
+
+package overlay
+
+import (
+	"strconv"
+	"syscall/js"
+	"time"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// CloseEvent is dispatched on an overlay once it closes, by HSOverlay or by
+// the fallback of this package
+const CloseEvent = "close.hs.overlay"
+
+// transition is the duration of the FlyonUI overlay transitions in milliseconds
+const transition = 300
+
+// hsOverlay returns FlyonUI's HSOverlay class, if loaded
+func hsOverlay() (js.Value, bool) {
+	if !bridge.HasGlobal("HSOverlay") {
+		return js.Undefined(), false
+	}
+	return js.Global().Get("HSOverlay"), true
+}
+
+// Open shows the overlay el, e.g. a rendered ModalComponent. Overlays added
+// to the page after FlyonUI initialized are registered first. Open starts
+// the overlay manager, see Init.
+func Open(el dom.Element) {
+	Init()
+	if hs, ok := hsOverlay(); ok {
+		if instance := hs.Call("getInstance", el.Underlying(), true); !instance.Truthy() {
+			hs.Call("autoInit")
+		}
+		hs.Call("open", el.Underlying())
+		return
+	}
+	if el.HasAttribute("data-overlay-fallback-open") {
+		return
+	}
+	el.SetAttribute("data-overlay-fallback-open", "")
+	el.Class().Remove("hidden")
+	el.Class().Add("open")
+	el.SetAttribute("aria-hidden", "false")
+
+	window := dom.GetWindow()
+	doc := window.Document()
+	opts := readOptions(el)
+	var backdrop dom.Element
+	if opts.Backdrop != "false" {
+		backdrop = doc.CreateElement("div")
+		backdrop.SetAttribute("class", "overlay-backdrop fixed inset-0 bg-base-300/60 transition duration-300")
+		backdrop.SetAttribute("data-overlay-fallback-backdrop", "")
+		if el.ID() != "" {
+			backdrop.SetAttribute("id", el.ID()+"-backdrop")
+		}
+		if z := zIndex(el); z != 0 {
+			backdrop.(dom.HTMLElement).Style().SetProperty("z-index", strconv.Itoa(z-1), "")
+		}
+		el.ParentNode().InsertBefore(backdrop, el)
+	}
+
+	keydown := func(event dom.Event) {
+		if event.(*dom.KeyboardEvent).Key() != "Escape" || !*readOptions(el).Keyboard {
+			return
+		}
+		// Escape closes the top overlay only
+		if top := Top(); top == nil || top.Underlying().Equal(el.Underlying()) {
+			Close(el)
+		}
+	}
+	// The overlay covers the viewport; clicks outside its dialog hit it directly
+	click := func(event dom.Event) {
+		if target := event.Target(); target != nil && target.Underlying().Equal(el.Underlying()) && closesOnBackdrop(el) {
+			Close(el)
+		}
+	}
+	keyListener := doc.AddEventListener("keydown", false, keydown)
+	clickListener := el.AddEventListener("click", false, click)
+	// Close buttons target the overlay through data-overlay, like HSOverlay toggles
+	var buttonListeners []js.Func
+	buttons := el.QuerySelectorAll(`[data-overlay="#` + el.ID() + `"]`)
+	for _, button := range buttons {
+		buttonListeners = append(buttonListeners, button.AddEventListener("click", false, func(dom.Event) { Close(el) }))
+	}
+	window.RequestAnimationFrame(func(time.Duration) { el.Class().Add("opened") })
+
+	var onClose js.Func
+	onClose = el.AddEventListener(CloseEvent, false, func(dom.Event) {
+		doc.RemoveEventListener("keydown", false, keyListener)
+		el.RemoveEventListener("click", false, clickListener)
+		el.RemoveEventListener(CloseEvent, false, onClose)
+		keyListener.Release()
+		clickListener.Release()
+		for i, button := range buttons {
+			button.RemoveEventListener("click", false, buttonListeners[i])
+			buttonListeners[i].Release()
+		}
+		if backdrop != nil {
+			backdrop.Class().Add("opacity-0")
+		}
+		window.SetTimeout(func() {
+			if backdrop != nil {
+				if parent := backdrop.ParentNode(); parent != nil {
+					parent.RemoveChild(backdrop)
+				}
+			}
+			onClose.Release()
+		}, transition)
+	})
+	el.DispatchEvent(bridge.NewCustomEvent(OpenEvent, el.Underlying()))
+}
+
+// Close hides the overlay el and dispatches CloseEvent on it
+func Close(el dom.Element) {
+	if hs, ok := hsOverlay(); ok {
+		hs.Call("close", el.Underlying())
+		return
+	}
+	if !el.HasAttribute("data-overlay-fallback-open") {
+		return
+	}
+	el.RemoveAttribute("data-overlay-fallback-open")
+	el.Class().Remove("opened")
+	el.SetAttribute("aria-hidden", "true")
+	dom.GetWindow().SetTimeout(func() {
+		el.Class().Remove("open")
+		el.Class().Add("hidden")
+	}, transition)
+	el.DispatchEvent(bridge.NewCustomEvent(CloseEvent, el.Underlying()))
+}
+
+// OnClose calls fn whenever the overlay el closes, including through its
+// close buttons, Escape or a backdrop click. The returned function removes
+// the callback.
+func OnClose(el dom.Element, fn func()) (remove func()) {
+	listener := el.AddEventListener(CloseEvent, false, func(dom.Event) { fn() })
+	return func() {
+		el.RemoveEventListener(CloseEvent, false, listener)
+		listener.Release()
+	}
+}
+
+// Dispose removes a closed overlay from the page once its closing
+// transition has finished, releasing its HSOverlay instance
+func Dispose(el dom.Element) {
+	dom.GetWindow().SetTimeout(func() {
+		if hs, ok := hsOverlay(); ok {
+			if instance := hs.Call("getInstance", el.Underlying(), true); instance.Truthy() {
+				if destroy := instance.Get("element").Get("destroy"); destroy.Type() == js.TypeFunction {
+					instance.Get("element").Call("destroy")
+				}
+			}
+		}
+		if parent := el.ParentNode(); parent != nil {
+			parent.RemoveChild(el)
+		}
+	}, transition)
+}
+
+// Toggle opens the overlay el when closed and closes it when open, like a
+// data-overlay trigger
+func Toggle(el dom.Element) {
+	if el.Class().Contains("open") {
+		Close(el)
+		return
+	}
+	Open(el)
+}
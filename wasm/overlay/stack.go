@@ -0,0 +1,279 @@
+//go:build js && wasm
+
+package overlay
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"honnef.co/go/js/dom/v2"
+)
+
+// OpenEvent is dispatched on an overlay once it opens, by HSOverlay or by the
+// fallback of this package
+const OpenEvent = "open.hs.overlay"
+
+// zIndexStep separates stacked overlays, leaving room for their backdrops
+const zIndexStep = 10
+
+// focusable matches the elements Tab can reach inside an overlay
+const focusable = `a[href], area[href], button:not([disabled]), input:not([disabled]):not([type="hidden"]), select:not([disabled]), textarea:not([disabled]), iframe, [contenteditable], [tabindex]:not([tabindex="-1"])`
+
+// entry is an open overlay and the element focused before it opened
+type entry struct {
+	el      dom.Element
+	trigger dom.HTMLElement
+	zIndex  bool
+}
+
+// manager keeps the stack of open overlays; the last entry is on top
+var manager struct {
+	initialized bool
+	stack       []entry
+	lastTrigger dom.HTMLElement
+	overflow    string
+	padding     string
+}
+
+// options is the data-overlay-options configuration rendered by the
+// components package
+type options struct {
+	Backdrop string `json:"backdrop"`
+	Keyboard *bool  `json:"keyboard"`
+}
+
+// readOptions parses the options of el; attributes missing from the JSON
+// fall back to the defaults
+func readOptions(el dom.Element) options {
+	o := options{Backdrop: "true"}
+	json.Unmarshal([]byte(el.GetAttribute("data-overlay-options")), &o)
+	if o.Keyboard == nil {
+		keyboard := el.GetAttribute("data-overlay-keyboard") != "false"
+		o.Keyboard = &keyboard
+	}
+	return o
+}
+
+// Init starts the overlay manager. Overlays opened afterwards, through Open
+// or FlyonUI's own triggers, stack above each other, keep Tab focus inside
+// the top overlay, lock page scrolling and return focus to their trigger
+// when closed. Calling Init again is a no-op.
+func Init() {
+	if manager.initialized {
+		return
+	}
+	manager.initialized = true
+	doc := dom.GetWindow().Document()
+
+	// HSOverlay may move focus before dispatching its open event, so
+	// remember the trigger that was clicked
+	doc.AddEventListener("click", true, func(event dom.Event) {
+		if target := event.Target(); target != nil {
+			if trigger, ok := target.Closest("[data-overlay]").(dom.HTMLElement); ok {
+				manager.lastTrigger = trigger
+			}
+		}
+	})
+	doc.AddEventListener(OpenEvent, false, func(event dom.Event) {
+		if target := event.Target(); target != nil {
+			push(target)
+		}
+	})
+	doc.AddEventListener(CloseEvent, false, func(event dom.Event) {
+		if target := event.Target(); target != nil {
+			pop(target)
+		}
+	})
+	doc.AddEventListener("keydown", false, func(event dom.Event) {
+		if key := event.(*dom.KeyboardEvent); key.Key() == "Tab" {
+			trapFocus(key)
+		}
+	})
+}
+
+// Top returns the overlay on top of the stack, or nil when none is open
+func Top() dom.Element {
+	if len(manager.stack) == 0 {
+		return nil
+	}
+	return manager.stack[len(manager.stack)-1].el
+}
+
+// index returns the position of el in the stack, or -1
+func index(el dom.Element) int {
+	for i, e := range manager.stack {
+		if e.el.Underlying().Equal(el.Underlying()) {
+			return i
+		}
+	}
+	return -1
+}
+
+// push registers the opened overlay el on top of the stack
+func push(el dom.Element) {
+	if index(el) >= 0 {
+		return
+	}
+	e := entry{el: el, trigger: activeElement()}
+	if e.trigger == nil || el.Contains(e.trigger) {
+		e.trigger = manager.lastTrigger
+	}
+	manager.lastTrigger = nil
+
+	if top := Top(); top != nil {
+		z := zIndex(top) + zIndexStep
+		if zIndex(el) < z {
+			setZIndex(el, z)
+			e.zIndex = true
+		}
+	}
+	if len(manager.stack) == 0 {
+		lockScroll()
+	}
+	manager.stack = append(manager.stack, e)
+
+	if active := activeElement(); active == nil || !el.Contains(active) {
+		focusFirst(el)
+	}
+}
+
+// pop removes the closed overlay el from the stack and restores the focus
+func pop(el dom.Element) {
+	i := index(el)
+	if i < 0 {
+		return
+	}
+	e := manager.stack[i]
+	manager.stack = append(manager.stack[:i], manager.stack[i+1:]...)
+	if e.zIndex {
+		// Keep the stacked z-index while the overlay transitions out
+		dom.GetWindow().SetTimeout(func() {
+			if index(el) < 0 {
+				setZIndex(el, -1)
+			}
+		}, transition)
+	}
+	if len(manager.stack) == 0 {
+		unlockScroll()
+	}
+	if e.trigger != nil && e.trigger.Underlying().Get("isConnected").Bool() {
+		e.trigger.Focus()
+	} else if top := Top(); top != nil {
+		focusFirst(top)
+	}
+}
+
+// zIndex returns the computed z-index of el, 0 when auto
+func zIndex(el dom.Element) int {
+	z, _ := strconv.Atoi(dom.GetWindow().GetComputedStyle(el, "").GetPropertyValue("z-index"))
+	return z
+}
+
+// setZIndex sets the z-index of el and its backdrop; a negative z resets it
+func setZIndex(el dom.Element, z int) {
+	targets := []dom.Element{el}
+	if el.ID() != "" {
+		if backdrop := dom.GetWindow().Document().GetElementByID(el.ID() + "-backdrop"); backdrop != nil {
+			targets = append(targets, backdrop)
+		}
+	}
+	for i, target := range targets {
+		style := target.(dom.HTMLElement).Style()
+		if z < 0 {
+			style.RemoveProperty("z-index")
+			continue
+		}
+		// The backdrop sits right below its overlay
+		style.SetProperty("z-index", strconv.Itoa(z-i), "")
+	}
+}
+
+// lockScroll hides the page scrollbar while overlays are open, padding the
+// page so its content does not shift. The root element is used because
+// HSOverlay locks the body itself.
+func lockScroll() {
+	window := dom.GetWindow()
+	root := window.Document().DocumentElement().(dom.HTMLElement)
+	style := root.Style()
+	manager.overflow = style.GetPropertyValue("overflow")
+	manager.padding = style.GetPropertyValue("padding-right")
+	if scrollbar := window.InnerWidth() - root.Underlying().Get("clientWidth").Int(); scrollbar > 0 {
+		style.SetProperty("padding-right", strconv.Itoa(scrollbar)+"px", "")
+	}
+	style.SetProperty("overflow", "hidden", "")
+}
+
+// unlockScroll restores the page scrolling locked by lockScroll
+func unlockScroll() {
+	style := dom.GetWindow().Document().DocumentElement().(dom.HTMLElement).Style()
+	for property, value := range map[string]string{"overflow": manager.overflow, "padding-right": manager.padding} {
+		if value == "" {
+			style.RemoveProperty(property)
+		} else {
+			style.SetProperty(property, value, "")
+		}
+	}
+}
+
+// tabbable returns the elements of el reachable with Tab, in DOM order
+func tabbable(el dom.Element) []dom.HTMLElement {
+	var elements []dom.HTMLElement
+	for _, candidate := range el.QuerySelectorAll(focusable) {
+		html, ok := candidate.(dom.HTMLElement)
+		// Elements without a layout box, e.g. below display: none, cannot take focus
+		if !ok || html.Underlying().Call("getClientRects").Length() == 0 {
+			continue
+		}
+		elements = append(elements, html)
+	}
+	return elements
+}
+
+// focusFirst focuses the first tabbable element of el, or el itself
+func focusFirst(el dom.Element) {
+	if elements := tabbable(el); len(elements) > 0 {
+		elements[0].Focus()
+	} else if html, ok := el.(dom.HTMLElement); ok {
+		html.Focus()
+	}
+}
+
+// trapFocus wraps Tab and Shift+Tab around the top overlay
+func trapFocus(event *dom.KeyboardEvent) {
+	top := Top()
+	if top == nil {
+		return
+	}
+	elements := tabbable(top)
+	if len(elements) == 0 {
+		event.PreventDefault()
+		if html, ok := top.(dom.HTMLElement); ok {
+			html.Focus()
+		}
+		return
+	}
+	first, last := elements[0], elements[len(elements)-1]
+	active := activeElement()
+	inside := active != nil && top.Contains(active)
+	switch {
+	case event.ShiftKey() && (!inside || active.Underlying().Equal(first.Underlying())):
+		event.PreventDefault()
+		last.Focus()
+	case !event.ShiftKey() && (!inside || active.Underlying().Equal(last.Underlying())):
+		event.PreventDefault()
+		first.Focus()
+	}
+}
+
+// activeElement returns the focused element of the document, if any
+func activeElement() dom.HTMLElement {
+	if doc, ok := dom.GetWindow().Document().(dom.HTMLDocument); ok {
+		return doc.ActiveElement()
+	}
+	return nil
+}
+
+// closesOnBackdrop reports whether a click on the backdrop of el closes it
+func closesOnBackdrop(el dom.Element) bool {
+	return readOptions(el).Backdrop == "true"
+}
@@ -0,0 +1,109 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydratePassword drives the strength meter and rule checklist of every
+// PasswordComponent below root. FlyonUI's HSStrongPassword does this when
+// flyonui.js is loaded, so the Go behavior only runs without it. It toggles
+// the same strong-password classes, so the rendered markup styles both.
+func HydratePassword(root Root) {
+	if bridge.HasGlobal("HSStrongPassword") {
+		return
+	}
+	document := dom.GetWindow().Document()
+	for _, meter := range root.QuerySelectorAll("[data-strong-password]") {
+		if markHydrated(meter, "strong-password") {
+			continue
+		}
+		var options struct {
+			Target        string                    `json:"target"`
+			Hints         string                    `json:"hints"`
+			StripClasses  string                    `json:"stripClasses"`
+			MinLength     int                       `json:"minLength"`
+			ChecksExclude []components.PasswordRule `json:"checksExclude"`
+		}
+		if err := json.Unmarshal([]byte(meter.GetAttribute("data-strong-password")), &options); err != nil {
+			continue
+		}
+		input, ok := document.QuerySelector(options.Target).(*dom.HTMLInputElement)
+		if !ok {
+			continue
+		}
+		hints := document.QuerySelector(options.Hints)
+
+		var rules []components.PasswordRule
+		for _, rule := range components.DefaultPasswordRules {
+			if !containsRule(options.ChecksExclude, rule) {
+				rules = append(rules, rule)
+			}
+		}
+		strips := make([]dom.Element, len(rules))
+		for i := range strips {
+			strips[i] = document.CreateElement("div")
+			strips[i].SetAttribute("class", options.StripClasses)
+			meter.AppendChild(strips[i])
+		}
+
+		var levels []string
+		var hint dom.Element
+		if hints != nil {
+			hint = hints.QuerySelector("[data-pw-strength-hint]")
+		}
+		if hint != nil {
+			json.Unmarshal([]byte(hint.GetAttribute("data-pw-strength-hint")), &levels)
+		}
+
+		update := func() {
+			check := components.CheckPassword(input.Value(), options.MinLength, rules)
+			for i, strip := range strips {
+				setClass(strip, "strong-password", i < check.Score())
+				setClass(strip, "strong-password-accepted", check.OK())
+			}
+			if hints != nil {
+				for _, item := range hints.QuerySelectorAll("[data-pw-strength-rule]") {
+					passed := containsRule(check.Passed, components.PasswordRule(item.GetAttribute("data-pw-strength-rule")))
+					setClass(item, "strong-password-active", passed)
+					if el := item.QuerySelector("[data-check]"); el != nil {
+						setClass(el, "hidden", !passed)
+					}
+					if el := item.QuerySelector("[data-uncheck]"); el != nil {
+						setClass(el, "hidden", passed)
+					}
+				}
+			}
+			if hint != nil && check.Score() < len(levels) {
+				hint.SetTextContent(levels[check.Score()])
+			}
+		}
+		input.AddEventListener("input", false, func(dom.Event) { update() })
+		update()
+	}
+}
+
+// containsRule reports whether rules contains rule
+func containsRule(rules []components.PasswordRule, rule components.PasswordRule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// setClass adds or removes class on el
+func setClass(el dom.Element, class string, on bool) {
+	if on {
+		el.Class().Add(class)
+	} else {
+		el.Class().Remove(class)
+	}
+}
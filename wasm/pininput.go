@@ -0,0 +1,123 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strings"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// pinInput holds the runtime state of a PinInputComponent
+type pinInput struct {
+	wrapper      dom.Element
+	items        []*dom.HTMLInputElement
+	hidden       *dom.HTMLInputElement
+	alphanumeric bool
+}
+
+// HydratePinInput keeps the hidden form value of every PinInputComponent below
+// root in sync with its boxes and dispatches "pin:complete" once all boxes are
+// filled. Without FlyonUI's HSPinInput it also advances focus while typing,
+// moves back on Backspace and spreads pasted codes over the boxes.
+func HydratePinInput(root Root) {
+	plugin := bridge.HasGlobal("HSPinInput")
+	for _, wrapper := range root.QuerySelectorAll("[data-pin-input]") {
+		hidden, ok := wrapper.QuerySelector("[data-pin-input-value]").(*dom.HTMLInputElement)
+		if !ok || markHydrated(wrapper, "pin-input") {
+			continue
+		}
+		pin := &pinInput{
+			wrapper:      wrapper,
+			hidden:       hidden,
+			alphanumeric: strings.Contains(wrapper.GetAttribute("data-pin-input"), "a-z"),
+		}
+		for _, el := range wrapper.QuerySelectorAll("[data-pin-input-item]") {
+			if item, ok := el.(*dom.HTMLInputElement); ok {
+				pin.items = append(pin.items, item)
+			}
+		}
+
+		for i, item := range pin.items {
+			if !plugin {
+				pin.bind(i, item)
+			}
+			item.AddEventListener("input", false, func(dom.Event) { pin.sync() })
+		}
+	}
+}
+
+// bind adds the typing behavior of box i
+func (p *pinInput) bind(i int, item *dom.HTMLInputElement) {
+	item.AddEventListener("input", false, func(dom.Event) {
+		chars := components.PinChars(item.Value(), p.alphanumeric, len(p.items))
+		if len(chars) == 0 {
+			item.SetValue("")
+			return
+		}
+		// Several characters arrive when a code is pasted or autofilled
+		p.fill(i, chars)
+	})
+	item.AddEventListener("keydown", false, func(event dom.Event) {
+		switch event.(*dom.KeyboardEvent).Key() {
+		case "Backspace":
+			if item.Value() == "" && i > 0 {
+				event.PreventDefault()
+				p.items[i-1].SetValue("")
+				p.items[i-1].Focus()
+				p.sync()
+			}
+		case "ArrowLeft":
+			if i > 0 {
+				event.PreventDefault()
+				p.items[i-1].Focus()
+			}
+		case "ArrowRight":
+			if i < len(p.items)-1 {
+				event.PreventDefault()
+				p.items[i+1].Focus()
+			}
+		}
+	})
+	item.AddEventListener("focus", false, func(dom.Event) { item.Select() })
+}
+
+// fill writes chars into the boxes starting at box i and focuses the box after them
+func (p *pinInput) fill(i int, chars []string) {
+	for _, char := range chars {
+		if i >= len(p.items) {
+			break
+		}
+		p.items[i].SetValue(char)
+		i++
+	}
+	if i < len(p.items) {
+		p.items[i].Focus()
+	} else {
+		p.items[len(p.items)-1].Blur()
+	}
+}
+
+// sync copies the boxes into the hidden input
+func (p *pinInput) sync() {
+	var sb strings.Builder
+	complete := true
+	for _, item := range p.items {
+		if item.Value() == "" {
+			complete = false
+		}
+		sb.WriteString(item.Value())
+	}
+	value := sb.String()
+	if value == p.hidden.Value() {
+		return
+	}
+	p.hidden.SetValue(value)
+	p.hidden.DispatchEvent(bridge.NewEvent("change"))
+	if complete {
+		p.wrapper.DispatchEvent(bridge.NewCustomEvent("pin:complete", map[string]any{"value": value}))
+	}
+}
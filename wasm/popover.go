@@ -0,0 +1,220 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strings"
+	"syscall/js"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// popoverSides positions the content of a popover next to its toggle when
+// FlyonUI's HSTooltip is not loaded, keyed by the side of the placement
+var popoverSides = map[string]string{
+	"top":    "bottom: 100%; left: 0; margin-bottom: 0.5rem",
+	"bottom": "top: 100%; left: 0; margin-top: 0.5rem",
+	"left":   "right: 100%; top: 0; margin-right: 0.5rem",
+	"right":  "left: 100%; top: 0; margin-left: 0.5rem",
+}
+
+// popoverDocument tracks the single document listener closing click
+// popovers on outside clicks, shared by every popover so none is leaked
+var popoverDocument struct {
+	listening bool
+}
+
+// HydratePopover makes PopoverComponents below root open on their trigger
+// when FlyonUI's HSTooltip is not loaded, and closes open popovers on
+// Escape either way. With HSTooltip, its show and hide events update
+// aria-expanded and are forwarded as popover:show and popover:hide.
+func HydratePopover(root Root) {
+	for _, el := range root.QuerySelectorAll("[data-popover]") {
+		if markHydrated(el, "popover") {
+			continue
+		}
+		el.AddEventListener("keydown", false, func(event dom.Event) {
+			if event.(*dom.KeyboardEvent).Key() == "Escape" && popoverOpen(el) {
+				HidePopover(el)
+				if toggle, ok := popoverTrigger(el).(dom.HTMLElement); ok {
+					toggle.Focus()
+				}
+			}
+		})
+		if bridge.HasGlobal("HSTooltip") {
+			// Nested tooltips dispatch the same events, which bubble up
+			for event, open := range map[string]bool{"show.hs.tooltip": true, "hide.hs.tooltip": false} {
+				el.AddEventListener(event, false, func(event dom.Event) {
+					if event.Target().Underlying().Equal(el.Underlying()) {
+						popoverToggled(el, open)
+					}
+				})
+			}
+			continue
+		}
+
+		toggle := el.QuerySelector(".tooltip-toggle")
+		if toggle == nil {
+			continue
+		}
+		switch popoverMode(el) {
+		case "hover":
+			// An interactive popover nests its content in the toggle, so
+			// leaving the toggle means leaving the content as well
+			toggle.AddEventListener("mouseenter", false, func(dom.Event) { ShowPopover(el) })
+			toggle.AddEventListener("mouseleave", false, func(dom.Event) { HidePopover(el) })
+		case "focus":
+			toggle.AddEventListener("focusin", false, func(dom.Event) { ShowPopover(el) })
+			toggle.AddEventListener("focusout", false, func(event dom.Event) {
+				// Keep it open while focus moves into nested content
+				if next := event.Underlying().Get("relatedTarget"); next.Truthy() && el.Contains(dom.WrapElement(next)) {
+					return
+				}
+				HidePopover(el)
+			})
+		default:
+			if trigger := popoverTrigger(el); trigger != nil {
+				trigger.AddEventListener("click", false, func(event dom.Event) {
+					event.PreventDefault()
+					if popoverOpen(el) {
+						HidePopover(el)
+					} else {
+						ShowPopover(el)
+					}
+				})
+			}
+			listenPopoverDocument()
+		}
+	}
+}
+
+// listenPopoverDocument closes open click popovers on clicks outside of
+// them. The listener is added once and looks the popovers up on each click.
+func listenPopoverDocument() {
+	if popoverDocument.listening {
+		return
+	}
+	popoverDocument.listening = true
+	doc := dom.GetWindow().Document()
+	doc.AddEventListener("click", false, func(event dom.Event) {
+		target := event.Target()
+		if target == nil || bridge.HasGlobal("HSTooltip") {
+			return
+		}
+		for _, el := range doc.QuerySelectorAll("[data-popover]") {
+			if popoverMode(el) == "click" && popoverOpen(el) && !el.Contains(target) {
+				HidePopover(el)
+			}
+		}
+	})
+}
+
+// ShowPopover opens the popover el, a rendered PopoverComponent
+func ShowPopover(el dom.Element) {
+	if hs, ok := hsTooltip(); ok {
+		hs.Call("show", el.Underlying())
+		return
+	}
+	content := el.QuerySelector(".tooltip-content")
+	if content == nil || popoverOpen(el) {
+		return
+	}
+	side := "top"
+	for candidate := range popoverSides {
+		if strings.Contains(el.GetAttribute("class"), "[--placement:"+candidate) {
+			side = candidate
+		}
+	}
+	el.Class().Add("relative")
+	content.SetAttribute("style", "position: absolute; z-index: 80; opacity: 1; visibility: visible; "+popoverSides[side])
+	el.Class().Add("show")
+	content.Class().Add("show")
+	popoverToggled(el, true)
+}
+
+// HidePopover closes the popover el
+func HidePopover(el dom.Element) {
+	if hs, ok := hsTooltip(); ok {
+		hs.Call("hide", el.Underlying())
+		return
+	}
+	content := el.QuerySelector(".tooltip-content")
+	if content == nil || !popoverOpen(el) {
+		return
+	}
+	content.RemoveAttribute("style")
+	el.Class().Remove("show")
+	content.Class().Remove("show")
+	popoverToggled(el, false)
+}
+
+// popoverToggled reflects a new state of the popover el on its trigger and
+// dispatches popover:show or popover:hide
+func popoverToggled(el dom.Element, open bool) {
+	setPopoverExpanded(el, open)
+	if open {
+		el.DispatchEvent(bridge.NewEvent("popover:show"))
+	} else {
+		el.DispatchEvent(bridge.NewEvent("popover:hide"))
+	}
+}
+
+// OnPopoverToggle calls fn with the new state whenever the popover el opens
+// or closes. The returned function removes the callback.
+func OnPopoverToggle(el dom.Element, fn func(open bool)) (remove func()) {
+	show := el.AddEventListener("popover:show", false, func(dom.Event) { fn(true) })
+	hide := el.AddEventListener("popover:hide", false, func(dom.Event) { fn(false) })
+	return func() {
+		el.RemoveEventListener("popover:show", false, show)
+		el.RemoveEventListener("popover:hide", false, hide)
+		show.Release()
+		hide.Release()
+	}
+}
+
+// hsTooltip returns FlyonUI's HSTooltip class, if loaded
+func hsTooltip() (js.Value, bool) {
+	if !bridge.HasGlobal("HSTooltip") {
+		return js.Undefined(), false
+	}
+	return js.Global().Get("HSTooltip"), true
+}
+
+// popoverMode returns how the popover el opens: "hover", "focus" or "click"
+func popoverMode(el dom.Element) string {
+	class := el.GetAttribute("class")
+	switch {
+	case strings.Contains(class, "[--trigger:hover]"):
+		return "hover"
+	case strings.Contains(class, "[--trigger:focus]"):
+		return "focus"
+	}
+	return "click"
+}
+
+// popoverOpen reports whether the popover el is shown
+func popoverOpen(el dom.Element) bool {
+	content := el.QuerySelector(".tooltip-content")
+	return content != nil && content.Class().Contains("show")
+}
+
+// popoverTrigger returns the element opening the popover el
+func popoverTrigger(el dom.Element) dom.Element {
+	if trigger := el.QuerySelector(".tooltip-toggle > [aria-haspopup]"); trigger != nil {
+		return trigger
+	}
+	return el.QuerySelector(".tooltip-toggle > :first-child")
+}
+
+// setPopoverExpanded reflects the state of the popover el on its trigger
+func setPopoverExpanded(el dom.Element, open bool) {
+	if trigger := popoverTrigger(el); trigger != nil && trigger.HasAttribute("aria-expanded") {
+		state := "false"
+		if open {
+			state = "true"
+		}
+		trigger.SetAttribute("aria-expanded", state)
+	}
+}
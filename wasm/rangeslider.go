@@ -0,0 +1,149 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// rangeSlider holds the runtime state of a RangeComponent rendered as a slider
+type rangeSlider struct {
+	wrapper  dom.Element
+	handles  []*dom.HTMLInputElement
+	tooltips []dom.Element
+	hidden   []*dom.HTMLInputElement
+	fill     dom.HTMLElement
+	min, max float64
+	format   components.RangeFormatFunc
+	vertical bool
+}
+
+// HydrateRangeSlider keeps the handles of every RangeComponent slider below
+// root apart, moves their tooltips and the track highlight, and copies the
+// values into the hidden <name>_min and <name>_max inputs. Each change is
+// announced with a "range:change" event; see OnRangeChange.
+func HydrateRangeSlider(root Root) {
+	for _, wrapper := range root.QuerySelectorAll("[data-range-slider]") {
+		if markHydrated(wrapper, "range-slider") {
+			continue
+		}
+		var options struct {
+			Format   string `json:"format"`
+			Vertical bool   `json:"vertical"`
+		}
+		if err := json.Unmarshal([]byte(wrapper.GetAttribute("data-range-slider")), &options); err != nil {
+			continue
+		}
+
+		rs := &rangeSlider{
+			wrapper:  wrapper,
+			format:   components.RangeFormat(options.Format),
+			vertical: options.Vertical,
+		}
+		for _, el := range wrapper.QuerySelectorAll("[data-range-handle]") {
+			if handle, ok := el.(*dom.HTMLInputElement); ok {
+				rs.handles = append(rs.handles, handle)
+			}
+		}
+		if len(rs.handles) == 0 {
+			continue
+		}
+		rs.min = parseFloat(rs.handles[0].Min(), 0)
+		rs.max = parseFloat(rs.handles[0].Max(), 100)
+		rs.tooltips = wrapper.QuerySelectorAll("[data-range-tooltip]")
+		for _, el := range wrapper.QuerySelectorAll("[data-range-value]") {
+			if hidden, ok := el.(*dom.HTMLInputElement); ok {
+				rs.hidden = append(rs.hidden, hidden)
+			}
+		}
+		rs.fill, _ = wrapper.QuerySelector("[data-range-fill]").(dom.HTMLElement)
+
+		for i, handle := range rs.handles {
+			handle.AddEventListener("input", false, func(dom.Event) { rs.update(i) })
+		}
+	}
+}
+
+// values returns the handle values
+func (rs *rangeSlider) values() []float64 {
+	values := make([]float64, len(rs.handles))
+	for i, handle := range rs.handles {
+		values[i] = parseFloat(handle.Value(), rs.min)
+	}
+	return values
+}
+
+// update reacts to handle moved by the user
+func (rs *rangeSlider) update(moved int) {
+	values := rs.values()
+	if len(values) == 2 && values[0] > values[1] {
+		// A handle cannot pass the other one
+		values[moved] = values[1-moved]
+		rs.handles[moved].SetValue(strconv.FormatFloat(values[moved], 'f', -1, 64))
+	}
+
+	for i, value := range values {
+		text := rs.format(value)
+		rs.handles[i].SetAttribute("aria-valuetext", text)
+		if i < len(rs.tooltips) {
+			rs.tooltips[i].SetTextContent(text)
+			rs.tooltips[i].SetAttribute("style", components.RangeOffset(rs.percent(value), rs.vertical))
+		}
+		if i < len(rs.hidden) {
+			rs.hidden[i].SetValue(strconv.FormatFloat(value, 'f', -1, 64))
+		}
+	}
+
+	from, to := 0.0, rs.percent(values[0])
+	if len(values) == 2 {
+		from, to = rs.percent(values[0]), rs.percent(values[1])
+		// Keep the lower handle reachable when both sit at the maximum
+		setClass(rs.handles[0], "z-10", values[0] >= rs.max)
+	}
+	if rs.fill != nil {
+		rs.fill.SetAttribute("style", components.RangeFill(from, to, rs.vertical))
+	}
+
+	detail := map[string]any{"min": values[0], "max": values[len(values)-1]}
+	rs.wrapper.DispatchEvent(bridge.NewCustomEvent("range:change", detail))
+}
+
+// percent returns the position of v along the track
+func (rs *rangeSlider) percent(v float64) float64 {
+	if rs.max <= rs.min {
+		return 0
+	}
+	return (min(max(v, rs.min), rs.max) - rs.min) / (rs.max - rs.min) * 100
+}
+
+// OnRangeChange calls fn with the values of the range slider containing el,
+// e.g. a handle found by ID, whenever a handle moves. A slider with one handle
+// reports its value as both min and max. The returned function removes the
+// callback.
+func OnRangeChange(el dom.Element, fn func(min, max float64)) (remove func()) {
+	if slider := el.Closest("[data-range-slider]"); slider != nil {
+		el = slider
+	}
+	listener := el.AddEventListener("range:change", false, func(event dom.Event) {
+		detail := event.Underlying().Get("detail")
+		fn(detail.Get("min").Float(), detail.Get("max").Float())
+	})
+	return func() {
+		el.RemoveEventListener("range:change", false, listener)
+		listener.Release()
+	}
+}
+
+// parseFloat parses s, returning def when it is not a number
+func parseFloat(s string, def float64) float64 {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return def
+}
@@ -0,0 +1,323 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall/js"
+
+	"honnef.co/go/js/dom/v2"
+	"maragu.dev/gomponents"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// tabLoaders produce the content of lazy panels without a URL, keyed by tab ID
+var tabLoaders = map[string]func() (gomponents.Node, error){}
+
+// RegisterTabLoader makes load produce the content of the lazy panel of the
+// tab with id on its first activation. load runs on its own goroutine, so it
+// may block, e.g. on network calls. Register loaders before hydrating.
+func RegisterTabLoader(id string, load func() (gomponents.Node, error)) {
+	tabLoaders[id] = load
+}
+
+// HydrateTabs makes TabsComponents below root switch panels when FlyonUI's
+// HSTabs is not loaded, and either way keeps the active tab in the URL and
+// fills lazy panels on first activation
+func HydrateTabs(root Root) {
+	for _, el := range root.QuerySelectorAll("[data-component=tabs]") {
+		if markHydrated(el, "tabs") {
+			continue
+		}
+		nav := el.QuerySelector("[role=tablist]")
+		if nav == nil {
+			continue
+		}
+
+		if bridge.HasGlobal("HSTabs") {
+			nav.AddEventListener("change.hs.tab", false, func(dom.Event) { tabChanged(el) })
+		} else {
+			nav.AddEventListener("click", false, func(event dom.Event) {
+				if tab := event.Target().Closest("[role=tab]"); tab != nil && !tab.HasAttribute("disabled") {
+					ShowTab(el, strings.TrimPrefix(tab.GetAttribute("data-tab"), "#"))
+				}
+			})
+			nav.AddEventListener("keydown", false, func(event dom.Event) { onTabKeyDown(el, event) })
+		}
+
+		// Restore a tab linked to, e.g. by a hash the server never saw
+		if id := urlTab(el); id != "" && !tabActive(tabTrigger(el, id)) {
+			ShowTab(el, id)
+		}
+		if panel := activeTabPanel(el); panel != nil {
+			loadTabPanel(panel)
+		}
+	}
+}
+
+// ShowTab activates the tab with id of el, a rendered TabsComponent.
+// Disabled and unknown tabs are ignored.
+func ShowTab(el dom.Element, id string) {
+	tab := tabTrigger(el, id)
+	if tab == nil || tab.HasAttribute("disabled") || tabActive(tab) {
+		return
+	}
+	if bridge.HasGlobal("HSTabs") {
+		// HSTabs reports the change with change.hs.tab
+		js.Global().Get("HSTabs").Call("open", tab.Underlying())
+		return
+	}
+	for _, other := range tabTriggers(el) {
+		active := other.Underlying().Equal(tab.Underlying())
+		if active {
+			other.Class().Add("active")
+		} else {
+			other.Class().Remove("active")
+		}
+		other.SetAttribute("aria-selected", fmt.Sprint(active))
+		if panel := tabPanel(el, other); panel != nil {
+			if active {
+				panel.Class().Remove("hidden")
+			} else {
+				panel.Class().Add("hidden")
+			}
+		}
+	}
+	tabChanged(el)
+}
+
+// OnTabChange calls fn with the ID of the newly active tab whenever the
+// active tab of el changes. The returned function removes the callback.
+func OnTabChange(el dom.Element, fn func(id string)) (remove func()) {
+	listener := el.AddEventListener("tab:change", false, func(event dom.Event) {
+		fn(event.Underlying().Get("detail").String())
+	})
+	return func() {
+		el.RemoveEventListener("tab:change", false, listener)
+		listener.Release()
+	}
+}
+
+// tabChanged follows up on a tab change: it moves the roving tabindex,
+// mirrors the tab in the URL and fills a lazy panel
+func tabChanged(el dom.Element) {
+	var id string
+	for _, tab := range tabTriggers(el) {
+		if tabActive(tab) {
+			tab.SetAttribute("tabindex", "0")
+			id = strings.TrimPrefix(tab.GetAttribute("data-tab"), "#")
+		} else {
+			tab.SetAttribute("tabindex", "-1")
+		}
+	}
+	if id == "" {
+		return
+	}
+	syncTabURL(el, id)
+	if panel := activeTabPanel(el); panel != nil {
+		loadTabPanel(panel)
+	}
+	el.DispatchEvent(bridge.NewCustomEvent("tab:change", id))
+}
+
+// onTabKeyDown implements the keyboard interaction of the tabs pattern,
+// activating tabs as they receive focus
+func onTabKeyDown(el dom.Element, event dom.Event) {
+	var tabs []dom.Element
+	current := -1
+	for _, tab := range tabTriggers(el) {
+		if tab.HasAttribute("disabled") {
+			continue
+		}
+		if isActive(tab) {
+			current = len(tabs)
+		}
+		tabs = append(tabs, tab)
+	}
+	if current < 0 {
+		return
+	}
+
+	prev, next := "ArrowLeft", "ArrowRight"
+	if nav := el.QuerySelector("[role=tablist]"); nav != nil && nav.GetAttribute("aria-orientation") == "vertical" {
+		prev, next = "ArrowUp", "ArrowDown"
+	}
+	target := current
+	switch event.(*dom.KeyboardEvent).Key() {
+	case prev:
+		target = (current - 1 + len(tabs)) % len(tabs)
+	case next:
+		target = (current + 1) % len(tabs)
+	case "Home":
+		target = 0
+	case "End":
+		target = len(tabs) - 1
+	default:
+		return
+	}
+	event.PreventDefault()
+	if tab, ok := tabs[target].(dom.HTMLElement); ok {
+		tab.Focus()
+	}
+	ShowTab(el, strings.TrimPrefix(tabs[target].GetAttribute("data-tab"), "#"))
+}
+
+// tabTriggers returns the tabs of el
+func tabTriggers(el dom.Element) []dom.Element {
+	return el.QuerySelectorAll("[role=tablist] > [role=tab]")
+}
+
+// tabTrigger returns the tab of el controlling the panel with id, if any
+func tabTrigger(el dom.Element, id string) dom.Element {
+	for _, tab := range tabTriggers(el) {
+		if tab.GetAttribute("aria-controls") == id {
+			return tab
+		}
+	}
+	return nil
+}
+
+// tabActive reports whether tab is the active tab
+func tabActive(tab dom.Element) bool {
+	return tab != nil && tab.Class().Contains("active")
+}
+
+// activeTabPanel returns the panel of the active tab of el, if any
+func activeTabPanel(el dom.Element) dom.Element {
+	for _, tab := range tabTriggers(el) {
+		if tabActive(tab) {
+			return tabPanel(el, tab)
+		}
+	}
+	return nil
+}
+
+// tabPanel returns the panel of tab inside el, if any. The panel is looked
+// up by ID rather than by selector, so IDs with dots, colons or a leading
+// digit work too.
+func tabPanel(el, tab dom.Element) dom.Element {
+	id := strings.TrimPrefix(tab.GetAttribute("data-tab"), "#")
+	if id == "" {
+		return nil
+	}
+	panel := dom.GetWindow().Document().GetElementByID(id)
+	if panel == nil || !el.Contains(panel) {
+		return nil
+	}
+	return panel
+}
+
+// urlTab returns the tab ID named in the URL for el with URL sync enabled:
+// its query parameter, or the hash when it has none
+func urlTab(el dom.Element) string {
+	if !el.HasAttribute("data-tab-sync") {
+		return ""
+	}
+	location, err := url.Parse(js.Global().Get("location").Get("href").String())
+	if err != nil {
+		return ""
+	}
+	if param := el.GetAttribute("data-tab-sync"); param != "" {
+		return location.Query().Get(param)
+	}
+	return location.Fragment
+}
+
+// syncTabURL mirrors the active tab id of el in the URL without adding a
+// history entry or scrolling to the panel
+func syncTabURL(el dom.Element, id string) {
+	if !el.HasAttribute("data-tab-sync") || urlTab(el) == id {
+		return
+	}
+	location, err := url.Parse(js.Global().Get("location").Get("href").String())
+	if err != nil {
+		return
+	}
+	if param := el.GetAttribute("data-tab-sync"); param != "" {
+		query := location.Query()
+		query.Set(param, id)
+		location.RawQuery = query.Encode()
+	} else {
+		location.Fragment = id
+	}
+	js.Global().Get("history").Call("replaceState", js.Global().Get("history").Get("state"), "", location.String())
+}
+
+// loadTabPanel fills the lazy panel from its URL or registered loader. A
+// failed load keeps the placeholder and is retried on the next activation.
+func loadTabPanel(panel dom.Element) {
+	if !panel.HasAttribute("data-tab-lazy") || panel.HasAttribute("data-tab-loading") {
+		return
+	}
+	panel.SetAttribute("data-tab-loading", "")
+	source, id := panel.GetAttribute("data-tab-lazy"), panel.ID()
+
+	// Loading blocks, so it must leave the event loop goroutine
+	go func() {
+		content, err := tabContent(source, id)
+		panel.RemoveAttribute("data-tab-loading")
+		if err != nil {
+			panel.DispatchEvent(bridge.NewCustomEvent("tab:error", err.Error()))
+			return
+		}
+		panel.SetInnerHTML(content)
+		panel.RemoveAttribute("data-tab-lazy")
+		panel.RemoveAttribute("aria-busy")
+		Hydrate(panel)
+		panel.DispatchEvent(bridge.NewEvent("tab:load"))
+	}()
+}
+
+// tabContent returns the HTML of a lazy panel, fetched from source or
+// rendered by the loader registered for id when source is empty
+func tabContent(source, id string) (string, error) {
+	if source == "" {
+		load, ok := tabLoaders[id]
+		if !ok {
+			return "", fmt.Errorf("no loader registered for tab %q", id)
+		}
+		node, err := load()
+		if err != nil {
+			return "", err
+		}
+		var html strings.Builder
+		if err := node.Render(&html); err != nil {
+			return "", err
+		}
+		return html.String(), nil
+	}
+
+	// Relative URLs resolve against the page, as they would with fetch
+	base, err := url.Parse(js.Global().Get("location").Get("href").String())
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := base.Parse(source)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/html")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,173 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"encoding/json"
+	"strings"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// tagsInput holds the runtime state of a TagsInputComponent
+type tagsInput struct {
+	wrapper  dom.Element
+	field    *dom.HTMLInputElement
+	template dom.Element // chip cloned for new tags
+	options  struct {
+		Max             int  `json:"max"`
+		SuggestionsOnly bool `json:"suggestionsOnly"`
+	}
+	suggestions []string
+}
+
+// HydrateTagsInput turns text typed into every TagsInputComponent below root
+// into chips. Enter, comma and leaving the field add tags, pasted lists are
+// split, and Backspace in the empty field removes the last tag. Changes are
+// announced with a "tags:change" event carrying the tags.
+func HydrateTagsInput(root Root) {
+	for _, wrapper := range root.QuerySelectorAll("[data-tags-input]") {
+		field, ok := wrapper.QuerySelector("[data-tags-input-field]").(*dom.HTMLInputElement)
+		template, okTemplate := wrapper.QuerySelector("template[data-tags-input-template]").(*dom.HTMLTemplateElement)
+		if !ok || !okTemplate || markHydrated(wrapper, "tags-input") {
+			continue
+		}
+		ti := &tagsInput{
+			wrapper:  wrapper,
+			field:    field,
+			template: template.Content().QuerySelector("[data-tags-input-tag]"),
+		}
+		if err := json.Unmarshal([]byte(wrapper.GetAttribute("data-tags-input")), &ti.options); err != nil {
+			continue
+		}
+		for _, option := range wrapper.QuerySelectorAll("datalist option") {
+			ti.suggestions = append(ti.suggestions, option.GetAttribute("value"))
+		}
+
+		field.AddEventListener("keydown", false, func(event dom.Event) {
+			switch event.(*dom.KeyboardEvent).Key() {
+			case "Enter", ",":
+				if strings.TrimSpace(field.Value()) != "" {
+					event.PreventDefault()
+					ti.add(field.Value())
+				}
+			case "Backspace":
+				if field.Value() == "" {
+					if tags := ti.tags(); len(tags) > 0 {
+						ti.remove(tags[len(tags)-1])
+					}
+				}
+			}
+		})
+		field.AddEventListener("input", false, func(event dom.Event) {
+			// Commas typed on mobile keyboards and pasted lists arrive here
+			// rather than through keydown, as do suggestions picked from the
+			// datalist, which browsers report without a typing inputType
+			value := field.Value()
+			inputType := event.Underlying().Get("inputType")
+			picked := inputType.IsUndefined() || inputType.String() == "insertReplacementText"
+			if strings.ContainsAny(value, ",;\n") || (picked && ti.isSuggestion(value)) {
+				ti.add(value)
+			}
+		})
+		field.AddEventListener("blur", false, func(dom.Event) {
+			if strings.TrimSpace(field.Value()) != "" {
+				ti.add(field.Value())
+			}
+		})
+		wrapper.AddEventListener("click", false, func(event dom.Event) {
+			if button := event.Target().Closest("[data-tags-input-remove]"); button != nil {
+				ti.remove(button.Closest("[data-tags-input-tag]"))
+				field.Focus()
+			} else if event.Target().Underlying().Equal(wrapper.Underlying()) {
+				field.Focus()
+			}
+		})
+		ti.update()
+	}
+}
+
+// tags returns the chip elements
+func (t *tagsInput) tags() []dom.Element {
+	return t.wrapper.QuerySelectorAll(":scope > [data-tags-input-tag]")
+}
+
+// values returns the current tags
+func (t *tagsInput) values() []string {
+	var values []string
+	for _, tag := range t.tags() {
+		values = append(values, tag.GetAttribute("data-tags-input-tag"))
+	}
+	return values
+}
+
+// isSuggestion reports whether value exactly matches a suggestion
+func (t *tagsInput) isSuggestion(value string) bool {
+	for _, suggestion := range t.suggestions {
+		if strings.EqualFold(suggestion, strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// add creates chips for the tags in text that are new and allowed
+func (t *tagsInput) add(text string) {
+	current := t.values()
+	all := components.NormalizeTags(append(current, components.SplitTags(text)...), t.options.Max)
+	t.field.SetValue("")
+
+	added := false
+	for _, tag := range all[len(current):] {
+		if t.options.SuggestionsOnly && !t.isSuggestion(tag) {
+			continue
+		}
+		chip := t.template.CloneNode(true).(dom.Element)
+		chip.SetAttribute("data-tags-input-tag", tag)
+		if label := chip.QuerySelector("[data-tags-input-label]"); label != nil {
+			label.SetTextContent(tag)
+		}
+		if button := chip.QuerySelector("[data-tags-input-remove]"); button != nil {
+			button.SetAttribute("aria-label", "Remove "+tag)
+		}
+		if hidden, ok := chip.QuerySelector("input[type=hidden]").(*dom.HTMLInputElement); ok {
+			hidden.SetValue(tag)
+		}
+		t.wrapper.InsertBefore(chip, t.field)
+		added = true
+	}
+	if added {
+		t.changed()
+	}
+}
+
+// remove deletes a chip
+func (t *tagsInput) remove(tag dom.Element) {
+	if tag == nil {
+		return
+	}
+	t.wrapper.RemoveChild(tag)
+	t.changed()
+}
+
+// changed updates the field and announces the new tags
+func (t *tagsInput) changed() {
+	t.update()
+	t.wrapper.DispatchEvent(bridge.NewCustomEvent("tags:change", map[string]any{
+		"tags": bridge.GoStringsToJSArray(t.values()),
+	}))
+}
+
+// update disables the text field once the maximum number of tags is reached
+func (t *tagsInput) update() {
+	full := t.options.Max > 0 && len(t.tags()) >= t.options.Max
+	if full {
+		t.wrapper.SetAttribute("data-tags-input-full", "")
+	} else {
+		t.wrapper.RemoveAttribute("data-tags-input-full")
+	}
+	t.field.SetReadOnly(full)
+}
@@ -0,0 +1,107 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strconv"
+	"strings"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// markdownShortcuts maps the keys pressed with Ctrl or Cmd to toolbar actions
+var markdownShortcuts = map[string]components.MarkdownAction{
+	"b": components.MarkdownBold,
+	"i": components.MarkdownItalic,
+	"k": components.MarkdownLink,
+}
+
+// HydrateTextarea adds the live character counter, auto-grow and markdown
+// toolbar behavior to every TextareaComponent below root that uses them
+func HydrateTextarea(root Root) {
+	document := dom.GetWindow().Document()
+
+	for _, counter := range root.QuerySelectorAll("[data-textarea-counter]") {
+		textarea, ok := document.GetElementByID(strings.TrimSuffix(counter.ID(), "-counter")).(*dom.HTMLTextAreaElement)
+		if !ok || markHydrated(counter, "textarea-counter") {
+			continue
+		}
+		maxLength, _ := strconv.Atoi(counter.GetAttribute("data-textarea-counter"))
+		textarea.AddEventListener("input", false, func(dom.Event) {
+			text, nearLimit := components.TextareaCount(textarea.Value(), maxLength)
+			counter.SetTextContent(text)
+			setClass(counter, "text-error", nearLimit)
+			setClass(counter, "text-base-content/60", !nearLimit)
+		})
+	}
+
+	for _, el := range root.QuerySelectorAll("textarea[data-textarea-autogrow]") {
+		textarea, ok := el.(*dom.HTMLTextAreaElement)
+		if !ok || markHydrated(textarea, "textarea-autogrow") {
+			continue
+		}
+		bounds := strings.Split(textarea.GetAttribute("data-textarea-autogrow"), ",")
+		if len(bounds) != 2 {
+			continue
+		}
+		minRows, _ := strconv.Atoi(bounds[0])
+		maxRows, _ := strconv.Atoi(bounds[1])
+		grow := func() { autoGrow(textarea, minRows, maxRows) }
+		textarea.AddEventListener("input", false, func(dom.Event) { grow() })
+		grow()
+	}
+
+	for _, toolbar := range root.QuerySelectorAll("[data-markdown-toolbar]") {
+		textarea, ok := document.GetElementByID(toolbar.GetAttribute("data-markdown-toolbar")).(*dom.HTMLTextAreaElement)
+		if !ok || markHydrated(toolbar, "markdown-toolbar") {
+			continue
+		}
+		for _, button := range toolbar.QuerySelectorAll("[data-markdown-action]") {
+			action := components.MarkdownAction(button.GetAttribute("data-markdown-action"))
+			button.AddEventListener("click", false, func(dom.Event) { applyMarkdown(textarea, action) })
+		}
+		textarea.AddEventListener("keydown", false, func(event dom.Event) {
+			key := event.(*dom.KeyboardEvent)
+			action, ok := markdownShortcuts[strings.ToLower(key.Key())]
+			if ok && (key.CtrlKey() || key.MetaKey()) && !key.AltKey() && !textarea.ReadOnly() {
+				event.PreventDefault()
+				applyMarkdown(textarea, action)
+			}
+		})
+	}
+}
+
+// autoGrow sets the rows of textarea to fit its content within the bounds
+func autoGrow(textarea *dom.HTMLTextAreaElement, minRows, maxRows int) {
+	rows := components.AutoGrowRows(0, minRows, maxRows)
+	textarea.SetRows(rows)
+	for overflows(textarea) && rows < maxRows {
+		rows++
+		textarea.SetRows(rows)
+	}
+	overflow := "hidden"
+	if overflows(textarea) {
+		overflow = "auto"
+	}
+	textarea.Style().SetProperty("overflow-y", overflow, "")
+}
+
+// overflows reports whether the content of el is taller than its box
+func overflows(el dom.HTMLElement) bool {
+	return el.Underlying().Get("scrollHeight").Int() > el.Underlying().Get("clientHeight").Int()
+}
+
+// applyMarkdown applies action to the selection of textarea
+func applyMarkdown(textarea *dom.HTMLTextAreaElement, action components.MarkdownAction) {
+	value := textarea.Value()
+	edit := components.ApplyMarkdown(action, value,
+		runeOffset(value, textarea.SelectionStart()), runeOffset(value, textarea.SelectionEnd()))
+
+	textarea.SetValue(edit.Value)
+	textarea.Focus()
+	textarea.SetSelectionRange(utf16Offset(edit.Value, edit.Start), utf16Offset(edit.Value, edit.End), "none")
+	textarea.DispatchEvent(bridge.NewEvent("input"))
+}
@@ -0,0 +1,37 @@
+package wasm
+
+import "unicode/utf8"
+
+// runeOffset converts an offset in UTF-16 code units, as used by DOM
+// selection APIs, into a rune offset of s
+func runeOffset(s string, utf16Offset int) int {
+	units, runes := 0, 0
+	for _, r := range s {
+		if units >= utf16Offset {
+			break
+		}
+		units += utf16Len(r)
+		runes++
+	}
+	return runes
+}
+
+// utf16Offset converts a rune offset of s into UTF-16 code units
+func utf16Offset(s string, runeOffset int) int {
+	units := 0
+	for i, r := range []rune(s) {
+		if i >= runeOffset {
+			break
+		}
+		units += utf16Len(r)
+	}
+	return units
+}
+
+// utf16Len returns the number of UTF-16 code units encoding r
+func utf16Len(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
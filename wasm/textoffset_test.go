@@ -0,0 +1,16 @@
+package wasm
+
+import "testing"
+
+func TestTextOffsets(t *testing.T) {
+	s := "a😀b" // the emoji takes two UTF-16 code units
+	tests := []struct{ units, runes int }{{0, 0}, {1, 1}, {3, 2}, {4, 3}}
+	for _, tt := range tests {
+		if got := runeOffset(s, tt.units); got != tt.runes {
+			t.Errorf("runeOffset(%d): expected %d, got %d", tt.units, tt.runes, got)
+		}
+		if got := utf16Offset(s, tt.runes); got != tt.units {
+			t.Errorf("utf16Offset(%d): expected %d, got %d", tt.runes, tt.units, got)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+//go:build js && wasm
+
+package wasm
+
+import "github.com/ozanturksever/gomponents-flyonui/wasm/toast"
+
+// HydrateToast starts the dismiss timers of the toasts below root, such as
+// flash messages rendered on the server. Use toast.Show for new toasts.
+func HydrateToast(root Root) {
+	for _, el := range root.QuerySelectorAll("[data-toast]") {
+		toast.Attach(el)
+	}
+}
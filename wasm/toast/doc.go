@@ -0,0 +1,3 @@
+// Package toast shows ToastComponent notifications from WASM code and
+// drives the dismiss timers of toasts rendered on the server.
+package toast
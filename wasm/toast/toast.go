@@ -0,0 +1,202 @@
+//go:build js && wasm
+
+package toast
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/flyon"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// enterClasses are removed on the frame after a toast is inserted and added
+// again when it leaves, animating both ways through the transition classes
+// of the toast
+var enterClasses = []string{"opacity-0", "translate-y-2"}
+
+// Options configures a toast shown with Show
+type Options struct {
+	Title string
+	Icon  string
+	// Color is the alert color; leave Colored unset for the neutral style
+	Color   flyon.Color
+	Colored bool
+	// Timeout defaults to components.DefaultToastTimeout; a negative timeout
+	// keeps the toast until it is closed
+	Timeout time.Duration
+	// Position selects the container, created on first use; defaults to
+	// components.ToastBottomEnd
+	Position components.ToastPosition
+	// Persistent hides the close button
+	Persistent bool
+	Actions    []components.ToastAction
+	// OnAction is called with the Value of a clicked action button, after
+	// which the toast is dismissed
+	OnAction func(value string)
+}
+
+// Show renders message as a toast, stacks it in the container of its
+// position and returns a function dismissing it early
+func Show(message string, opts Options) (dismiss func()) {
+	toast := components.NewToast(message).WithTitle(opts.Title).WithIcon(opts.Icon).WithDismissible(!opts.Persistent).WithClasses(enterClasses...)
+	if opts.Colored {
+		toast = toast.WithColor(opts.Color)
+	}
+	switch {
+	case opts.Timeout < 0:
+		toast = toast.WithTimeout(0)
+	case opts.Timeout > 0:
+		toast = toast.WithTimeout(opts.Timeout)
+	}
+	for _, action := range opts.Actions {
+		toast = toast.WithAction(action)
+	}
+
+	var html strings.Builder
+	if err := toast.Render(&html); err != nil {
+		return func() {}
+	}
+	doc := dom.GetWindow().Document()
+	holder := doc.CreateElement("div")
+	holder.SetInnerHTML(html.String())
+	el := holder.QuerySelector("[data-toast]")
+	if el == nil {
+		return func() {}
+	}
+
+	container := Container(opts.Position)
+	container.AppendChild(el)
+	enforceLimit(container)
+	if opts.OnAction != nil {
+		el.AddEventListener("toast:action", false, func(event dom.Event) {
+			opts.OnAction(event.Underlying().Get("detail").String())
+		})
+	}
+	dom.GetWindow().RequestAnimationFrame(func(time.Duration) {
+		for _, class := range enterClasses {
+			el.Class().Remove(class)
+		}
+	})
+	return Attach(el)
+}
+
+// Container returns the toast container of position, rendering it into the
+// document body when the page has none
+func Container(position components.ToastPosition) dom.Element {
+	if position == "" {
+		position = components.ToastBottomEnd
+	}
+	doc := dom.GetWindow().Document()
+	if container := doc.QuerySelector(`[data-toast-container="` + string(position) + `"]`); container != nil {
+		return container
+	}
+	var html strings.Builder
+	components.NewToastContainer().WithPosition(position).Render(&html)
+	holder := doc.CreateElement("div")
+	holder.SetInnerHTML(html.String())
+	container := holder.QuerySelector("[data-toast-container]")
+	doc.(dom.HTMLDocument).Body().AppendChild(container)
+	return container
+}
+
+// enforceLimit dismisses the oldest toasts beyond the limit of container
+func enforceLimit(container dom.Element) {
+	limit, err := strconv.Atoi(container.GetAttribute("data-toast-limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+	var active []dom.Element
+	for _, el := range container.QuerySelectorAll("[data-toast]") {
+		if !el.HasAttribute("data-toast-leaving") {
+			active = append(active, el)
+		}
+	}
+	for i := 0; i < len(active)-limit; i++ {
+		active[i].DispatchEvent(bridge.NewEvent("toast:dismiss"))
+	}
+}
+
+// Attach starts the dismiss timer of a rendered toast, pausing it while the
+// toast is hovered or focused, and wires its close and action buttons. It
+// returns a function dismissing the toast; attaching twice is a no-op.
+func Attach(el dom.Element) (dismiss func()) {
+	dismiss = func() { el.DispatchEvent(bridge.NewEvent("toast:dismiss")) }
+	if el.HasAttribute("data-hydrated-toast") {
+		return dismiss
+	}
+	el.SetAttribute("data-hydrated-toast", "")
+
+	var config struct {
+		Timeout int `json:"timeout"`
+	}
+	json.Unmarshal([]byte(el.GetAttribute("data-toast")), &config)
+
+	window := dom.GetWindow()
+	remaining := config.Timeout
+	timer, started := 0, time.Time{}
+	stop := func() {
+		if timer != 0 {
+			window.ClearTimeout(timer)
+			timer = 0
+			remaining -= int(time.Since(started).Milliseconds())
+		}
+	}
+	start := func() {
+		if config.Timeout <= 0 || timer != 0 {
+			return
+		}
+		started = time.Now()
+		timer = window.SetTimeout(func() {
+			timer = 0
+			dismiss()
+		}, max(remaining, 0))
+	}
+
+	hovered, focused := false, false
+	resume := func() {
+		if !hovered && !focused {
+			start()
+		}
+	}
+	el.AddEventListener("mouseenter", false, func(dom.Event) { hovered = true; stop() })
+	el.AddEventListener("mouseleave", false, func(dom.Event) { hovered = false; resume() })
+	el.AddEventListener("focusin", false, func(dom.Event) { focused = true; stop() })
+	el.AddEventListener("focusout", false, func(dom.Event) { focused = false; resume() })
+
+	el.AddEventListener("toast:dismiss", false, func(dom.Event) {
+		if el.HasAttribute("data-toast-leaving") {
+			return
+		}
+		el.SetAttribute("data-toast-leaving", "")
+		stop()
+		for _, class := range enterClasses {
+			el.Class().Add(class)
+		}
+		// Remove after the transition; transitionend does not fire without one
+		window.SetTimeout(func() {
+			if parent := el.ParentNode(); parent != nil {
+				parent.RemoveChild(el)
+			}
+		}, 300)
+	})
+
+	// FlyonUI's HSRemoveElement handles the close button when it is loaded
+	if close := el.QuerySelector("[data-toast-close]"); close != nil && !bridge.HasGlobal("HSRemoveElement") {
+		close.AddEventListener("click", false, func(dom.Event) { dismiss() })
+	}
+	for _, action := range el.QuerySelectorAll("[data-toast-action]") {
+		action.AddEventListener("click", false, func(dom.Event) {
+			el.DispatchEvent(bridge.NewCustomEvent("toast:action", action.GetAttribute("data-toast-action")))
+			dismiss()
+		})
+	}
+
+	start()
+	return dismiss
+}
@@ -0,0 +1,452 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall/js"
+
+	"github.com/ozanturksever/gomponents-flyonui/components"
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+	"honnef.co/go/js/dom/v2"
+)
+
+// Messages shown on files rejected by the client-side checks or the server
+const (
+	uploadErrTooLarge = "File is too large"
+	uploadErrType     = "File type is not allowed"
+	uploadErrTooMany  = "Too many files"
+	uploadErrFailed   = "Upload failed"
+)
+
+// uploadMaxRetries is how often a failing chunk is retried before giving up
+const uploadMaxRetries = 3
+
+// fileUpload holds the runtime state of a FileUploadComponent
+type fileUpload struct {
+	wrapper  dom.Element
+	input    *dom.HTMLInputElement
+	list     dom.Element
+	template dom.Element
+
+	name      string
+	url       string
+	accept    string
+	color     string
+	chunkSize int64
+	maxSize   int64
+	maxFiles  int
+
+	// csrfHeader and csrfToken pass CSRFProtection.Middleware
+	csrfHeader string
+	csrfToken  string
+
+	// files are the files of the list, keyed by the data-upload-key of their item
+	files map[string]*uploadItem
+	next  int
+}
+
+// uploadItem is one file of the list
+type uploadItem struct {
+	el      dom.Element
+	file    *dom.File
+	preview string
+	cancel  context.CancelFunc
+}
+
+// uploadStatus mirrors the JSON response of components.UploadHandler
+type uploadStatus struct {
+	ID       string `json:"id"`
+	Offset   int64  `json:"offset"`
+	Complete bool   `json:"complete"`
+}
+
+// HydrateFileUpload adds drag and drop, previews, client-side size and type
+// checks and, with an upload URL, chunked resumable uploads to every
+// FileUploadComponent below root
+func HydrateFileUpload(root Root) {
+	for _, wrapper := range root.QuerySelectorAll("[data-file-upload]") {
+		input, ok := wrapper.QuerySelector("[data-file-upload-input]").(*dom.HTMLInputElement)
+		list := wrapper.QuerySelector("[data-file-upload-list]")
+		template, _ := wrapper.QuerySelector("[data-file-upload-template]").(*dom.HTMLTemplateElement)
+		if !ok || list == nil || template == nil || markHydrated(wrapper, "file-upload") {
+			continue
+		}
+
+		u := &fileUpload{
+			wrapper:   wrapper,
+			input:     input,
+			list:      list,
+			template:  template.Content().QuerySelector("[data-file-upload-item]"),
+			name:      wrapper.GetAttribute("data-upload-name"),
+			url:       wrapper.GetAttribute("data-upload-url"),
+			accept:    wrapper.GetAttribute("data-upload-accept"),
+			color:     wrapper.GetAttribute("data-upload-color"),
+			chunkSize: int64(attrInt(wrapper, "data-upload-chunk-size", components.DefaultUploadChunkSize)),
+			maxSize:   int64(attrInt(wrapper, "data-upload-max-size", 0)),
+			maxFiles:  attrInt(wrapper, "data-upload-max-files", 0),
+			files:     make(map[string]*uploadItem),
+
+			csrfHeader: wrapper.GetAttribute("data-upload-csrf-header"),
+			csrfToken:  wrapper.GetAttribute("data-upload-csrf-token"),
+		}
+
+		input.AddEventListener("change", false, func(dom.Event) {
+			u.add(input.Files())
+		})
+
+		zone := wrapper.QuerySelector("[data-file-upload-dropzone]")
+		if zone != nil {
+			highlight := []string{"border-" + u.color, "bg-" + u.color + "/5"}
+			for _, typ := range []string{"dragenter", "dragover"} {
+				zone.AddEventListener(typ, false, func(event dom.Event) {
+					event.PreventDefault()
+					if !input.Disabled() {
+						for _, c := range highlight {
+							zone.Class().Add(c)
+						}
+					}
+				})
+			}
+			for _, typ := range []string{"dragleave", "drop"} {
+				zone.AddEventListener(typ, false, func(event dom.Event) {
+					event.PreventDefault()
+					for _, c := range highlight {
+						zone.Class().Remove(c)
+					}
+				})
+			}
+			zone.AddEventListener("drop", false, func(event dom.Event) {
+				if input.Disabled() {
+					return
+				}
+				files := bridge.DroppedFiles(event)
+				if !input.Multiple() && len(files) > 1 {
+					files = files[:1]
+				}
+				if u.url == "" {
+					// Without an upload URL the dropped files are posted with the form
+					bridge.SetInputFiles(input, files)
+				}
+				u.add(files)
+			})
+		}
+
+		list.AddEventListener("click", false, func(event dom.Event) {
+			button := event.Target().Closest("[data-file-upload-remove]")
+			if button == nil {
+				return
+			}
+			if item := button.Closest("[data-file-upload-item]"); item != nil {
+				u.remove(item)
+				if u.url == "" {
+					u.syncInput()
+				}
+			}
+		})
+	}
+}
+
+// add validates files, appends them to the list and starts their upload
+func (u *fileUpload) add(files []*dom.File) {
+	if len(files) == 0 {
+		return
+	}
+	if !u.input.Multiple() || u.url == "" {
+		// A native input holds a single selection, so the list mirrors it
+		for _, item := range u.list.QuerySelectorAll("[data-file-upload-item]") {
+			u.remove(item)
+		}
+	}
+
+	for _, file := range files {
+		name := file.Get("name").String()
+		size := int64(file.Get("size").Int())
+		mimeType := file.Get("type").String()
+
+		item := u.newItem(file, name, size, mimeType)
+		switch {
+		case u.maxFiles > 0 && len(u.list.QuerySelectorAll("[data-file-upload-item]:not([data-upload-error])")) >= u.maxFiles:
+			u.fail(item, uploadErrTooMany)
+		case u.maxSize > 0 && size > u.maxSize:
+			u.fail(item, uploadErrTooLarge)
+		case !components.AcceptsFile(u.accept, name, mimeType):
+			u.fail(item, uploadErrType)
+		case u.url != "":
+			ctx, cancel := context.WithCancel(context.Background())
+			item.cancel = cancel
+			// Network calls block, so they must leave the event loop goroutine
+			go u.upload(ctx, item, name, size, mimeType)
+		}
+		u.list.AppendChild(item.el)
+	}
+
+	if u.url != "" {
+		// Allow selecting the same file again after removing it
+		u.input.SetValue("")
+	} else {
+		// Rejected files must not be posted with the form
+		u.syncInput()
+	}
+}
+
+// newItem clones the item template and fills in the file details
+func (u *fileUpload) newItem(file *dom.File, name string, size int64, mimeType string) *uploadItem {
+	el := u.template.CloneNode(true).(dom.Element)
+	key := strconv.Itoa(u.next)
+	u.next++
+	el.SetAttribute("data-upload-key", key)
+
+	item := &uploadItem{el: el, file: file}
+	u.files[key] = item
+
+	el.QuerySelector("[data-file-upload-name]").SetTextContent(name)
+	el.QuerySelector("[data-file-upload-size]").SetTextContent(components.FormatFileSize(size))
+	el.QuerySelector("[data-file-upload-remove]").SetAttribute("aria-label", "Remove "+name)
+	el.QuerySelector("[data-file-upload-progress]").SetAttribute("aria-label", name)
+
+	if strings.HasPrefix(mimeType, "image/") {
+		item.preview = bridge.CreateObjectURL(file)
+		if img, ok := el.QuerySelector("[data-file-upload-thumbnail]").(*dom.HTMLImageElement); ok {
+			img.SetSrc(item.preview)
+			img.Class().Remove("hidden")
+			el.QuerySelector("[data-file-upload-icon]").Class().Add("hidden")
+		}
+	}
+	if u.url == "" {
+		// Files posted with the form have no upload progress
+		el.QuerySelector("[data-file-upload-progress]").Class().Add("hidden")
+	}
+	return item
+}
+
+// remove drops an item from the list, cancelling its upload
+func (u *fileUpload) remove(el dom.Element) {
+	key := el.GetAttribute("data-upload-key")
+	if item, ok := u.files[key]; ok {
+		if item.cancel != nil {
+			item.cancel()
+		}
+		if item.preview != "" {
+			bridge.RevokeObjectURL(item.preview)
+		}
+		delete(u.files, key)
+	}
+	el.Remove()
+	u.wrapper.DispatchEvent(bridge.NewCustomEvent("upload:remove", map[string]any{
+		"id": el.GetAttribute("data-file-id"),
+	}))
+}
+
+// syncInput makes the files posted with the form match the list
+func (u *fileUpload) syncInput() {
+	var files []*dom.File
+	for _, el := range u.list.QuerySelectorAll("[data-upload-key]:not([data-upload-error])") {
+		if item, ok := u.files[el.GetAttribute("data-upload-key")]; ok {
+			files = append(files, item.file)
+		}
+	}
+	bridge.SetInputFiles(u.input, files)
+}
+
+// fail marks an item as rejected
+func (u *fileUpload) fail(item *uploadItem, message string) {
+	item.el.SetAttribute("data-upload-error", "")
+	item.el.Class().Add("border-error")
+	item.el.QuerySelector("[data-file-upload-progress]").Class().Add("hidden")
+	msg := item.el.QuerySelector("[data-file-upload-error]")
+	msg.SetTextContent(message)
+	msg.Class().Remove("hidden")
+}
+
+// setProgress updates the progress bar of an item
+func (u *fileUpload) setProgress(item *uploadItem, offset, size int64) {
+	percent := 100.0
+	if size > 0 {
+		percent = float64(offset) * 100 / float64(size)
+	}
+	if bar, ok := item.el.QuerySelector("[data-file-upload-progress]").(*dom.HTMLProgressElement); ok {
+		bar.SetValue(percent)
+	}
+}
+
+// upload sends the file in chunks. The first chunk starts a new upload
+// whose ID is issued by the server and remembered in local storage, so an
+// interrupted upload of the same file resumes from the offset the server
+// already stored.
+func (u *fileUpload) upload(ctx context.Context, item *uploadItem, name string, size int64, mimeType string) {
+	key := uploadKey(name, size, int64(item.file.Get("lastModified").Int()))
+	id := uploadResumeID(key)
+
+	var status uploadStatus
+	if id != "" {
+		var err error
+		if status, err = u.status(ctx, id); err != nil {
+			// The server no longer knows the upload, start over
+			id, status = "", uploadStatus{}
+		} else {
+			item.el.SetAttribute("data-file-id", id)
+		}
+	}
+	retries := 0
+	for !status.Complete {
+		if ctx.Err() != nil {
+			return
+		}
+		u.setProgress(item, status.Offset, size)
+
+		start := status.Offset
+		end := min(start+u.chunkSize, size)
+		next, code, err := u.sendChunk(ctx, item, id, name, mimeType, start, end, size)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case code == http.StatusConflict:
+			// The server holds a different offset, e.g. after a lost response
+			status = next
+		case code == http.StatusNotFound && id != "":
+			id, status = "", uploadStatus{}
+		case err != nil || code != http.StatusOK:
+			retries++
+			if retries > uploadMaxRetries || code == http.StatusRequestEntityTooLarge || code == http.StatusUnsupportedMediaType {
+				u.fail(item, uploadErrorMessage(code))
+				return
+			}
+		default:
+			retries = 0
+			status = next
+			if id == "" {
+				id = status.ID
+				setUploadResumeID(key, id)
+				item.el.SetAttribute("data-file-id", id)
+			}
+		}
+	}
+	setUploadResumeID(key, "")
+
+	u.setProgress(item, size, size)
+	if u.name != "" {
+		hidden := dom.GetWindow().Document().CreateElement("input").(*dom.HTMLInputElement)
+		hidden.SetType("hidden")
+		hidden.SetName(u.name)
+		hidden.SetValue(id)
+		item.el.AppendChild(hidden)
+	}
+	u.wrapper.DispatchEvent(bridge.NewCustomEvent("upload:complete", map[string]any{
+		"id":   id,
+		"name": name,
+		"size": size,
+		"type": mimeType,
+	}))
+}
+
+// status asks the server how much of upload id it already stored
+func (u *fileUpload) status(ctx context.Context, id string) (uploadStatus, error) {
+	endpoint, err := url.Parse(u.url)
+	if err != nil {
+		return uploadStatus{}, err
+	}
+	params := endpoint.Query()
+	params.Set("id", id)
+	endpoint.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return uploadStatus{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return uploadStatus{}, err
+	}
+	defer resp.Body.Close()
+	var status uploadStatus
+	if resp.StatusCode != http.StatusOK {
+		return status, fmt.Errorf("upload status: %s", resp.Status)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&status)
+	return status, err
+}
+
+// sendChunk uploads bytes [start, end) of the file
+func (u *fileUpload) sendChunk(ctx context.Context, item *uploadItem, id, name, mimeType string, start, end, size int64) (uploadStatus, int, error) {
+	var status uploadStatus
+	data, err := bridge.ReadFileRange(item.file, start, end)
+	if err != nil {
+		return status, 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(data))
+	if err != nil {
+		return status, 0, err
+	}
+	if id != "" {
+		req.Header.Set(components.UploadIDHeader, id)
+	}
+	req.Header.Set(components.UploadNameHeader, url.PathEscape(name))
+	req.Header.Set(components.UploadTypeHeader, mimeType)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if u.csrfToken != "" {
+		req.Header.Set(u.csrfHeader, u.csrfToken)
+	}
+	if end > start {
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, size))
+	} else {
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return status, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+		err = json.NewDecoder(resp.Body).Decode(&status)
+	}
+	return status, resp.StatusCode, err
+}
+
+// uploadResumeID returns the ID of the unfinished upload of the file with
+// key, or an empty string
+func uploadResumeID(key string) string {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return ""
+	}
+	if id := storage.Call("getItem", "flyonui-upload:"+key); id.Truthy() {
+		return id.String()
+	}
+	return ""
+}
+
+// setUploadResumeID remembers the ID of the unfinished upload of the file
+// with key, forgetting it when id is empty
+func setUploadResumeID(key, id string) {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return
+	}
+	if id == "" {
+		storage.Call("removeItem", "flyonui-upload:"+key)
+	} else {
+		storage.Call("setItem", "flyonui-upload:"+key, id)
+	}
+}
+
+// uploadErrorMessage returns the message shown for a failed upload
+func uploadErrorMessage(code int) string {
+	switch code {
+	case http.StatusRequestEntityTooLarge:
+		return uploadErrTooLarge
+	case http.StatusUnsupportedMediaType:
+		return uploadErrType
+	default:
+		return uploadErrFailed
+	}
+}
@@ -0,0 +1,16 @@
+package wasm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// uploadKey derives a local resume key of a file from its name, size and
+// modification time, so selecting the same file again resumes its upload.
+// It only finds the upload ID issued by the server in this browser's
+// storage and is never sent to the server.
+func uploadKey(name string, size, modified int64) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + strconv.FormatInt(size, 10) + "\x00" + strconv.FormatInt(modified, 10)))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,16 @@
+package wasm
+
+import "testing"
+
+func TestUploadKey(t *testing.T) {
+	key := uploadKey("photo.jpg", 1024, 1700000000000)
+	if len(key) != 32 {
+		t.Errorf("Expected a 32 character key, got %q", key)
+	}
+	if key != uploadKey("photo.jpg", 1024, 1700000000000) {
+		t.Error("Expected the same file to produce the same key")
+	}
+	if key == uploadKey("photo.jpg", 1025, 1700000000000) || key == uploadKey("photo.jpg", 1024, 1700000000001) {
+		t.Error("Expected changed files to produce a different key")
+	}
+}
@@ -0,0 +1,138 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"strconv"
+
+	"honnef.co/go/js/dom/v2"
+
+	"github.com/ozanturksever/gomponents-flyonui/internal/bridge"
+)
+
+// HydrateWizard switches the steps of every WizardComponent below root on the
+// client. Carried values are dropped in favour of the fields of all steps,
+// which are submitted together once finish is clicked, except for steps
+// carried in a signed token, which stay read-only; native constraint
+// validation guards each step before moving on. A "wizard:step" event
+// carrying the new step index is dispatched on the form after every switch.
+func HydrateWizard(root Root) {
+	for _, el := range root.QuerySelectorAll("form[data-wizard]") {
+		form, ok := el.(*dom.HTMLFormElement)
+		if !ok || markHydrated(form, "wizard") {
+			continue
+		}
+		panels := form.QuerySelectorAll("[data-wizard-panel]")
+		if len(panels) == 0 {
+			continue
+		}
+		stepInput, _ := form.QuerySelector("[data-wizard-step-input]").(*dom.HTMLInputElement)
+		if client, ok := form.QuerySelector("[data-wizard-client]").(*dom.HTMLInputElement); ok {
+			client.SetValue("1")
+		}
+		for _, carry := range form.QuerySelectorAll("[data-wizard-carry]") {
+			if input, ok := carry.(*dom.HTMLInputElement); ok {
+				input.SetDisabled(true)
+			}
+		}
+		for _, panel := range panels {
+			// The server ignores edits to steps vouched for by the signed token
+			if panel.HasAttribute("data-wizard-signed") {
+				continue
+			}
+			if fieldset, ok := panel.(*dom.HTMLFieldSetElement); ok {
+				fieldset.SetDisabled(false)
+			}
+		}
+		items := form.QuerySelectorAll("[data-stepper-nav-item]")
+		back := form.QuerySelector("[data-wizard-back]")
+		next := form.QuerySelector("[data-wizard-next]")
+		finish := form.QuerySelector("[data-wizard-finish]")
+
+		current := 0
+		if stepInput != nil {
+			current, _ = strconv.Atoi(stepInput.Value())
+		}
+		last := len(panels) - 1
+
+		show := func(step int) {
+			current = min(max(step, 0), last)
+			for i, panel := range panels {
+				if i == current {
+					panel.RemoveAttribute("hidden")
+				} else {
+					panel.SetAttribute("hidden", "")
+				}
+			}
+			for i, item := range items {
+				setClass(item, "active", i == current)
+				setClass(item, "success", i < current)
+				setClass(item, "error", false)
+				if i == current {
+					item.SetAttribute("aria-current", "step")
+				} else {
+					item.RemoveAttribute("aria-current")
+				}
+			}
+			if back != nil {
+				setClass(back, "hidden", current == 0)
+			}
+			if next != nil {
+				setClass(next, "hidden", current == last)
+			}
+			if finish != nil {
+				setClass(finish, "hidden", current != last)
+			}
+			if stepInput != nil {
+				stepInput.SetValue(strconv.Itoa(current))
+			}
+			form.DispatchEvent(bridge.NewCustomEvent("wizard:step", current))
+		}
+
+		// valid reports whether every field of panel passes constraint
+		// validation, reporting the first invalid one when report is set
+		valid := func(panel dom.Element, report bool) bool {
+			for _, field := range panel.QuerySelectorAll("input, select, textarea") {
+				if !field.Underlying().Call("checkValidity").Bool() {
+					if report {
+						field.Underlying().Call("reportValidity")
+					}
+					return false
+				}
+			}
+			return true
+		}
+
+		if back != nil {
+			back.AddEventListener("click", false, func(event dom.Event) {
+				event.PreventDefault()
+				show(current - 1)
+			})
+		}
+		if next != nil {
+			next.AddEventListener("click", false, func(event dom.Event) {
+				if current == last {
+					// Enter on the last step clicks the hidden next button, which submits
+					return
+				}
+				event.PreventDefault()
+				if valid(panels[current], true) {
+					show(current + 1)
+				}
+			})
+		}
+		// Fields of hidden steps cannot show their validation message, so the
+		// first invalid step is brought into view before submitting
+		form.AddEventListener("submit", false, func(event dom.Event) {
+			for i, panel := range panels {
+				if !valid(panel, false) {
+					event.PreventDefault()
+					show(i)
+					valid(panel, true)
+					return
+				}
+			}
+		})
+		form.SetNoValidate(true)
+	}
+}